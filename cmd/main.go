@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -15,17 +16,28 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/joho/godotenv"
 	"github.com/loak155/techbranch-backend/internal/adapter"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/internal/usecase"
 	"github.com/loak155/techbranch-backend/pkg/auth"
 	"github.com/loak155/techbranch-backend/pkg/config"
+	"github.com/loak155/techbranch-backend/pkg/db"
+	"github.com/loak155/techbranch-backend/pkg/feed"
 	"github.com/loak155/techbranch-backend/pkg/jwt"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
 	"github.com/loak155/techbranch-backend/pkg/logger"
+	"github.com/loak155/techbranch-backend/pkg/mail"
 	"github.com/loak155/techbranch-backend/pkg/migration"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/loak155/techbranch-backend/pkg/redis"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/loak155/techbranch-backend/pkg/storage"
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -54,9 +66,16 @@ func main() {
 
 	waitGroup, ctx := errgroup.WithContext(ctx)
 
-	migration.DBMigrate(conf.MigrationUrl, conf.DbSource)
+	migration.DBMigrate(conf.MigrationUrl, conf.DbSource, normalizedUrlBackfillStep(conf))
 	runGatewayServer(ctx, waitGroup, conf)
 	runGrpcServer(ctx, waitGroup, conf)
+	runArticleScoreJob(ctx, waitGroup, conf)
+	runFeedSourceJob(ctx, waitGroup, conf)
+	runPurgeJob(ctx, waitGroup, conf)
+	runLinkCheckJob(ctx, waitGroup, conf)
+	runRecommendationJob(ctx, waitGroup, conf)
+	runBookmarkImportJob(ctx, waitGroup, conf)
+	runBookmarkReminderJob(ctx, waitGroup, conf)
 
 	err = waitGroup.Wait()
 	if err != nil {
@@ -65,7 +84,7 @@ func main() {
 }
 
 func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
-	grpcServer, _, _, _, _, _ := adapter.NewGRPCServer(conf)
+	grpcServer, _, _, _, _, _, _, _, _, _ := adapter.NewGRPCServer(conf)
 
 	listener, err := net.Listen("tcp", conf.GrpcServerAddress)
 	if err != nil {
@@ -94,17 +113,23 @@ func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, conf *config.
 }
 
 func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
-	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			UseProtoNames: true,
-		},
-		UnmarshalOptions: protojson.UnmarshalOptions{
-			DiscardUnknown: true,
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
 		},
 	})
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(
+		jsonOption,
+		runtime.WithErrorHandler(httpErrorHandler),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
-	_, articleServer, userServer, bookmarkServer, commentServer, authServer := adapter.NewGRPCServer(conf)
+	_, articleServer, userServer, bookmarkServer, commentServer, authServer, feedSourceServer, articleLinkServer, collectionServer, workspaceServer := adapter.NewGRPCServer(conf)
 	if err := pb.RegisterArticleServiceHandlerServer(ctx, grpcMux, articleServer); err != nil {
 		log.Fatal().Err(err).Msg("failed to register article service handler")
 	}
@@ -120,10 +145,40 @@ func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, conf *conf
 	if err := pb.RegisterAuthServiceHandlerServer(ctx, grpcMux, authServer); err != nil {
 		log.Fatal().Err(err).Msg("failed to register auth service handler")
 	}
+	if err := pb.RegisterFeedSourceServiceHandlerServer(ctx, grpcMux, feedSourceServer); err != nil {
+		log.Fatal().Err(err).Msg("failed to register feed source service handler")
+	}
+	if err := pb.RegisterArticleLinkServiceHandlerServer(ctx, grpcMux, articleLinkServer); err != nil {
+		log.Fatal().Err(err).Msg("failed to register article link service handler")
+	}
+	if err := pb.RegisterCollectionServiceHandlerServer(ctx, grpcMux, collectionServer); err != nil {
+		log.Fatal().Err(err).Msg("failed to register collection service handler")
+	}
+	if err := pb.RegisterWorkspaceServiceHandlerServer(ctx, grpcMux, workspaceServer); err != nil {
+		log.Fatal().Err(err).Msg("failed to register workspace service handler")
+	}
 
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
 
+	gormDB := db.NewDB(conf.DbSource)
+	userRepository := repository.NewUserRepository(gormDB)
+	articleRepository := repository.NewArticleRepository(gormDB, conf.SearchLanguage)
+	articleUsecase := usecase.NewArticleUsecase(articleRepository, userRepository, repository.NewArticleRevisionRepository(gormDB))
+	userUsecase := usecase.NewUserUsecase(userRepository)
+	collectionUsecase := usecase.NewCollectionUsecase(repository.NewCollectionRepository(gormDB), repository.NewBookmarkRepository(gormDB), articleRepository, repository.NewWorkspaceRepository(gormDB))
+	mux.Handle("/feeds/", adapter.NewFeedHTTPHandler(articleUsecase, userUsecase, collectionUsecase))
+
+	store, err := adapter.NewStore(conf)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create storage")
+	}
+	if localStore, ok := store.(*storage.LocalStore); ok {
+		mux.Handle("/files/", adapter.NewFileHTTPHandler(localStore))
+	}
+	imageUsecase := usecase.NewImageUsecase(store, safehttp.NewClient(conf.ImageFetchTimeout), urlsign.NewSigner(conf.ImageProxySecret), conf.ImageProxyBaseURL)
+	mux.Handle("/images/", adapter.NewImageHTTPHandler(imageUsecase))
+
 	mux.Handle("/docs/swagger/techbranch.swagger.json", http.FileServer(http.Dir(".")))
 	mux.Handle("/docs", middleware.SwaggerUI(middleware.SwaggerUIOpts{
 		SpecURL: "/docs/swagger/techbranch.swagger.json",
@@ -164,11 +219,138 @@ func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, conf *conf
 	})
 }
 
+func normalizedUrlBackfillStep(conf *config.Config) migration.Step {
+	gormDB := db.NewDB(conf.DbSource)
+	articleUsecase := usecase.NewArticleUsecase(repository.NewArticleRepository(gormDB, conf.SearchLanguage), repository.NewUserRepository(gormDB), repository.NewArticleRevisionRepository(gormDB))
+	return migration.Step{Version: 25, Run: articleUsecase.BackfillNormalizedUrls}
+}
+
+func runArticleScoreJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	articleRepository := repository.NewArticleRepository(gormDB, conf.SearchLanguage)
+	articleUsecase := usecase.NewArticleUsecase(articleRepository, repository.NewUserRepository(gormDB), repository.NewArticleRevisionRepository(gormDB))
+	runPeriodicJob(ctx, waitGroup, "article score", conf.ArticleScoreRefreshInterval, articleUsecase.RefreshArticleScores)
+}
+
+func runFeedSourceJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	articleUsecase := usecase.NewArticleUsecase(repository.NewArticleRepository(gormDB, conf.SearchLanguage), repository.NewUserRepository(gormDB), repository.NewArticleRevisionRepository(gormDB))
+	feedSourceUsecase := usecase.NewFeedSourceUsecase(repository.NewFeedSourceRepository(gormDB), repository.NewUserRepository(gormDB), articleUsecase, feed.NewFetcher(safehttp.NewClient(conf.FeedFetchTimeout)), conf.FeedFetchInterval, conf.FeedMaxBackoff)
+	runPeriodicJob(ctx, waitGroup, "feed source", conf.FeedPollInterval, feedSourceUsecase.FetchDueFeedSources)
+}
+
+func runPurgeJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	purgeUsecase := usecase.NewPurgeUsecase(
+		repository.NewArticleRepository(gormDB, conf.SearchLanguage),
+		repository.NewUserRepository(gormDB),
+		repository.NewBookmarkRepository(gormDB),
+		repository.NewCommentRepository(gormDB),
+		conf.SoftDeleteRetention,
+	)
+	runPeriodicJob(ctx, waitGroup, "purge", conf.PurgeInterval, purgeUsecase.PurgeDeleted)
+}
+
+func runLinkCheckJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	articleLinkUsecase := usecase.NewArticleLinkUsecase(
+		repository.NewArticleLinkRepository(gormDB),
+		repository.NewUserRepository(gormDB),
+		linkcheck.NewChecker(safehttp.NewClient(conf.LinkCheckTimeout)),
+		conf.LinkCheckInterval,
+		conf.LinkCheckRetryInterval,
+		conf.LinkBrokenThreshold,
+	)
+	runPeriodicJob(ctx, waitGroup, "link check", conf.LinkCheckPollInterval, articleLinkUsecase.CheckDueArticleLinks)
+}
+
+func runRecommendationJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	recommendationUsecase := usecase.NewRecommendationUsecase(
+		repository.NewRecommendationRepository(gormDB),
+		repository.NewArticleRepository(gormDB, conf.SearchLanguage),
+		conf.RecommendationLimit,
+	)
+	runPeriodicJob(ctx, waitGroup, "recommendation", conf.RecommendationInterval, recommendationUsecase.RefreshRecommendations)
+}
+
+func runBookmarkImportJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	bookmarkImportUsecase := usecase.NewBookmarkImportUsecase(
+		repository.NewBookmarkImportRepository(gormDB),
+		repository.NewBookmarkRepository(gormDB),
+		repository.NewCollectionRepository(gormDB),
+		usecase.NewArticleUsecase(repository.NewArticleRepository(gormDB, conf.SearchLanguage), repository.NewUserRepository(gormDB), repository.NewArticleRevisionRepository(gormDB)),
+		conf.BookmarkImportSyncLimit,
+		conf.BookmarkImportStaleTimeout,
+	)
+	runPeriodicJob(ctx, waitGroup, "bookmark import", conf.BookmarkImportPollInterval, bookmarkImportUsecase.ProcessPendingBookmarkImports)
+}
+
+func runBookmarkReminderJob(ctx context.Context, waitGroup *errgroup.Group, conf *config.Config) {
+	gormDB := db.NewDB(conf.DbSource)
+	bookmarkReminderMailManager, err := mail.NewBookmarkReminderMailManager(mail.GmailHost, mail.GmailPort, conf.GmailFrom, conf.GmailPassword, conf.BookmarkReminderMailSubject, conf.BookmarkReminderMailTemplate)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create bookmark reminder mail manager")
+	}
+	bookmarkDigestMailManager, err := mail.NewBookmarkDigestMailManager(mail.GmailHost, mail.GmailPort, conf.GmailFrom, conf.GmailPassword, conf.BookmarkDigestMailSubject, conf.BookmarkDigestMailTemplate, conf.BookmarkDigestSettingsURL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create bookmark digest mail manager")
+	}
+	bookmarkReminderUsecase := usecase.NewBookmarkReminderUsecase(
+		repository.NewBookmarkRepository(gormDB),
+		repository.NewUserRepository(gormDB),
+		*bookmarkReminderMailManager,
+		*bookmarkDigestMailManager,
+		conf.BookmarkDigestInterval,
+		conf.BookmarkDigestLimit,
+	)
+	runPeriodicJob(ctx, waitGroup, "bookmark reminder", conf.BookmarkReminderPollInterval, bookmarkReminderUsecase.SendDueBookmarkReminders)
+	runPeriodicJob(ctx, waitGroup, "bookmark digest", conf.BookmarkDigestPollInterval, bookmarkReminderUsecase.SendBookmarkDigests)
+}
+
+func runPeriodicJob(ctx context.Context, waitGroup *errgroup.Group, name string, interval time.Duration, job func() error) {
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start %s job", name)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := job(); err != nil {
+				log.Error().Err(err).Msgf("failed to run %s job", name)
+			}
+			select {
+			case <-ctx.Done():
+				log.Info().Msgf("%s job is stopped", name)
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+func httpErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "etag":
+		return "ETag", true
+	case "content-disposition":
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func enableCors(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Disposition")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusNoContent)
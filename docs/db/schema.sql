@@ -2,9 +2,22 @@ CREATE TABLE "articles" (
   "id" bigserial PRIMARY KEY,
   "title" varchar NOT NULL,
   "url" text NOT NULL,
+  "normalized_url" text NOT NULL DEFAULT '',
   "image" text,
+  "description" text NOT NULL DEFAULT '',
+  "body" text NOT NULL DEFAULT '',
+  "site" varchar NOT NULL DEFAULT '',
+  "tags" text[] DEFAULT '{}',
+  "language" regconfig NOT NULL DEFAULT 'english',
+  "search_vector" tsvector,
+  "bookmark_count" integer NOT NULL DEFAULT 0,
+  "comment_count" integer NOT NULL DEFAULT 0,
+  "trending_score" double precision NOT NULL DEFAULT 0,
+  "submitted_by_user_id" bigint,
+  "version" integer NOT NULL DEFAULT 1,
   "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
-  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "deleted_at" timestamp
 );
 
 CREATE TABLE "users" (
@@ -13,27 +26,241 @@ CREATE TABLE "users" (
   "email" varchar UNIQUE NOT NULL,
   "password" varchar,
   "google_id" varchar,
+  "bookmarks_public" boolean NOT NULL DEFAULT false,
+  "digest_enabled" boolean NOT NULL DEFAULT true,
+  "digest_sent_at" timestamp,
+  "role" varchar NOT NULL DEFAULT 'user',
+  "version" integer NOT NULL DEFAULT 1,
   "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
-  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "deleted_at" timestamp
 );
 
 CREATE TABLE "bookmarks" (
   "id" bigserial PRIMARY KEY,
   "user_id" bigint NOT NULL,
   "article_id" bigint NOT NULL,
+  "collection_id" bigint,
+  "position" integer NOT NULL DEFAULT 0,
+  "note" text NOT NULL DEFAULT '',
+  "highlights" text[] NOT NULL DEFAULT '{}',
+  "tags" text[] NOT NULL DEFAULT '{}',
+  "search_vector" tsvector,
+  "read_at" timestamp,
+  "progress" integer NOT NULL DEFAULT 0 CHECK ("progress" BETWEEN 0 AND 100),
+  "remind_at" timestamp,
+  "reminded_at" timestamp,
   "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
-  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "deleted_at" timestamp
 );
 
 CREATE TABLE "comments" (
   "id" bigserial PRIMARY KEY,
   "user_id" bigint NOT NULL,
   "article_id" bigint NOT NULL,
+  "workspace_id" bigint,
+  "parent_comment_id" bigint,
+  "depth" integer NOT NULL DEFAULT 0 CHECK ("depth" >= 0),
   "content" varchar NOT NULL,
   "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "deleted_at" timestamp
+);
+
+CREATE TABLE "article_revisions" (
+  "id" bigserial PRIMARY KEY,
+  "article_id" bigint NOT NULL,
+  "revision" integer NOT NULL,
+  "editor_user_id" bigint,
+  "title" varchar NOT NULL,
+  "url" text NOT NULL,
+  "image" text NOT NULL DEFAULT '',
+  "description" text NOT NULL DEFAULT '',
+  "body" text NOT NULL DEFAULT '',
+  "tags" text[] NOT NULL DEFAULT '{}',
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "feed_sources" (
+  "id" bigserial PRIMARY KEY,
+  "url" text UNIQUE NOT NULL,
+  "title" varchar NOT NULL DEFAULT '',
+  "etag" varchar NOT NULL DEFAULT '',
+  "last_modified" varchar NOT NULL DEFAULT '',
+  "last_fetched_at" timestamp,
+  "next_fetch_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "error_count" integer NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "article_links" (
+  "id" bigserial PRIMARY KEY,
+  "article_id" bigint UNIQUE NOT NULL,
+  "url" text NOT NULL,
+  "status_code" integer NOT NULL DEFAULT 0,
+  "redirect_url" text NOT NULL DEFAULT '',
+  "last_error" text NOT NULL DEFAULT '',
+  "failure_count" integer NOT NULL DEFAULT 0,
+  "broken" boolean NOT NULL DEFAULT false,
+  "last_checked_at" timestamp,
+  "next_check_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "article_recommendations" (
+  "user_id" bigint NOT NULL,
+  "article_id" bigint NOT NULL,
+  "score" double precision NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  PRIMARY KEY ("user_id", "article_id")
+);
+
+CREATE TABLE "collections" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "workspace_id" bigint,
+  "name" varchar NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "position" integer NOT NULL DEFAULT 0,
+  "visibility" varchar NOT NULL DEFAULT 'private',
+  "share_token" varchar NOT NULL DEFAULT (gen_random_uuid()::text),
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "bookmark_imports" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "format" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "data" text NOT NULL DEFAULT '',
+  "total_count" integer NOT NULL DEFAULT 0,
+  "processed_count" integer NOT NULL DEFAULT 0,
+  "created_count" integer NOT NULL DEFAULT 0,
+  "existing_count" integer NOT NULL DEFAULT 0,
+  "failed_count" integer NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "started_at" timestamp,
+  "finished_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "bookmark_import_errors" (
+  "id" bigserial PRIMARY KEY,
+  "import_id" bigint NOT NULL,
+  "item_index" integer NOT NULL,
+  "url" text NOT NULL DEFAULT '',
+  "title" text NOT NULL DEFAULT '',
+  "reason" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "workspaces" (
+  "id" bigserial PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
   "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
 );
 
+CREATE TABLE "workspace_members" (
+  "workspace_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  "role" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  PRIMARY KEY ("workspace_id", "user_id")
+);
+
+CREATE TABLE "workspace_invitations" (
+  "id" bigserial PRIMARY KEY,
+  "workspace_id" bigint NOT NULL,
+  "email" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "token" varchar NOT NULL,
+  "invited_by_user_id" bigint,
+  "expires_at" timestamp NOT NULL,
+  "accepted_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE INDEX ON "articles" USING GIN ("search_vector");
+
+CREATE INDEX ON "articles" USING GIN ("tags");
+
+CREATE INDEX ON "articles" ("site");
+
+CREATE INDEX ON "articles" ("normalized_url");
+
+CREATE INDEX ON "articles" ("bookmark_count", "id");
+
+CREATE INDEX ON "articles" ("comment_count", "id");
+
+CREATE INDEX ON "articles" ("trending_score", "id");
+
+CREATE INDEX ON "articles" ("submitted_by_user_id");
+
+CREATE INDEX ON "articles" ("deleted_at");
+
+CREATE INDEX ON "users" ("deleted_at");
+
+CREATE INDEX ON "bookmarks" ("deleted_at");
+
+CREATE UNIQUE INDEX ON "bookmarks" ("user_id", "article_id") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX ON "bookmarks" ("collection_id", "position", "id");
+
+CREATE INDEX ON "bookmarks" USING GIN ("search_vector");
+
+CREATE INDEX ON "bookmarks" USING GIN ("tags");
+
+CREATE INDEX ON "bookmarks" ("user_id") WHERE "read_at" IS NULL AND "deleted_at" IS NULL;
+
+CREATE INDEX ON "bookmarks" ("remind_at") WHERE "reminded_at" IS NULL AND "deleted_at" IS NULL;
+
+CREATE INDEX ON "comments" ("deleted_at");
+
+CREATE INDEX ON "comments" ("workspace_id", "article_id");
+
+CREATE INDEX ON "comments" ("parent_comment_id", "created_at", "id");
+
+CREATE UNIQUE INDEX ON "article_revisions" ("article_id", "revision");
+
+CREATE INDEX ON "feed_sources" ("next_fetch_at");
+
+CREATE INDEX ON "article_links" ("next_check_at");
+
+CREATE INDEX ON "article_links" ("broken");
+
+CREATE INDEX ON "article_recommendations" ("user_id", "score", "article_id");
+
+CREATE UNIQUE INDEX ON "collections" ("user_id", "name") WHERE "workspace_id" IS NULL;
+
+CREATE UNIQUE INDEX ON "collections" ("workspace_id", "name") WHERE "workspace_id" IS NOT NULL;
+
+CREATE INDEX ON "collections" ("user_id", "position", "id");
+
+CREATE INDEX ON "collections" ("workspace_id", "position", "id");
+
+CREATE UNIQUE INDEX ON "collections" ("share_token");
+
+CREATE INDEX ON "bookmark_imports" ("status", "id");
+
+CREATE INDEX ON "bookmark_imports" ("user_id", "id");
+
+CREATE INDEX ON "bookmark_import_errors" ("import_id", "item_index");
+
+CREATE INDEX ON "workspace_members" ("user_id");
+
+CREATE UNIQUE INDEX ON "workspace_invitations" ("token");
+
+CREATE INDEX ON "workspace_invitations" ("workspace_id", "id");
+
+ALTER TABLE "articles" ADD FOREIGN KEY ("submitted_by_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
 ALTER TABLE "bookmarks" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
 
 ALTER TABLE "bookmarks" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id");
@@ -41,3 +268,35 @@ ALTER TABLE "bookmarks" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("i
 ALTER TABLE "comments" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
 
 ALTER TABLE "comments" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id");
+
+ALTER TABLE "article_revisions" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "article_revisions" ADD FOREIGN KEY ("editor_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "article_links" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "article_recommendations" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "article_recommendations" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "bookmarks" ADD FOREIGN KEY ("collection_id") REFERENCES "collections" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "collections" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "bookmark_imports" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "bookmark_import_errors" ADD FOREIGN KEY ("import_id") REFERENCES "bookmark_imports" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "collections" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("parent_comment_id") REFERENCES "comments" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_members" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_members" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_invitations" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_invitations" ADD FOREIGN KEY ("invited_by_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
@@ -5,6 +5,7 @@ import (
 
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,15 +21,24 @@ type IArticleGRPCServer interface {
 	DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.DeleteArticleResponse, error)
 	GetArticleCount(ctx context.Context, req *pb.GetArticleCountRequest) (*pb.GetArticleCountResponse, error)
 	GetBookmarkedArticles(ctx context.Context, req *pb.GetBookmarkedArticlesRequest) (*pb.GetBookmarkedArticlesResponse, error)
+	SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesResponse, error)
+	RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (*pb.RestoreArticleResponse, error)
+	ListArticleRevisions(ctx context.Context, req *pb.ListArticleRevisionsRequest) (*pb.ListArticleRevisionsResponse, error)
+	RevertArticle(ctx context.Context, req *pb.RevertArticleRequest) (*pb.RevertArticleResponse, error)
+	DiffArticleRevisions(ctx context.Context, req *pb.DiffArticleRevisionsRequest) (*pb.DiffArticleRevisionsResponse, error)
+	ListRelatedArticles(ctx context.Context, req *pb.ListRelatedArticlesRequest) (*pb.ListRelatedArticlesResponse, error)
+	ListRecommendedArticles(ctx context.Context, req *pb.ListRecommendedArticlesRequest) (*pb.ListRecommendedArticlesResponse, error)
 }
 
 type articleGRPCServer struct {
 	pb.UnimplementedArticleServiceServer
-	usecase usecase.IArticleUsecase
+	usecase               usecase.IArticleUsecase
+	imageUsecase          usecase.IImageUsecase
+	recommendationUsecase usecase.IRecommendationUsecase
 }
 
-func NewArticleGRPCServer(grpcServer *grpc.Server, usecase usecase.IArticleUsecase) pb.ArticleServiceServer {
-	server := articleGRPCServer{usecase: usecase}
+func NewArticleGRPCServer(grpcServer *grpc.Server, usecase usecase.IArticleUsecase, imageUsecase usecase.IImageUsecase, recommendationUsecase usecase.IRecommendationUsecase) pb.ArticleServiceServer {
+	server := articleGRPCServer{usecase: usecase, imageUsecase: imageUsecase, recommendationUsecase: recommendationUsecase}
 	pb.RegisterArticleServiceServer(grpcServer, &server)
 	return &server
 }
@@ -39,25 +49,23 @@ func (server *articleGRPCServer) CreateArticle(ctx context.Context, req *pb.Crea
 	}
 
 	res := pb.CreateArticleResponse{}
+	submittedByUserID := uint(myContext.GetUserID(ctx))
 	article, err := server.usecase.CreateArticle(
 		domain.Article{
-			Title: req.Title,
-			Url:   req.Url,
-			Image: req.Image,
+			Title:             req.Title,
+			Url:               req.Url,
+			Image:             req.Image,
+			Description:       req.Description,
+			Body:              req.Body,
+			Tags:              req.Tags,
+			SubmittedByUserID: &submittedByUserID,
 		},
 	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create article: %v", err)
 	}
 
-	res.Article = &pb.Article{
-		Id:        int32(article.ID),
-		Title:     article.Title,
-		Url:       article.Url,
-		Image:     article.Image,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
-	}
+	res.Article = server.newArticlePB(article)
 
 	return &res, nil
 }
@@ -68,69 +76,81 @@ func (server *articleGRPCServer) GetArticle(ctx context.Context, req *pb.GetArti
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get article: %v", err)
 	}
-	res.Article = &pb.Article{
-		Id:        int32(article.ID),
-		Title:     article.Title,
-		Url:       article.Url,
-		Image:     article.Image,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
-	}
+	setETag(ctx, article.Version)
+	res.Article = server.newArticlePB(article)
 
 	return &res, nil
 }
 
 func (server *articleGRPCServer) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (*pb.ListArticlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.ListArticlesResponse{}
-	articleRes, err := server.usecase.ListArticles(int(req.Offset), int(req.Limit))
+	articleRes, nextPageToken, err := server.usecase.ListArticles(req.OrderBy, int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list articles: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to list articles: %v", err)
 	}
 	for _, article := range articleRes {
-		res.Articles = append(res.Articles, &pb.Article{
-			Id:        int32(article.ID),
-			Title:     article.Title,
-			Url:       article.Url,
-			Image:     article.Image,
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
-		})
+		res.Articles = append(res.Articles, server.newArticlePB(article))
 	}
+	res.NextPageToken = nextPageToken
 
 	return &res, nil
 }
 
 func (server *articleGRPCServer) UpdateArticle(ctx context.Context, req *pb.UpdateArticleRequest) (*pb.UpdateArticleResponse, error) {
-	if err := req.Validate(); err != nil {
+	populated := map[string]bool{
+		"title":       req.Title != "",
+		"url":         req.Url != "",
+		"image":       req.Image != "",
+		"description": req.Description != "",
+		"body":        req.Body != "",
+		"tags":        len(req.Tags) > 0,
+	}
+	fields, err := updateFields(req.UpdateMask, populated)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+	if err := validateFields(req.ValidateAll(), fields, populated); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	version, err := requestVersion(ctx, req.Version)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
 	}
 
 	res := pb.UpdateArticleResponse{}
 	article, err := server.usecase.UpdateArticle(
+		myContext.GetUserID(ctx),
 		domain.Article{
-			ID:    uint(req.Id),
-			Title: req.Title,
-			Url:   req.Url,
-			Image: req.Image,
+			ID:          uint(req.Id),
+			Title:       req.Title,
+			Url:         req.Url,
+			Image:       req.Image,
+			Description: req.Description,
+			Body:        req.Body,
+			Tags:        req.Tags,
+			Version:     version,
 		},
+		fields,
 	)
-
-	res.Article = &pb.Article{
-		Id:        int32(article.ID),
-		Title:     article.Title,
-		Url:       article.Url,
-		Image:     article.Image,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update article: %v", err)
 	}
-	return &res, err
+	setETag(ctx, article.Version)
+
+	res.Article = server.newArticlePB(article)
+	return &res, nil
 }
 
 func (server *articleGRPCServer) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.DeleteArticleResponse, error) {
 	res := pb.DeleteArticleResponse{}
-	err := server.usecase.DeleteArticle(int(req.Id))
+	err := server.usecase.DeleteArticle(myContext.GetUserID(ctx), int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete article: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to delete article: %v", err)
 	}
 
 	return &res, err
@@ -145,21 +165,212 @@ func (server *articleGRPCServer) GetArticleCount(ctx context.Context, req *pb.Ge
 }
 
 func (server *articleGRPCServer) GetBookmarkedArticles(ctx context.Context, req *pb.GetBookmarkedArticlesRequest) (*pb.GetBookmarkedArticlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.GetBookmarkedArticlesResponse{}
-	articleRes, err := server.usecase.GetBookmarkedArticles(int(req.UserId))
+	articleRes, nextPageToken, err := server.usecase.GetBookmarkedArticles(int(req.UserId), req.ReadState, int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get bookmarked articles: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to get bookmarked articles: %v", err)
 	}
 	for _, article := range articleRes {
-		res.Articles = append(res.Articles, &pb.Article{
-			Id:        int32(article.ID),
-			Title:     article.Title,
-			Url:       article.Url,
-			Image:     article.Image,
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
+		res.Articles = append(res.Articles, server.newArticlePB(article))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	query := domain.ArticleSearchQuery{
+		Query:     req.Query,
+		Tag:       req.Tag,
+		Site:      req.Site,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+
+	res := pb.SearchArticlesResponse{}
+	results, nextPageToken, err := server.usecase.SearchArticles(query)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to search articles: %v", err)
+	}
+	for _, result := range results {
+		res.Results = append(res.Results, &pb.SearchArticleResult{
+			Article:   server.newArticlePB(result.Article),
+			Rank:      result.Rank,
+			Highlight: result.Highlight,
 		})
 	}
+	res.NextPageToken = nextPageToken
 
 	return &res, nil
 }
+
+func (server *articleGRPCServer) RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (*pb.RestoreArticleResponse, error) {
+	res := pb.RestoreArticleResponse{}
+	article, err := server.usecase.RestoreArticle(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to restore article: %v", err)
+	}
+	res.Article = server.newArticlePB(article)
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) ListArticleRevisions(ctx context.Context, req *pb.ListArticleRevisionsRequest) (*pb.ListArticleRevisionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListArticleRevisionsResponse{}
+	revisions, nextPageToken, err := server.usecase.ListArticleRevisions(int(req.ArticleId), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list article revisions: %v", err)
+	}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, newArticleRevisionPB(revision))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) RevertArticle(ctx context.Context, req *pb.RevertArticleRequest) (*pb.RevertArticleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	version, err := requestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.RevertArticleResponse{}
+	article, err := server.usecase.RevertArticle(myContext.GetUserID(ctx), int(req.ArticleId), int(req.Revision), version)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to revert article: %v", err)
+	}
+	setETag(ctx, article.Version)
+	res.Article = server.newArticlePB(article)
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) DiffArticleRevisions(ctx context.Context, req *pb.DiffArticleRevisionsRequest) (*pb.DiffArticleRevisionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.DiffArticleRevisionsResponse{}
+	changes, err := server.usecase.DiffArticleRevisions(int(req.ArticleId), int(req.From), int(req.To))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to diff article revisions: %v", err)
+	}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, &pb.ArticleFieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) ListRelatedArticles(ctx context.Context, req *pb.ListRelatedArticlesRequest) (*pb.ListRelatedArticlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListRelatedArticlesResponse{}
+	articles, err := server.usecase.ListRelatedArticles(int(req.ArticleId), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list related articles: %v", err)
+	}
+	for _, article := range articles {
+		res.Articles = append(res.Articles, server.newArticlePB(article))
+	}
+
+	return &res, nil
+}
+
+func (server *articleGRPCServer) ListRecommendedArticles(ctx context.Context, req *pb.ListRecommendedArticlesRequest) (*pb.ListRecommendedArticlesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListRecommendedArticlesResponse{}
+	articles, nextPageToken, err := server.recommendationUsecase.ListRecommendedArticles(myContext.GetUserID(ctx), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list recommended articles: %v", err)
+	}
+	for _, article := range articles {
+		res.Articles = append(res.Articles, server.newArticlePB(article))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func newArticleRevisionPB(revision domain.ArticleRevision) *pb.ArticleRevision {
+	editorUserID := int32(0)
+	if revision.EditorUserID != nil {
+		editorUserID = int32(*revision.EditorUserID)
+	}
+	return &pb.ArticleRevision{
+		Id:           int32(revision.ID),
+		ArticleId:    int32(revision.ArticleID),
+		Revision:     int32(revision.Revision),
+		EditorUserId: editorUserID,
+		Title:        revision.Title,
+		Url:          revision.Url,
+		Image:        revision.Image,
+		Description:  revision.Description,
+		Tags:         revision.Tags,
+		CreatedAt:    &timestamppb.Timestamp{Seconds: int64(revision.CreatedAt.Unix()), Nanos: int32(revision.CreatedAt.Nanosecond())},
+	}
+}
+
+func (server *articleGRPCServer) newArticlePB(article domain.Article) *pb.Article {
+	submittedByUserID := int32(0)
+	if article.SubmittedByUserID != nil {
+		submittedByUserID = int32(*article.SubmittedByUserID)
+	}
+	thumbnails := []*pb.ArticleThumbnail{}
+	for _, thumbnail := range server.imageUsecase.GetThumbnailUrls(article.Image) {
+		thumbnails = append(thumbnails, &pb.ArticleThumbnail{
+			Size:    thumbnail.Size,
+			Width:   int32(thumbnail.Width),
+			WebpUrl: thumbnail.WebpUrl,
+			JpegUrl: thumbnail.JpegUrl,
+		})
+	}
+	return &pb.Article{
+		Id:                int32(article.ID),
+		Title:             article.Title,
+		Url:               article.Url,
+		Image:             article.Image,
+		Description:       article.Description,
+		Site:              article.Site,
+		Tags:              article.Tags,
+		BookmarkCount:     int32(article.BookmarkCount),
+		CommentCount:      int32(article.CommentCount),
+		SubmittedByUserId: submittedByUserID,
+		Version:           int32(article.Version),
+		Thumbnails:        thumbnails,
+		CreatedAt:         &timestamppb.Timestamp{Seconds: int64(article.CreatedAt.Unix()), Nanos: int32(article.CreatedAt.Nanosecond())},
+		UpdatedAt:         &timestamppb.Timestamp{Seconds: int64(article.UpdatedAt.Unix()), Nanos: int32(article.UpdatedAt.Nanosecond())},
+	}
+}
@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,9 +10,15 @@ import (
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
 	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"gorm.io/gorm"
 )
 
@@ -36,7 +43,7 @@ func TestCreateArticle(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
@@ -53,7 +60,7 @@ func TestCreateArticle(t *testing.T) {
 		{
 			name: "InvalidArgument",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: &pb.CreateArticleRequest{},
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
@@ -66,7 +73,7 @@ func TestCreateArticle(t *testing.T) {
 		{
 			name: "InvalidData",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
@@ -87,11 +94,13 @@ func TestCreateArticle(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewArticleUsecase(repo)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), revisionRepo)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewArticleGRPCServer(server, usecase)
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
 			res, err := s.CreateArticle(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -112,6 +121,7 @@ func TestGetArticle(t *testing.T) {
 		ID:        1,
 		Title:     "test_Article",
 		Url:       "http://example.com",
+		Image:     "http://example.com/image",
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -135,6 +145,8 @@ func TestGetArticle(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, repoResArticle.Title, res.Article.Title)
 				assert.Equal(t, repoResArticle.Url, res.Article.Url)
+				assert.Len(t, res.Article.Thumbnails, 3)
+				assert.True(t, strings.HasPrefix(res.Article.Thumbnails[0].WebpUrl, "http://localhost:8080/images/"))
 				assert.NotNil(t, res.Article.CreatedAt)
 				assert.NotNil(t, res.Article.UpdatedAt)
 			},
@@ -163,11 +175,11 @@ func TestGetArticle(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewArticleUsecase(repo)
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewArticleGRPCServer(server, usecase)
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
 			res, err := s.GetArticle(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -181,8 +193,7 @@ func TestListArticles(t *testing.T) {
 	}
 
 	req := &pb.ListArticlesRequest{
-		Offset: 0,
-		Limit:  10,
+		PageSize: 10,
 	}
 
 	repoResArticles := []domain.Article{
@@ -217,7 +228,7 @@ func TestListArticles(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any()).Return(&repoResArticles, nil)
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResArticles, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListArticlesResponse, err error) {
 				assert.NoError(t, err)
@@ -237,13 +248,41 @@ func TestListArticles(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any()).Return(&[]domain.Article{}, gorm.ErrInvalidData)
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Article{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListArticlesResponse, err error) {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), "failed to list articles")
 			},
 		},
+		{
+			name: "InvalidOrderBy",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListArticlesRequest{OrderBy: "popular"},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListArticlesRequest{PageToken: "invalid"},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Article{}, "", pagination.ErrInvalidPageToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -254,11 +293,11 @@ func TestListArticles(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewArticleUsecase(repo)
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewArticleGRPCServer(server, usecase)
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
 			res, err := s.ListArticles(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -272,12 +311,16 @@ func TestUpdateArticle(t *testing.T) {
 	}
 
 	req := &pb.UpdateArticleRequest{
-		Id:    1,
-		Title: "test_Article",
-		Url:   "http://example.com",
-		Image: "http://example.com/image",
+		Id:      1,
+		Title:   "test_Article",
+		Url:     "http://example.com",
+		Image:   "http://example.com/image",
+		Version: 1,
 	}
 
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+
 	testCases := []struct {
 		name          string
 		args          args
@@ -287,16 +330,101 @@ func TestUpdateArticle(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().UpdateArticle(gomock.Any()).Return(nil)
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "UpdateMask",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.UpdateArticleRequest{Id: 1, Version: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"image"}}},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), []string{"image"}, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidMaskPath",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.UpdateArticleRequest{Id: 1, Version: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bookmark_count"}}},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidMaskedUrl",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.UpdateArticleRequest{Id: 1, Url: "not a url", Version: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"url"}}},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "VersionConflict",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.ErrVersionConflict)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "IfMatchMismatch",
+			args: args{
+				ctx: metadata.NewIncomingContext(myContext.SetUserID(context.Background(), 1), metadata.Pairs("if-match", `"2"`)),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
 		{
 			name: "InvalidArgument",
 			args: args{
@@ -319,12 +447,16 @@ func TestUpdateArticle(t *testing.T) {
 
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			userRepo.EXPECT().GetUser(gomock.Any()).Return(&domain.User{Role: domain.UserRoleUser}, nil).AnyTimes()
+
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
 
-			usecase := usecase.NewArticleUsecase(repo)
+			usecase := usecase.NewArticleUsecase(repo, userRepo, revisionRepo)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewArticleGRPCServer(server, usecase)
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
 			res, err := s.UpdateArticle(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -341,6 +473,9 @@ func TestDeleteArticle(t *testing.T) {
 		Id: 1,
 	}
 
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+
 	testCases := []struct {
 		name          string
 		args          args
@@ -350,11 +485,12 @@ func TestDeleteArticle(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().DeleteArticle(gomock.Any()).Return(nil)
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().DeleteArticle(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteArticleResponse, err error) {
 				assert.NoError(t, err)
@@ -363,15 +499,16 @@ func TestDeleteArticle(t *testing.T) {
 		{
 			name: "NotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().DeleteArticle(gomock.Any()).Return(gorm.ErrRecordNotFound)
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{}, gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteArticleResponse, err error) {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), "failed to delete article")
+				assert.Equal(t, codes.NotFound, status.Code(err))
 			},
 		},
 	}
@@ -384,13 +521,396 @@ func TestDeleteArticle(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewArticleUsecase(repo)
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewArticleGRPCServer(server, usecase)
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
 			res, err := s.DeleteArticle(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
 	}
 }
+
+func TestSearchArticles(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.SearchArticlesRequest
+	}
+
+	req := &pb.SearchArticlesRequest{
+		Query:    "test",
+		Tag:      "go",
+		PageSize: 10,
+	}
+
+	repoResResults := []domain.ArticleSearchResult{
+		{
+			Article: domain.Article{
+				ID:        1,
+				Title:     "test_Article",
+				Url:       "http://example.com",
+				Site:      "example.com",
+				Tags:      []string{"go"},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+			Rank:      0.5,
+			Highlight: "<mark>test</mark>_Article",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, res *pb.SearchArticlesResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().SearchArticles(gomock.Any()).Return(&repoResResults, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchArticlesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, len(repoResResults), len(res.Results))
+				for i, repoResResult := range repoResResults {
+					assert.Equal(t, repoResResult.Title, res.Results[i].Article.Title)
+					assert.Equal(t, repoResResult.Site, res.Results[i].Article.Site)
+					assert.Equal(t, []string(repoResResult.Tags), res.Results[i].Article.Tags)
+					assert.Equal(t, repoResResult.Highlight, res.Results[i].Highlight)
+				}
+			},
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.SearchArticlesRequest{},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid argument")
+			},
+		},
+		{
+			name: "InvalidData",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().SearchArticles(gomock.Any()).Return(&[]domain.ArticleSearchResult{}, "", gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to search articles")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
+			res, err := s.SearchArticles(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestRevertArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.RevertArticleRequest
+	}
+
+	req := &pb.RevertArticleRequest{
+		ArticleId: 1,
+		Revision:  1,
+		Version:   3,
+	}
+
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+	repoResRevision := domain.ArticleRevision{ArticleID: 1, Revision: 1, Title: "old_title", Url: "https://example.com/old"}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository)
+		checkResponse func(t *testing.T, res *pb.RevertArticleResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&repoResRevision, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), uint(1)).DoAndReturn(func(article *domain.Article, columns []string, editorUserID uint) error {
+					assert.Equal(t, 3, article.Version)
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.RevertArticleResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResRevision.Title, res.Article.Title)
+			},
+		},
+		{
+			name: "VersionConflict",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&repoResRevision, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), uint(1)).Return(domain.ErrVersionConflict)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevertArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "MissingVersion",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.RevertArticleRequest{ArticleId: 1, Revision: 1},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.RevertArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&domain.ArticleRevision{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.RevertArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.RevertArticleRequest{ArticleId: 1},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.RevertArticleResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, revisionRepo)
+
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), revisionRepo)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
+			res, err := s.RevertArticle(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListRelatedArticles(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.ListRelatedArticlesRequest
+	}
+
+	repoResArticles := []domain.Article{{ID: 2, Title: "test_title2"}, {ID: 3, Title: "test_title3"}}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, res *pb.ListRelatedArticlesResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListRelatedArticlesRequest{ArticleId: 1, PageSize: 5},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{ID: 1}, nil)
+				repo.EXPECT().ListRelatedArticles(1, 5).Return(&repoResArticles, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRelatedArticlesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Articles, 2)
+				assert.Equal(t, int32(2), res.Articles[0].Id)
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListRelatedArticlesRequest{ArticleId: 1},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRelatedArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListRelatedArticlesRequest{ArticleId: 1, PageSize: -1},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRelatedArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewArticleGRPCServer(server, usecase, newTestImageUsecase(), nil)
+			res, err := s.ListRelatedArticles(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListRecommendedArticles(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.ListRecommendedArticlesRequest
+	}
+
+	repoResRecommendations := []domain.RecommendedArticle{
+		{Article: domain.Article{ID: 3, Title: "test_title3"}, Score: 0.9},
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIRecommendationRepository)
+		checkResponse func(t *testing.T, res *pb.ListRecommendedArticlesResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.ListRecommendedArticlesRequest{PageSize: 1},
+			},
+			buildStubs: func(repo *mock.MockIRecommendationRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, 1, "").Return(&repoResRecommendations, "next", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRecommendedArticlesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Articles, 1)
+				assert.Equal(t, int32(3), res.Articles[0].Id)
+				assert.Equal(t, "next", res.NextPageToken)
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.ListRecommendedArticlesRequest{PageToken: "invalid"},
+			},
+			buildStubs: func(repo *mock.MockIRecommendationRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, pagination.DefaultPageSize, "invalid").Return(&[]domain.RecommendedArticle{}, "", pagination.ErrInvalidPageToken)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRecommendedArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.ListRecommendedArticlesRequest{},
+			},
+			buildStubs: func(repo *mock.MockIRecommendationRepository) {
+				repo.EXPECT().ListRecommendedArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.RecommendedArticle{}, "", gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListRecommendedArticlesResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIRecommendationRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			articleUsecase := usecase.NewArticleUsecase(articleRepo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			recommendationUsecase := usecase.NewRecommendationUsecase(repo, articleRepo, 100)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewArticleGRPCServer(server, articleUsecase, newTestImageUsecase(), recommendationUsecase)
+			res, err := s.ListRecommendedArticles(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
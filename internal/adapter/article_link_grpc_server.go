@@ -0,0 +1,67 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IArticleLinkGRPCServer interface {
+	ListBrokenArticleLinks(ctx context.Context, req *pb.ListBrokenArticleLinksRequest) (*pb.ListBrokenArticleLinksResponse, error)
+}
+
+type articleLinkGRPCServer struct {
+	pb.UnimplementedArticleLinkServiceServer
+	usecase usecase.IArticleLinkUsecase
+}
+
+func NewArticleLinkGRPCServer(grpcServer *grpc.Server, usecase usecase.IArticleLinkUsecase) pb.ArticleLinkServiceServer {
+	server := articleLinkGRPCServer{usecase: usecase}
+	pb.RegisterArticleLinkServiceServer(grpcServer, &server)
+	return &server
+}
+
+func (server *articleLinkGRPCServer) ListBrokenArticleLinks(ctx context.Context, req *pb.ListBrokenArticleLinksRequest) (*pb.ListBrokenArticleLinksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListBrokenArticleLinksResponse{}
+	links, nextPageToken, err := server.usecase.ListBrokenArticleLinks(myContext.GetUserID(ctx), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list broken article links: %v", err)
+	}
+	for _, link := range links {
+		res.ArticleLinks = append(res.ArticleLinks, newArticleLinkPB(link))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func newArticleLinkPB(link domain.ArticleLink) *pb.ArticleLink {
+	res := &pb.ArticleLink{
+		ArticleId:    int32(link.ArticleID),
+		Title:        link.Article.Title,
+		Url:          link.Url,
+		StatusCode:   int32(link.StatusCode),
+		RedirectUrl:  link.RedirectUrl,
+		LastError:    link.LastError,
+		FailureCount: int32(link.FailureCount),
+		Broken:       link.Broken,
+		ArchivedUrl:  linkcheck.ArchivedUrl(link.Url),
+		NextCheckAt:  &timestamppb.Timestamp{Seconds: int64(link.NextCheckAt.Unix()), Nanos: int32(link.NextCheckAt.Nanosecond())},
+	}
+	if link.LastCheckedAt != nil {
+		res.LastCheckedAt = &timestamppb.Timestamp{Seconds: int64(link.LastCheckedAt.Unix()), Nanos: int32(link.LastCheckedAt.Nanosecond())}
+	}
+	return res
+}
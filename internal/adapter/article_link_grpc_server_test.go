@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestArticleLinkGRPCServer(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) pb.ArticleLinkServiceServer {
+	articleLinkUsecase := usecase.NewArticleLinkUsecase(repo, userRepo, linkcheck.NewChecker(&http.Client{Timeout: time.Second}), 7*24*time.Hour, 6*time.Hour, 3)
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	return NewArticleLinkGRPCServer(server, articleLinkUsecase)
+}
+
+func TestListBrokenArticleLinks(t *testing.T) {
+	checkedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListBrokenArticleLinksRequest
+		buildStubs    func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res *pb.ListBrokenArticleLinksResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListBrokenArticleLinksRequest{PageSize: 10},
+			buildStubs: func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().ListBrokenArticleLinks(10, "").Return(&[]domain.ArticleLink{
+					{
+						ID:            1,
+						ArticleID:     2,
+						Url:           "https://example.com/gone",
+						StatusCode:    404,
+						LastError:     "unexpected status: 404",
+						FailureCount:  3,
+						Broken:        true,
+						LastCheckedAt: &checkedAt,
+						Article:       domain.Article{ID: 2, Title: "Gone"},
+					},
+				}, "next", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBrokenArticleLinksResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.ArticleLinks, 1)
+				assert.Equal(t, int32(2), res.ArticleLinks[0].ArticleId)
+				assert.Equal(t, "Gone", res.ArticleLinks[0].Title)
+				assert.Equal(t, int32(404), res.ArticleLinks[0].StatusCode)
+				assert.Equal(t, "https://web.archive.org/web/https://example.com/gone", res.ArticleLinks[0].ArchivedUrl)
+				assert.NotNil(t, res.ArticleLinks[0].LastCheckedAt)
+				assert.Equal(t, "next", res.NextPageToken)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.ListBrokenArticleLinksRequest{PageSize: -1},
+			buildStubs: func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBrokenArticleLinksResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.ListBrokenArticleLinksRequest{},
+			buildStubs: func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1}, nil)
+				repo.EXPECT().ListBrokenArticleLinks(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBrokenArticleLinksResponse, err error) {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleLinkRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			server := newTestArticleLinkGRPCServer(repo, userRepo)
+			res, err := server.ListBrokenArticleLinks(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -107,17 +107,21 @@ func (server *authGRPCServer) RefreshToken(ctx context.Context, req *pb.RefreshT
 
 func (server *authGRPCServer) GetSigninUser(ctx context.Context, req *pb.GetSigninUserRequest) (*pb.GetSigninUserResponse, error) {
 	res := pb.GetSigninUserResponse{}
-	user, err := server.usecase.GetSigninUser(myContext.GetUserID(ctx))
+	user, unreadBookmarkCount, err := server.usecase.GetSigninUser(myContext.GetUserID(ctx))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get signin user: %v", err)
 	}
 	res.User = &pb.User{
-		Id:        int32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 	}
+	res.UnreadBookmarkCount = int32(unreadBookmarkCount)
 
 	return &res, nil
 }
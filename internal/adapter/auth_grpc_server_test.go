@@ -103,7 +103,7 @@ func TestPreSignup(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to create presignup mail manager: %v", err)
 			}
-			usecase := usecase.NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := usecase.NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 
 			server := grpc.NewServer()
 			server.GracefulStop()
@@ -204,7 +204,7 @@ func TestSignin(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := usecase.NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := usecase.NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 
 			server := grpc.NewServer()
 			server.GracefulStop()
@@ -260,7 +260,7 @@ func TestSignout(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := usecase.NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := usecase.NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 
 			server := grpc.NewServer()
 			server.GracefulStop()
@@ -331,7 +331,7 @@ func TestRefreshToken(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := usecase.NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := usecase.NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 
 			server := grpc.NewServer()
 			server.GracefulStop()
@@ -364,7 +364,7 @@ func TestGetSigninUser(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIUserRepository)
+		buildStubs    func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository)
 		checkResponse func(t *testing.T, res *pb.GetSigninUserResponse, err error)
 	}{
 		{
@@ -373,11 +373,13 @@ func TestGetSigninUser(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockIUserRepository) {
+			buildStubs: func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository) {
 				repo.EXPECT().GetUser(gomock.Any()).Return(&repoResUser, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(3, nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetSigninUserResponse, err error) {
 				assert.NoError(t, err)
+				assert.Equal(t, int32(3), res.UnreadBookmarkCount)
 			},
 		},
 		{
@@ -386,7 +388,7 @@ func TestGetSigninUser(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockIUserRepository) {
+			buildStubs: func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository) {
 				repo.EXPECT().GetUser(gomock.Any()).Return(&domain.User{}, gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetSigninUserResponse, err error) {
@@ -401,7 +403,8 @@ func TestGetSigninUser(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIUserRepository(mockCtrl)
-			tc.buildStubs(repo)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo, bookmarkRepo)
 
 			jwtAccessTokenManager := jwt.NewJwtManager("issuer", "secret", time.Duration(time.Hour*1))
 			jwtRefreshTokenManager := jwt.NewJwtManager("issuer", "secret", time.Duration(time.Hour*24*30))
@@ -410,7 +413,7 @@ func TestGetSigninUser(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := usecase.NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := usecase.NewAuthUsecase(repo, bookmarkRepo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 
 			server := grpc.NewServer()
 			server.GracefulStop()
@@ -2,12 +2,18 @@ package adapter
 
 import (
 	"context"
+	"mime"
+	"time"
 
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/pkg/bookmarkfile"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/pb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -16,19 +22,37 @@ type IBookmarkGRPCServer interface {
 	CreateBookmark(ctx context.Context, req *pb.CreateBookmarkRequest) (*pb.CreateBookmarkResponse, error)
 	GetBookmarkCountByArticleID(ctx context.Context, req *pb.GetBookmarkCountByArticleIDRequest) (*pb.GetBookmarkCountByArticleIDResponse, error)
 	ListBookmarksByUserID(ctx context.Context, req *pb.ListBookmarksByUserIDRequest) (*pb.ListBookmarksByUserIDResponse, error)
+	ListWorkspaceBookmarks(ctx context.Context, req *pb.ListWorkspaceBookmarksRequest) (*pb.ListWorkspaceBookmarksResponse, error)
 	ListBookmarksByArticleID(ctx context.Context, req *pb.ListBookmarksByArticleIDRequest) (*pb.ListBookmarksByArticleIDResponse, error)
 	DeleteBookmarkByUserIDAndArticleID(ctx context.Context, req *pb.DeleteBookmarkByUserIDAndArticleIDRequest) (*pb.DeleteBookmarkByUserIDAndArticleIDResponse, error)
 	DeleteBookmarkByUserID(ctx context.Context, req *pb.DeleteBookmarkByUserIDRequest) (*pb.DeleteBookmarkByUserIDResponse, error)
 	DeleteBookmarkByArticleID(ctx context.Context, req *pb.DeleteBookmarkByArticleIDRequest) (*pb.DeleteBookmarkByArticleIDResponse, error)
+	UpdateBookmark(ctx context.Context, req *pb.UpdateBookmarkRequest) (*pb.UpdateBookmarkResponse, error)
+	SearchBookmarks(ctx context.Context, req *pb.SearchBookmarksRequest) (*pb.SearchBookmarksResponse, error)
+	MarkBookmarksRead(ctx context.Context, req *pb.MarkBookmarksReadRequest) (*pb.MarkBookmarksReadResponse, error)
+	MarkBookmarksUnread(ctx context.Context, req *pb.MarkBookmarksUnreadRequest) (*pb.MarkBookmarksUnreadResponse, error)
+	ImportBookmarks(ctx context.Context, req *pb.ImportBookmarksRequest) (*pb.ImportBookmarksResponse, error)
+	GetBookmarkImport(ctx context.Context, req *pb.GetBookmarkImportRequest) (*pb.GetBookmarkImportResponse, error)
+	ExportBookmarks(ctx context.Context, req *pb.ExportBookmarksRequest) (*httpbody.HttpBody, error)
+}
+
+var bookmarkExportFiles = map[string]struct {
+	contentType string
+	fileName    string
+}{
+	bookmarkfile.FormatNetscape: {"text/html; charset=utf-8", "bookmarks.html"},
+	bookmarkfile.FormatCSV:      {"text/csv; charset=utf-8", "bookmarks.csv"},
+	bookmarkfile.FormatMarkdown: {"text/markdown; charset=utf-8", "bookmarks.md"},
 }
 
 type bookmarkGRPCServer struct {
 	pb.UnimplementedBookmarkServiceServer
-	usecase usecase.IBookmarkUsecase
+	usecase       usecase.IBookmarkUsecase
+	importUsecase usecase.IBookmarkImportUsecase
 }
 
-func NewBookmarkGRPCServer(grpcServer *grpc.Server, usecase usecase.IBookmarkUsecase) pb.BookmarkServiceServer {
-	server := bookmarkGRPCServer{usecase: usecase}
+func NewBookmarkGRPCServer(grpcServer *grpc.Server, usecase usecase.IBookmarkUsecase, importUsecase usecase.IBookmarkImportUsecase) pb.BookmarkServiceServer {
+	server := bookmarkGRPCServer{usecase: usecase, importUsecase: importUsecase}
 	pb.RegisterBookmarkServiceServer(grpcServer, &server)
 	return &server
 }
@@ -40,18 +64,13 @@ func (server *bookmarkGRPCServer) CreateBookmark(ctx context.Context, req *pb.Cr
 			UserID:    uint(req.UserId),
 			ArticleID: uint(req.ArticleId),
 		},
+		req.Strict,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create bookmark: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to create bookmark: %v", err)
 	}
 
-	res.Bookmark = &pb.Bookmark{
-		Id:        int32(bookmark.ID),
-		UserId:    int32(bookmark.UserID),
-		ArticleId: int32(bookmark.ArticleID),
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.CreatedAt.Unix()), Nanos: int32(bookmark.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.UpdatedAt.Unix()), Nanos: int32(bookmark.UpdatedAt.Nanosecond())},
-	}
+	res.Bookmark = newBookmarkPB(bookmark)
 
 	return &res, nil
 }
@@ -67,38 +86,69 @@ func (server *bookmarkGRPCServer) GetBookmarkCountByArticleID(ctx context.Contex
 }
 
 func (server *bookmarkGRPCServer) ListBookmarksByUserID(ctx context.Context, req *pb.ListBookmarksByUserIDRequest) (*pb.ListBookmarksByUserIDResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.ListBookmarksByUserIDResponse{}
-	bookmarkRes, err := server.usecase.ListBookmarksByUserID(int(req.UserId))
+	userID := myContext.GetUserID(ctx)
+	bookmarkRes, nextPageToken, err := server.usecase.ListBookmarksByUserID(userID, domain.BookmarkListQuery{
+		UserID:       int(req.UserId),
+		CollectionID: int(req.CollectionId),
+		Tag:          req.Tag,
+		ReadState:    req.ReadState,
+		PageSize:     int(req.PageSize),
+		PageToken:    req.PageToken,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list bookmarks by user id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to list bookmarks by user id: %v", err)
 	}
+	res.NextPageToken = nextPageToken
 	for _, bookmark := range bookmarkRes {
-		res.Bookmarks = append(res.Bookmarks, &pb.Bookmark{
-			Id:        int32(bookmark.ID),
-			UserId:    int32(bookmark.UserID),
-			ArticleId: int32(bookmark.ArticleID),
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.CreatedAt.Unix()), Nanos: int32(bookmark.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.UpdatedAt.Unix()), Nanos: int32(bookmark.UpdatedAt.Nanosecond())},
-		})
+		res.Bookmarks = append(res.Bookmarks, newVisibleBookmarkPB(userID, bookmark))
+	}
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) ListWorkspaceBookmarks(ctx context.Context, req *pb.ListWorkspaceBookmarksRequest) (*pb.ListWorkspaceBookmarksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListWorkspaceBookmarksResponse{}
+	bookmarkRes, nextPageToken, err := server.usecase.ListWorkspaceBookmarks(myContext.GetUserID(ctx), domain.BookmarkListQuery{
+		WorkspaceID:  int(req.WorkspaceId),
+		CollectionID: int(req.CollectionId),
+		Tag:          req.Tag,
+		PageSize:     int(req.PageSize),
+		PageToken:    req.PageToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list workspace bookmarks: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+	for _, bookmark := range bookmarkRes {
+		res.Bookmarks = append(res.Bookmarks, newVisibleBookmarkPB(myContext.GetUserID(ctx), bookmark))
 	}
 
 	return &res, nil
 }
 
 func (server *bookmarkGRPCServer) ListBookmarksByArticleID(ctx context.Context, req *pb.ListBookmarksByArticleIDRequest) (*pb.ListBookmarksByArticleIDResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.ListBookmarksByArticleIDResponse{}
-	bookmarkRes, err := server.usecase.ListBookmarksByArticleID(int(req.ArticleId))
+	bookmarkRes, nextPageToken, err := server.usecase.ListBookmarksByArticleID(int(req.ArticleId), int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list bookmarks by article id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to list bookmarks by article id: %v", err)
 	}
+	res.NextPageToken = nextPageToken
+	userID := myContext.GetUserID(ctx)
 	for _, bookmark := range bookmarkRes {
-		res.Bookmarks = append(res.Bookmarks, &pb.Bookmark{
-			Id:        int32(bookmark.ID),
-			UserId:    int32(bookmark.UserID),
-			ArticleId: int32(bookmark.ArticleID),
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.CreatedAt.Unix()), Nanos: int32(bookmark.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(bookmark.UpdatedAt.Unix()), Nanos: int32(bookmark.UpdatedAt.Nanosecond())},
-		})
+		res.Bookmarks = append(res.Bookmarks, newVisibleBookmarkPB(userID, bookmark))
 	}
 
 	return &res, nil
@@ -133,3 +183,235 @@ func (server *bookmarkGRPCServer) DeleteBookmarkByArticleID(ctx context.Context,
 
 	return &res, err
 }
+
+func (server *bookmarkGRPCServer) UpdateBookmark(ctx context.Context, req *pb.UpdateBookmarkRequest) (*pb.UpdateBookmarkResponse, error) {
+	populated := map[string]bool{
+		"note":       req.Note != "",
+		"highlights": len(req.Highlights) > 0,
+		"tags":       len(req.Tags) > 0,
+		"progress":   req.Progress != 0,
+		"remind_at":  req.RemindAt != nil,
+	}
+	fields, err := updateFields(req.UpdateMask, populated)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+	if err := validateFields(req.ValidateAll(), fields, populated); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	var remindAt *time.Time
+	if req.RemindAt != nil {
+		t := req.RemindAt.AsTime()
+		remindAt = &t
+	}
+
+	res := pb.UpdateBookmarkResponse{}
+	bookmark, err := server.usecase.UpdateBookmark(
+		myContext.GetUserID(ctx),
+		domain.Bookmark{
+			ID:         uint(req.Id),
+			Note:       req.Note,
+			Highlights: req.Highlights,
+			Tags:       req.Tags,
+			Progress:   int(req.Progress),
+			RemindAt:   remindAt,
+		},
+		fields,
+	)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update bookmark: %v", err)
+	}
+	res.Bookmark = newBookmarkPB(bookmark)
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) SearchBookmarks(ctx context.Context, req *pb.SearchBookmarksRequest) (*pb.SearchBookmarksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.SearchBookmarksResponse{}
+	results, nextPageToken, err := server.usecase.SearchBookmarks(domain.BookmarkSearchQuery{
+		UserID:    myContext.GetUserID(ctx),
+		Query:     req.Query,
+		Tag:       req.Tag,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to search bookmarks: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+	for _, result := range results {
+		res.Results = append(res.Results, &pb.SearchBookmarkResult{
+			Bookmark: newBookmarkPB(result.Bookmark),
+			Rank:     result.Rank,
+			Snippet:  result.Snippet,
+		})
+	}
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) MarkBookmarksRead(ctx context.Context, req *pb.MarkBookmarksReadRequest) (*pb.MarkBookmarksReadResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.MarkBookmarksReadResponse{}
+	count, err := server.usecase.MarkBookmarksRead(myContext.GetUserID(ctx), toInts(req.ArticleIds))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to mark bookmarks read: %v", err)
+	}
+	res.UpdatedCount = int32(count)
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) MarkBookmarksUnread(ctx context.Context, req *pb.MarkBookmarksUnreadRequest) (*pb.MarkBookmarksUnreadResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.MarkBookmarksUnreadResponse{}
+	count, err := server.usecase.MarkBookmarksUnread(myContext.GetUserID(ctx), toInts(req.ArticleIds))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to mark bookmarks unread: %v", err)
+	}
+	res.UpdatedCount = int32(count)
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) ImportBookmarks(ctx context.Context, req *pb.ImportBookmarksRequest) (*pb.ImportBookmarksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ImportBookmarksResponse{}
+	bookmarkImport, err := server.importUsecase.ImportBookmarks(myContext.GetUserID(ctx), req.Format, []byte(req.Data))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to import bookmarks: %v", err)
+	}
+	res.Import = newBookmarkImportPB(bookmarkImport)
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) GetBookmarkImport(ctx context.Context, req *pb.GetBookmarkImportRequest) (*pb.GetBookmarkImportResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.GetBookmarkImportResponse{}
+	bookmarkImport, err := server.importUsecase.GetBookmarkImport(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to get bookmark import: %v", err)
+	}
+	res.Import = newBookmarkImportPB(bookmarkImport)
+
+	return &res, nil
+}
+
+func (server *bookmarkGRPCServer) ExportBookmarks(ctx context.Context, req *pb.ExportBookmarksRequest) (*httpbody.HttpBody, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	format := req.Format
+	if format == "" {
+		format = bookmarkfile.FormatNetscape
+	}
+	data, err := server.usecase.ExportBookmarks(myContext.GetUserID(ctx), format)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to export bookmarks: %v", err)
+	}
+
+	file := bookmarkExportFiles[format]
+	setContentDisposition(ctx, file.fileName)
+	return &httpbody.HttpBody{ContentType: file.contentType, Data: data}, nil
+}
+
+func newBookmarkPB(bookmark domain.Bookmark) *pb.Bookmark {
+	collectionID := int32(0)
+	if bookmark.CollectionID != nil {
+		collectionID = int32(*bookmark.CollectionID)
+	}
+	var readAt, remindAt *timestamppb.Timestamp
+	if bookmark.ReadAt != nil {
+		readAt = &timestamppb.Timestamp{Seconds: int64(bookmark.ReadAt.Unix()), Nanos: int32(bookmark.ReadAt.Nanosecond())}
+	}
+	if bookmark.RemindAt != nil {
+		remindAt = &timestamppb.Timestamp{Seconds: int64(bookmark.RemindAt.Unix()), Nanos: int32(bookmark.RemindAt.Nanosecond())}
+	}
+	return &pb.Bookmark{
+		Id:           int32(bookmark.ID),
+		UserId:       int32(bookmark.UserID),
+		ArticleId:    int32(bookmark.ArticleID),
+		CollectionId: collectionID,
+		Position:     int32(bookmark.Position),
+		Note:         bookmark.Note,
+		Highlights:   bookmark.Highlights,
+		Tags:         bookmark.Tags,
+		Read:         bookmark.ReadAt != nil,
+		ReadAt:       readAt,
+		Progress:     int32(bookmark.Progress),
+		RemindAt:     remindAt,
+		CreatedAt:    &timestamppb.Timestamp{Seconds: int64(bookmark.CreatedAt.Unix()), Nanos: int32(bookmark.CreatedAt.Nanosecond())},
+		UpdatedAt:    &timestamppb.Timestamp{Seconds: int64(bookmark.UpdatedAt.Unix()), Nanos: int32(bookmark.UpdatedAt.Nanosecond())},
+	}
+}
+
+func newVisibleBookmarkPB(userID int, bookmark domain.Bookmark) *pb.Bookmark {
+	if int(bookmark.UserID) != userID {
+		bookmark.Note = ""
+		bookmark.Highlights = nil
+		bookmark.Tags = nil
+		bookmark.ReadAt = nil
+		bookmark.Progress = 0
+		bookmark.RemindAt = nil
+	}
+	return newBookmarkPB(bookmark)
+}
+
+func newBookmarkImportPB(bookmarkImport domain.BookmarkImport) *pb.BookmarkImport {
+	var startedAt, finishedAt *timestamppb.Timestamp
+	if bookmarkImport.StartedAt != nil {
+		startedAt = &timestamppb.Timestamp{Seconds: int64(bookmarkImport.StartedAt.Unix()), Nanos: int32(bookmarkImport.StartedAt.Nanosecond())}
+	}
+	if bookmarkImport.FinishedAt != nil {
+		finishedAt = &timestamppb.Timestamp{Seconds: int64(bookmarkImport.FinishedAt.Unix()), Nanos: int32(bookmarkImport.FinishedAt.Nanosecond())}
+	}
+	importErrors := []*pb.BookmarkImportError{}
+	for _, importError := range bookmarkImport.Errors {
+		importErrors = append(importErrors, &pb.BookmarkImportError{
+			ItemIndex: int32(importError.ItemIndex),
+			Url:       importError.Url,
+			Title:     importError.Title,
+			Reason:    importError.Reason,
+		})
+	}
+	return &pb.BookmarkImport{
+		Id:             int32(bookmarkImport.ID),
+		UserId:         int32(bookmarkImport.UserID),
+		Format:         bookmarkImport.Format,
+		Status:         bookmarkImport.Status,
+		TotalCount:     int32(bookmarkImport.TotalCount),
+		ProcessedCount: int32(bookmarkImport.ProcessedCount),
+		CreatedCount:   int32(bookmarkImport.CreatedCount),
+		ExistingCount:  int32(bookmarkImport.ExistingCount),
+		FailedCount:    int32(bookmarkImport.FailedCount),
+		LastError:      bookmarkImport.LastError,
+		Errors:         importErrors,
+		StartedAt:      startedAt,
+		FinishedAt:     finishedAt,
+		CreatedAt:      &timestamppb.Timestamp{Seconds: int64(bookmarkImport.CreatedAt.Unix()), Nanos: int32(bookmarkImport.CreatedAt.Nanosecond())},
+		UpdatedAt:      &timestamppb.Timestamp{Seconds: int64(bookmarkImport.UpdatedAt.Unix()), Nanos: int32(bookmarkImport.UpdatedAt.Nanosecond())},
+	}
+}
+
+func setContentDisposition(ctx context.Context, fileName string) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName})))
+}
@@ -9,9 +9,15 @@ import (
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
 	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"gorm.io/gorm"
 )
 
@@ -63,6 +69,34 @@ func TestCreateBookmark(t *testing.T) {
 				assert.Contains(t, err.Error(), "failed to create bookmark")
 			},
 		},
+		{
+			name: "Duplicate",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists)
+				repo.EXPECT().GetBookmarkByUserIDAndArticleID(1, 1).Return(&domain.Bookmark{ID: 3, UserID: 1, ArticleID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBookmarkResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(3), res.Bookmark.Id)
+			},
+		},
+		{
+			name: "DuplicateStrict",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.CreateBookmarkRequest{UserId: 1, ArticleId: 1, Strict: true},
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBookmarkResponse, err error) {
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -73,11 +107,11 @@ func TestCreateBookmark(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.CreateBookmark(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -140,11 +174,11 @@ func TestGetBookmarkCountByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.GetBookmarkCountByArticleID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -177,21 +211,23 @@ func TestListBookmarksByUserID(t *testing.T) {
 			UpdatedAt: time.Now(),
 		},
 	}
+	collectionID := uint(2)
+	readAt := time.Now()
 
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		buildStubs    func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&repoResBookmarks, nil)
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&repoResBookmarks, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error) {
 				assert.NoError(t, err)
@@ -204,14 +240,70 @@ func TestListBookmarksByUserID(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Collection",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: &pb.ListBookmarksByUserIDRequest{UserId: 1, CollectionId: 2},
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, CollectionID: 2, PageSize: pagination.DefaultPageSize}).Return(&[]domain.Bookmark{
+					{ID: 1, UserID: 1, ArticleID: 1, CollectionID: &collectionID, Position: 1},
+				}, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Bookmarks, 1)
+				assert.Equal(t, int32(2), res.Bookmarks[0].CollectionId)
+				assert.Equal(t, int32(1), res.Bookmarks[0].Position)
+			},
+		},
+		{
+			name: "PublicOtherUser",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, BookmarksPublic: true}, nil)
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{
+					{ID: 1, UserID: 1, ArticleID: 1, Note: "private note", Tags: []string{"go"}, ReadAt: &readAt, Progress: 50},
+				}, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Bookmarks, 1)
+				assert.Equal(t, int32(1), res.Bookmarks[0].ArticleId)
+				assert.Empty(t, res.Bookmarks[0].Note)
+				assert.Empty(t, res.Bookmarks[0].Tags)
+				assert.False(t, res.Bookmarks[0].Read)
+				assert.Nil(t, res.Bookmarks[0].ReadAt)
+				assert.Zero(t, res.Bookmarks[0].Progress)
+			},
+		},
+		{
+			name: "PrivateOtherUser",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1}, nil)
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
 		{
 			name: "InvalidData",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{}, gorm.ErrInvalidData)
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListBookmarksByUserIDResponse, err error) {
 				assert.Error(t, err)
@@ -226,13 +318,14 @@ func TestListBookmarksByUserID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, userRepo, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.ListBookmarksByUserID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -254,6 +347,7 @@ func TestListBookmarksByArticleID(t *testing.T) {
 			ID:        1,
 			UserID:    1,
 			ArticleID: 1,
+			Note:      "own note",
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
@@ -261,6 +355,9 @@ func TestListBookmarksByArticleID(t *testing.T) {
 			ID:        2,
 			UserID:    2,
 			ArticleID: 1,
+			Note:      "private note",
+			Tags:      []string{"go"},
+			Progress:  50,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
@@ -275,11 +372,11 @@ func TestListBookmarksByArticleID(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByArticleID(gomock.Any()).Return(&repoResBookmarks, nil)
+				repo.EXPECT().ListBookmarksByArticleID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResBookmarks, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListBookmarksByArticleIDResponse, err error) {
 				assert.NoError(t, err)
@@ -290,6 +387,26 @@ func TestListBookmarksByArticleID(t *testing.T) {
 					assert.NotNil(t, res.Bookmarks[i].CreatedAt)
 					assert.NotNil(t, res.Bookmarks[i].UpdatedAt)
 				}
+				assert.Equal(t, "own note", res.Bookmarks[0].Note)
+				assert.Empty(t, res.Bookmarks[1].Note)
+				assert.Empty(t, res.Bookmarks[1].Tags)
+				assert.Zero(t, res.Bookmarks[1].Progress)
+			},
+		},
+		{
+			name: "Anonymous",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().ListBookmarksByArticleID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResBookmarks, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListBookmarksByArticleIDResponse, err error) {
+				assert.NoError(t, err)
+				for _, bookmark := range res.Bookmarks {
+					assert.Empty(t, bookmark.Note)
+				}
 			},
 		},
 		{
@@ -299,7 +416,7 @@ func TestListBookmarksByArticleID(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByArticleID(gomock.Any()).Return(&[]domain.Bookmark{}, gorm.ErrInvalidData)
+				repo.EXPECT().ListBookmarksByArticleID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Bookmark{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListBookmarksByArticleIDResponse, err error) {
 				assert.Error(t, err)
@@ -316,17 +433,45 @@ func TestListBookmarksByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.ListBookmarksByArticleID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
 	}
 }
 
+func TestListWorkspaceBookmarks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	workspaceRepo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+	repo.EXPECT().ListBookmarksByWorkspaceID(gomock.Any()).Return(&[]domain.Bookmark{
+		{ID: 1, UserID: 1, ArticleID: 1, Note: "own note", Tags: []string{"go"}},
+		{ID: 2, UserID: 2, ArticleID: 2, Note: "private note", Highlights: []string{"highlight"}, Tags: []string{"go"}, Progress: 50},
+	}, "", nil)
+
+	usecase := usecase.NewBookmarkUsecase(repo, nil, workspaceRepo)
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	s := NewBookmarkGRPCServer(server, usecase, nil)
+	res, err := s.ListWorkspaceBookmarks(myContext.SetUserID(context.Background(), 1), &pb.ListWorkspaceBookmarksRequest{WorkspaceId: 1})
+	assert.NoError(t, err)
+	assert.Len(t, res.Bookmarks, 2)
+	assert.Equal(t, "own note", res.Bookmarks[0].Note)
+	assert.Equal(t, []string{"go"}, res.Bookmarks[0].Tags)
+	assert.Empty(t, res.Bookmarks[1].Note)
+	assert.Empty(t, res.Bookmarks[1].Highlights)
+	assert.Empty(t, res.Bookmarks[1].Tags)
+	assert.Zero(t, res.Bookmarks[1].Progress)
+}
+
 func TestDeleteBookmarkByUserIDAndArticleID(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -381,11 +526,11 @@ func TestDeleteBookmarkByUserIDAndArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.DeleteBookmarkByUserIDAndArticleID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -445,11 +590,11 @@ func TestDeleteBookmarkByUserID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.DeleteBookmarkByUserID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
@@ -509,13 +654,402 @@ func TestDeleteBookmarkByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewBookmarkUsecase(repo)
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
-			s := NewBookmarkGRPCServer(server, usecase)
+			s := NewBookmarkGRPCServer(server, usecase, nil)
 			res, err := s.DeleteBookmarkByArticleID(tc.args.ctx, tc.args.req)
 			tc.checkResponse(t, res, err)
 		})
 	}
 }
+
+func TestUpdateBookmark(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateBookmarkRequest
+		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, res *pb.UpdateBookmarkResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UpdateBookmarkRequest{Id: 1, Note: "# note", Tags: []string{"go"}},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 1, ArticleID: 1}, nil)
+				repo.EXPECT().UpdateBookmark(gomock.Any(), []string{"note", "tags"}).DoAndReturn(func(bookmark *domain.Bookmark, columns []string) error {
+					bookmark.UserID = 1
+					bookmark.ArticleID = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "# note", res.Bookmark.Note)
+				assert.Equal(t, []string{"go"}, res.Bookmark.Tags)
+				assert.Equal(t, int32(1), res.Bookmark.ArticleId)
+			},
+		},
+		{
+			name: "ClearHighlights",
+			req:  &pb.UpdateBookmarkRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"highlights"}}},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 1, ArticleID: 1}, nil)
+				repo.EXPECT().UpdateBookmark(gomock.Any(), []string{"highlights"}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkResponse, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, res.Bookmark.Highlights)
+			},
+		},
+		{
+			name: "NoFields",
+			req:  &pb.UpdateBookmarkRequest{Id: 1},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidTag",
+			req:  &pb.UpdateBookmarkRequest{Id: 1, Tags: []string{""}},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.UpdateBookmarkRequest{Id: 1, Note: "# note"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 2, ArticleID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkResponse, err error) {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, usecase, nil)
+			res, err := s.UpdateBookmark(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestSearchBookmarks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.SearchBookmarksRequest
+		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, res *pb.SearchBookmarksResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.SearchBookmarksRequest{Query: "golang", Tag: "go"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().SearchBookmarks(domain.BookmarkSearchQuery{UserID: 1, Query: "golang", Tag: "go", PageSize: 20}).
+					Return(&[]domain.BookmarkSearchResult{{Bookmark: domain.Bookmark{ID: 3, UserID: 1, ArticleID: 1}, Rank: 0.5, Snippet: "<mark>golang</mark>"}}, "next", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchBookmarksResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Results, 1)
+				assert.Equal(t, int32(3), res.Results[0].Bookmark.Id)
+				assert.Equal(t, "<mark>golang</mark>", res.Results[0].Snippet)
+				assert.Equal(t, "next", res.NextPageToken)
+			},
+		},
+		{
+			name: "EmptyQuery",
+			req:  &pb.SearchBookmarksRequest{},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchBookmarksResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, usecase, nil)
+			res, err := s.SearchBookmarks(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestMarkBookmarksRead(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.MarkBookmarksReadRequest
+		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, res *pb.MarkBookmarksReadResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.MarkBookmarksReadRequest{ArticleIds: []int32{2, 3}},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().SetBookmarksRead(1, []int{2, 3}, true).Return(2, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.MarkBookmarksReadResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(2), res.UpdatedCount)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.MarkBookmarksReadRequest{ArticleIds: []int32{2, 2}},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.MarkBookmarksReadResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewBookmarkUsecase(repo, nil, nil)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, usecase, nil)
+			res, err := s.MarkBookmarksRead(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestMarkBookmarksUnread(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	repo.EXPECT().SetBookmarksRead(1, []int{2}, false).Return(1, nil)
+
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	s := NewBookmarkGRPCServer(server, usecase.NewBookmarkUsecase(repo, nil, nil), nil)
+	res, err := s.MarkBookmarksUnread(myContext.SetUserID(context.Background(), 1), &pb.MarkBookmarksUnreadRequest{ArticleIds: []int32{2}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), res.UpdatedCount)
+}
+
+func TestImportBookmarks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.ImportBookmarksRequest
+		buildStubs    func(repo *mock.MockIBookmarkImportRepository)
+		checkResponse func(t *testing.T, res *pb.ImportBookmarksResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ImportBookmarksRequest{Format: "raindrop", Data: "title,url\nFirst,https://example.com/1\n"},
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+				repo.EXPECT().CreateBookmarkImport(gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport) error {
+					bookmarkImport.ID = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.ImportBookmarksResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(1), res.Import.Id)
+				assert.Equal(t, "pending", res.Import.Status)
+				assert.Equal(t, int32(1), res.Import.TotalCount)
+			},
+		},
+		{
+			name: "UnknownFormat",
+			req:  &pb.ImportBookmarksRequest{Format: "delicious", Data: "data"},
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.ImportBookmarksResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoBookmarks",
+			req:  &pb.ImportBookmarksRequest{Format: "netscape", Data: "<html></html>"},
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.ImportBookmarksResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			importUsecase := usecase.NewBookmarkImportUsecase(repo, nil, nil, nil, 0, time.Hour)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, nil, importUsecase)
+			res, err := s.ImportBookmarks(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetBookmarkImport(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIBookmarkImportRepository)
+		checkResponse func(t *testing.T, res *pb.GetBookmarkImportResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+				repo.EXPECT().GetBookmarkImport(1).Return(&domain.BookmarkImport{
+					ID:             1,
+					UserID:         1,
+					Status:         "running",
+					TotalCount:     200,
+					ProcessedCount: 50,
+					FailedCount:    1,
+					Errors:         []domain.BookmarkImportError{{ItemIndex: 3, Url: "invalid", Reason: "invalid url"}},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBookmarkImportResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(50), res.Import.ProcessedCount)
+				assert.Len(t, res.Import.Errors, 1)
+				assert.Equal(t, int32(3), res.Import.Errors[0].ItemIndex)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+				repo.EXPECT().GetBookmarkImport(1).Return(&domain.BookmarkImport{ID: 1, UserID: 2}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBookmarkImportResponse, err error) {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository) {
+				repo.EXPECT().GetBookmarkImport(1).Return(&domain.BookmarkImport{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetBookmarkImportResponse, err error) {
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			importUsecase := usecase.NewBookmarkImportUsecase(repo, nil, nil, nil, 100, time.Hour)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, nil, importUsecase)
+			res, err := s.GetBookmarkImport(myContext.SetUserID(context.Background(), 1), &pb.GetBookmarkImportRequest{Id: 1})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestExportBookmarks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.ExportBookmarksRequest
+		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, res *httpbody.HttpBody, err error)
+	}{
+		{
+			name: "Default",
+			req:  &pb.ExportBookmarksRequest{},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().ListBookmarkExportItems(1).Return(&[]domain.BookmarkExportItem{{Title: "First Post", Url: "https://example.com/1"}}, nil)
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "text/html; charset=utf-8", res.ContentType)
+				assert.Contains(t, string(res.Data), "https://example.com/1")
+			},
+		},
+		{
+			name: "Markdown",
+			req:  &pb.ExportBookmarksRequest{Format: "markdown"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().ListBookmarkExportItems(1).Return(&[]domain.BookmarkExportItem{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "text/markdown; charset=utf-8", res.ContentType)
+				assert.Equal(t, "# Bookmarks\n", string(res.Data))
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.ExportBookmarksRequest{Format: "json"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+			},
+			checkResponse: func(t *testing.T, res *httpbody.HttpBody, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewBookmarkGRPCServer(server, usecase.NewBookmarkUsecase(repo, nil, nil), nil)
+			res, err := s.ExportBookmarks(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
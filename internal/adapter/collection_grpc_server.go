@@ -0,0 +1,235 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ICollectionGRPCServer interface {
+	CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionResponse, error)
+	GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.GetCollectionResponse, error)
+	ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error)
+	UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.UpdateCollectionResponse, error)
+	DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error)
+	ReorderCollections(ctx context.Context, req *pb.ReorderCollectionsRequest) (*pb.ReorderCollectionsResponse, error)
+	ReorderCollectionBookmarks(ctx context.Context, req *pb.ReorderCollectionBookmarksRequest) (*pb.ReorderCollectionBookmarksResponse, error)
+	MoveBookmarks(ctx context.Context, req *pb.MoveBookmarksRequest) (*pb.MoveBookmarksResponse, error)
+	UpdateCollectionVisibility(ctx context.Context, req *pb.UpdateCollectionVisibilityRequest) (*pb.UpdateCollectionVisibilityResponse, error)
+	GetSharedCollection(ctx context.Context, req *pb.GetSharedCollectionRequest) (*pb.GetSharedCollectionResponse, error)
+}
+
+type collectionGRPCServer struct {
+	pb.UnimplementedCollectionServiceServer
+	usecase usecase.ICollectionUsecase
+}
+
+func NewCollectionGRPCServer(grpcServer *grpc.Server, usecase usecase.ICollectionUsecase) pb.CollectionServiceServer {
+	server := collectionGRPCServer{usecase: usecase}
+	pb.RegisterCollectionServiceServer(grpcServer, &server)
+	return &server
+}
+
+func (server *collectionGRPCServer) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.CreateCollectionResponse{}
+	collection := domain.Collection{
+		UserID:      uint(myContext.GetUserID(ctx)),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.WorkspaceId != 0 {
+		workspaceID := uint(req.WorkspaceId)
+		collection.WorkspaceID = &workspaceID
+	}
+	collection, err := server.usecase.CreateCollection(collection)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to create collection: %v", err)
+	}
+	res.Collection = newCollectionPB(collection)
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.GetCollectionResponse, error) {
+	res := pb.GetCollectionResponse{}
+	collection, err := server.usecase.GetCollection(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to get collection: %v", err)
+	}
+	res.Collection = newCollectionPB(collection)
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListCollectionsResponse{}
+	collections, nextPageToken, err := server.usecase.ListCollections(myContext.GetUserID(ctx), int(req.WorkspaceId), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list collections: %v", err)
+	}
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, newCollectionPB(collection))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.UpdateCollectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.UpdateCollectionResponse{}
+	collection, err := server.usecase.UpdateCollection(
+		myContext.GetUserID(ctx),
+		domain.Collection{
+			ID:          uint(req.Id),
+			Name:        req.Name,
+			Description: req.Description,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update collection: %v", err)
+	}
+	res.Collection = newCollectionPB(collection)
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	res := pb.DeleteCollectionResponse{}
+	err := server.usecase.DeleteCollection(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to delete collection: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) ReorderCollections(ctx context.Context, req *pb.ReorderCollectionsRequest) (*pb.ReorderCollectionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ReorderCollectionsResponse{}
+	err := server.usecase.ReorderCollections(myContext.GetUserID(ctx), toInts(req.CollectionIds))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to reorder collections: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) ReorderCollectionBookmarks(ctx context.Context, req *pb.ReorderCollectionBookmarksRequest) (*pb.ReorderCollectionBookmarksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ReorderCollectionBookmarksResponse{}
+	err := server.usecase.ReorderCollectionBookmarks(myContext.GetUserID(ctx), int(req.CollectionId), toInts(req.BookmarkIds))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to reorder collection bookmarks: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) MoveBookmarks(ctx context.Context, req *pb.MoveBookmarksRequest) (*pb.MoveBookmarksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.MoveBookmarksResponse{}
+	err := server.usecase.MoveBookmarks(myContext.GetUserID(ctx), toInts(req.BookmarkIds), int(req.CollectionId))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to move bookmarks: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) UpdateCollectionVisibility(ctx context.Context, req *pb.UpdateCollectionVisibilityRequest) (*pb.UpdateCollectionVisibilityResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.UpdateCollectionVisibilityResponse{}
+	collection, err := server.usecase.UpdateCollectionVisibility(myContext.GetUserID(ctx), int(req.Id), req.Visibility)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update collection visibility: %v", err)
+	}
+	res.Collection = newCollectionPB(collection)
+
+	return &res, nil
+}
+
+func (server *collectionGRPCServer) GetSharedCollection(ctx context.Context, req *pb.GetSharedCollectionRequest) (*pb.GetSharedCollectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.GetSharedCollectionResponse{}
+	collection, items, nextPageToken, err := server.usecase.GetSharedCollection(req.ShareToken, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to get shared collection: %v", err)
+	}
+	res.Collection = newCollectionPB(collection)
+	for _, item := range items {
+		res.Items = append(res.Items, &pb.SharedCollectionItem{
+			ArticleId:   int32(item.Article.ID),
+			Title:       item.Article.Title,
+			Url:         item.Article.Url,
+			Image:       item.Article.Image,
+			Description: item.Article.Description,
+			Site:        item.Article.Site,
+			Tags:        item.Article.Tags,
+			AddedAt:     &timestamppb.Timestamp{Seconds: int64(item.AddedAt.Unix()), Nanos: int32(item.AddedAt.Nanosecond())},
+		})
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func newCollectionPB(collection domain.Collection) *pb.Collection {
+	var workspaceID int32
+	if collection.WorkspaceID != nil {
+		workspaceID = int32(*collection.WorkspaceID)
+	}
+	return &pb.Collection{
+		Id:          int32(collection.ID),
+		UserId:      int32(collection.UserID),
+		WorkspaceId: workspaceID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		Position:    int32(collection.Position),
+		Visibility:  collection.Visibility,
+		ShareToken:  collection.ShareToken,
+		CreatedAt:   &timestamppb.Timestamp{Seconds: int64(collection.CreatedAt.Unix()), Nanos: int32(collection.CreatedAt.Nanosecond())},
+		UpdatedAt:   &timestamppb.Timestamp{Seconds: int64(collection.UpdatedAt.Unix()), Nanos: int32(collection.UpdatedAt.Nanosecond())},
+	}
+}
+
+func toInts(ids []int32) []int {
+	ints := make([]int, 0, len(ids))
+	for _, id := range ids {
+		ints = append(ints, int(id))
+	}
+	return ints
+}
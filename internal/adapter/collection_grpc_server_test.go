@@ -0,0 +1,361 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func newTestCollectionGRPCServer(repo *mock.MockICollectionRepository) pb.CollectionServiceServer {
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	return NewCollectionGRPCServer(server, usecase.NewCollectionUsecase(repo, nil, nil, nil))
+}
+
+func TestCreateCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.CreateCollectionRequest
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res *pb.CreateCollectionResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateCollectionRequest{Name: "reading", Description: "to read"},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "reading").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateCollection(gomock.Any()).DoAndReturn(func(collection *domain.Collection) error {
+					collection.ID = 1
+					collection.Position = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCollectionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(1), res.Collection.Id)
+				assert.Equal(t, int32(1), res.Collection.UserId)
+				assert.Equal(t, "to read", res.Collection.Description)
+			},
+		},
+		{
+			name: "AlreadyExists",
+			req:  &pb.CreateCollectionRequest{Name: "reading"},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "reading").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCollectionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.CreateCollectionRequest{},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateCollectionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestCollectionGRPCServer(repo)
+			res, err := s.CreateCollection(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res *pb.GetCollectionResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetCollectionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "reading", res.Collection.Name)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 2, Name: "reading"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetCollectionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetCollectionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestCollectionGRPCServer(repo)
+			res, err := s.GetCollection(myContext.SetUserID(context.Background(), 1), &pb.GetCollectionRequest{Id: 1})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListCollections(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockICollectionRepository(mockCtrl)
+	repo.EXPECT().ListCollectionsByUserID(1, 10, "").Return(&[]domain.Collection{
+		{ID: 2, UserID: 1, Name: "reading", Position: 1},
+		{ID: 1, UserID: 1, Name: "later", Position: 2},
+	}, "next", nil)
+
+	s := newTestCollectionGRPCServer(repo)
+	res, err := s.ListCollections(myContext.SetUserID(context.Background(), 1), &pb.ListCollectionsRequest{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Len(t, res.Collections, 2)
+	assert.Equal(t, int32(2), res.Collections[0].Id)
+	assert.Equal(t, "next", res.NextPageToken)
+}
+
+func TestReorderCollections(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.ReorderCollectionsRequest
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res *pb.ReorderCollectionsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ReorderCollectionsRequest{CollectionIds: []int32{3, 1, 2}},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().ReorderCollections(1, []int{3, 1, 2}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReorderCollectionsResponse, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "DuplicatedIDs",
+			req:  &pb.ReorderCollectionsRequest{CollectionIds: []int32{1, 1}},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.ReorderCollectionsResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestCollectionGRPCServer(repo)
+			res, err := s.ReorderCollections(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestMoveBookmarks(t *testing.T) {
+	collectionID := uint(2)
+
+	testCases := []struct {
+		name          string
+		req           *pb.MoveBookmarksRequest
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res *pb.MoveBookmarksResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.MoveBookmarksRequest{BookmarkIds: []int32{4, 5}, CollectionId: 2},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(2).Return(&domain.Collection{ID: 2, UserID: 1}, nil)
+				repo.EXPECT().MoveBookmarks(1, []int{4, 5}, &collectionID).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.MoveBookmarksResponse, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "BookmarkNotFound",
+			req:  &pb.MoveBookmarksRequest{BookmarkIds: []int32{4, 5}},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().MoveBookmarks(1, []int{4, 5}, nil).Return(gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.MoveBookmarksResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.MoveBookmarksRequest{CollectionId: 2},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.MoveBookmarksResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestCollectionGRPCServer(repo)
+			res, err := s.MoveBookmarks(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUpdateCollectionVisibility(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateCollectionVisibilityRequest
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res *pb.UpdateCollectionVisibilityResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UpdateCollectionVisibilityRequest{Id: 1, Visibility: "public"},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading", Visibility: "private", ShareToken: "token"}, nil)
+				repo.EXPECT().UpdateCollectionVisibility(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateCollectionVisibilityResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "public", res.Collection.Visibility)
+				assert.Equal(t, "token", res.Collection.ShareToken)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.UpdateCollectionVisibilityRequest{Id: 1, Visibility: "everyone"},
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().UpdateCollectionVisibility(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateCollectionVisibilityResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestCollectionGRPCServer(repo)
+			res, err := s.UpdateCollectionVisibility(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetSharedCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, res *pb.GetSharedCollectionResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading", Visibility: "public"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{{ID: 1, ArticleID: 3, Note: "private note"}}, "", nil)
+				articleRepo.EXPECT().ListArticlesByIDs([]int{3}).Return(&[]domain.Article{{ID: 3, Title: "title", Url: "https://example.com"}}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSharedCollectionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "reading", res.Collection.Name)
+				assert.Len(t, res.Items, 1)
+				assert.Equal(t, int32(3), res.Items[0].ArticleId)
+				assert.Equal(t, "https://example.com", res.Items[0].Url)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading", Visibility: "private"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetSharedCollectionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo, bookmarkRepo, articleRepo)
+
+			server := grpc.NewServer()
+			server.GracefulStop()
+			s := NewCollectionGRPCServer(server, usecase.NewCollectionUsecase(repo, bookmarkRepo, articleRepo, nil))
+			res, err := s.GetSharedCollection(context.Background(), &pb.GetSharedCollectionRequest{ShareToken: "token"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
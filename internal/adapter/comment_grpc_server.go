@@ -5,6 +5,7 @@ import (
 
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,14 +13,18 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const deletedCommentContent = "[deleted]"
+
 type ICommentGRPCServer interface {
 	CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error)
 	ListCommentsByUserID(ctx context.Context, req *pb.ListCommentsByUserIDRequest) (*pb.ListCommentsByUserIDResponse, error)
 	ListCommentsByArticleID(ctx context.Context, req *pb.ListCommentsByArticleIDRequest) (*pb.ListCommentsByArticleIDResponse, error)
+	ListWorkspaceComments(ctx context.Context, req *pb.ListWorkspaceCommentsRequest) (*pb.ListWorkspaceCommentsResponse, error)
 	DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error)
 	DeleteCommentByUserIDAndArticleID(ctx context.Context, req *pb.DeleteCommentByUserIDAndArticleIDRequest) (*pb.DeleteCommentByUserIDAndArticleIDResponse, error)
 	DeleteCommentByUserID(ctx context.Context, req *pb.DeleteCommentByUserIDRequest) (*pb.DeleteCommentByUserIDResponse, error)
 	DeleteCommentByArticleID(ctx context.Context, req *pb.DeleteCommentByArticleIDRequest) (*pb.DeleteCommentByArticleIDResponse, error)
+	RestoreComment(ctx context.Context, req *pb.RestoreCommentRequest) (*pb.RestoreCommentResponse, error)
 }
 
 type commentGRPCServer struct {
@@ -39,64 +44,78 @@ func (server *commentGRPCServer) CreateComment(ctx context.Context, req *pb.Crea
 	}
 
 	res := pb.CreateCommentResponse{}
-	comment, err := server.usecase.CreateComment(
-		domain.Comment{
-			UserID:    uint(req.UserId),
-			ArticleID: uint(req.ArticleId),
-			Content:   req.Content,
-		},
-	)
+	comment := domain.Comment{
+		UserID:    uint(myContext.GetUserID(ctx)),
+		ArticleID: uint(req.ArticleId),
+		Content:   req.Content,
+	}
+	if req.ParentCommentId != 0 {
+		parentCommentID := uint(req.ParentCommentId)
+		comment.ParentCommentID = &parentCommentID
+	}
+	if req.WorkspaceId != 0 {
+		workspaceID := uint(req.WorkspaceId)
+		comment.WorkspaceID = &workspaceID
+	}
+	comment, err := server.usecase.CreateComment(comment)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to create comment: %v", err)
 	}
 
-	res.Comment = &pb.Comment{
-		Id:        int32(comment.ID),
-		UserId:    int32(comment.UserID),
-		ArticleId: int32(comment.ArticleID),
-		Content:   comment.Content,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(comment.CreatedAt.Unix()), Nanos: int32(comment.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(comment.UpdatedAt.Unix()), Nanos: int32(comment.UpdatedAt.Nanosecond())},
-	}
+	res.Comment = newCommentPB(comment)
 
 	return &res, nil
 }
 
 func (server *commentGRPCServer) ListCommentsByUserID(ctx context.Context, req *pb.ListCommentsByUserIDRequest) (*pb.ListCommentsByUserIDResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.ListCommentsByUserIDResponse{}
-	commentRes, err := server.usecase.ListCommentsByUserID(int(req.UserId))
+	commentRes, nextPageToken, err := server.usecase.ListCommentsByUserID(int(req.UserId), int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list comments by user id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to list comments by user id: %v", err)
 	}
+	res.NextPageToken = nextPageToken
 	for _, comment := range commentRes {
-		res.Comments = append(res.Comments, &pb.Comment{
-			Id:        int32(comment.ID),
-			UserId:    int32(comment.UserID),
-			ArticleId: int32(comment.ArticleID),
-			Content:   comment.Content,
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(comment.CreatedAt.Unix()), Nanos: int32(comment.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(comment.UpdatedAt.Unix()), Nanos: int32(comment.UpdatedAt.Nanosecond())},
-		})
+		res.Comments = append(res.Comments, newCommentPB(comment))
 	}
 
 	return &res, nil
 }
 
 func (server *commentGRPCServer) ListCommentsByArticleID(ctx context.Context, req *pb.ListCommentsByArticleIDRequest) (*pb.ListCommentsByArticleIDResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
 	res := pb.ListCommentsByArticleIDResponse{}
-	commentRes, err := server.usecase.ListCommentsByArticleID(int(req.ArticleId))
+	commentRes, nextPageToken, err := server.usecase.ListCommentsByArticleID(int(req.ArticleId), int(req.ParentCommentId), int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list comments by article id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to list comments by article id: %v", err)
 	}
+	res.NextPageToken = nextPageToken
 	for _, comment := range commentRes {
-		res.Comments = append(res.Comments, &pb.Comment{
-			Id:        int32(comment.ID),
-			UserId:    int32(comment.UserID),
-			ArticleId: int32(comment.ArticleID),
-			Content:   comment.Content,
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(comment.CreatedAt.Unix()), Nanos: int32(comment.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(comment.UpdatedAt.Unix()), Nanos: int32(comment.UpdatedAt.Nanosecond())},
-		})
+		res.Comments = append(res.Comments, newCommentPB(comment))
+	}
+
+	return &res, nil
+}
+
+func (server *commentGRPCServer) ListWorkspaceComments(ctx context.Context, req *pb.ListWorkspaceCommentsRequest) (*pb.ListWorkspaceCommentsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListWorkspaceCommentsResponse{}
+	commentRes, nextPageToken, err := server.usecase.ListWorkspaceComments(myContext.GetUserID(ctx), int(req.WorkspaceId), int(req.ArticleId), int(req.ParentCommentId), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list workspace comments: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+	for _, comment := range commentRes {
+		res.Comments = append(res.Comments, newCommentPB(comment))
 	}
 
 	return &res, nil
@@ -104,9 +123,9 @@ func (server *commentGRPCServer) ListCommentsByArticleID(ctx context.Context, re
 
 func (server *commentGRPCServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
 	res := pb.DeleteCommentResponse{}
-	err := server.usecase.DeleteComment(int(req.Id))
+	err := server.usecase.DeleteComment(myContext.GetUserID(ctx), int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to delete comment: %v", err)
 	}
 
 	return &res, err
@@ -114,9 +133,9 @@ func (server *commentGRPCServer) DeleteComment(ctx context.Context, req *pb.Dele
 
 func (server *commentGRPCServer) DeleteCommentByUserIDAndArticleID(ctx context.Context, req *pb.DeleteCommentByUserIDAndArticleIDRequest) (*pb.DeleteCommentByUserIDAndArticleIDResponse, error) {
 	res := pb.DeleteCommentByUserIDAndArticleIDResponse{}
-	err := server.usecase.DeleteCommentByUserIDAndArticleID(int(req.UserId), int(req.ArticleId))
+	err := server.usecase.DeleteCommentByUserIDAndArticleID(myContext.GetUserID(ctx), int(req.UserId), int(req.ArticleId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete comment by user id and article id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to delete comment by user id and article id: %v", err)
 	}
 
 	return &res, err
@@ -124,9 +143,9 @@ func (server *commentGRPCServer) DeleteCommentByUserIDAndArticleID(ctx context.C
 
 func (server *commentGRPCServer) DeleteCommentByUserID(ctx context.Context, req *pb.DeleteCommentByUserIDRequest) (*pb.DeleteCommentByUserIDResponse, error) {
 	res := pb.DeleteCommentByUserIDResponse{}
-	err := server.usecase.DeleteCommentByUserID(int(req.UserId))
+	err := server.usecase.DeleteCommentByUserID(myContext.GetUserID(ctx), int(req.UserId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete comment by user id and article id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to delete comment by user id and article id: %v", err)
 	}
 
 	return &res, err
@@ -134,10 +153,48 @@ func (server *commentGRPCServer) DeleteCommentByUserID(ctx context.Context, req
 
 func (server *commentGRPCServer) DeleteCommentByArticleID(ctx context.Context, req *pb.DeleteCommentByArticleIDRequest) (*pb.DeleteCommentByArticleIDResponse, error) {
 	res := pb.DeleteCommentByArticleIDResponse{}
-	err := server.usecase.DeleteCommentByArticleID(int(req.ArticleId))
+	err := server.usecase.DeleteCommentByArticleID(myContext.GetUserID(ctx), int(req.ArticleId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete comment by user id and article id: %v", err)
+		return nil, status.Errorf(errorCode(err), "failed to delete comment by user id and article id: %v", err)
 	}
 
 	return &res, err
 }
+
+func (server *commentGRPCServer) RestoreComment(ctx context.Context, req *pb.RestoreCommentRequest) (*pb.RestoreCommentResponse, error) {
+	res := pb.RestoreCommentResponse{}
+	comment, err := server.usecase.RestoreComment(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to restore comment: %v", err)
+	}
+	res.Comment = newCommentPB(comment)
+
+	return &res, nil
+}
+
+func newCommentPB(comment domain.Comment) *pb.Comment {
+	var workspaceID, parentCommentID int32
+	if comment.WorkspaceID != nil {
+		workspaceID = int32(*comment.WorkspaceID)
+	}
+	if comment.ParentCommentID != nil {
+		parentCommentID = int32(*comment.ParentCommentID)
+	}
+	if comment.DeletedAt.Valid {
+		comment.UserID = 0
+		comment.Content = deletedCommentContent
+	}
+	return &pb.Comment{
+		Id:              int32(comment.ID),
+		UserId:          int32(comment.UserID),
+		ArticleId:       int32(comment.ArticleID),
+		WorkspaceId:     workspaceID,
+		ParentCommentId: parentCommentID,
+		Depth:           int32(comment.Depth),
+		ReplyCount:      int32(comment.ReplyCount),
+		Content:         comment.Content,
+		Deleted:         comment.DeletedAt.Valid,
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(comment.CreatedAt.Unix()), Nanos: int32(comment.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(comment.UpdatedAt.Unix()), Nanos: int32(comment.UpdatedAt.Nanosecond())},
+	}
+}
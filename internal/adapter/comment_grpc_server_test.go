@@ -9,9 +9,12 @@ import (
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
 	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -22,7 +25,6 @@ func TestCreateComment(t *testing.T) {
 	}
 
 	req := &pb.CreateCommentRequest{
-		UserId:    1,
 		ArticleId: 1,
 		Content:   "test_content",
 	}
@@ -36,7 +38,7 @@ func TestCreateComment(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
@@ -44,7 +46,7 @@ func TestCreateComment(t *testing.T) {
 			},
 			checkResponse: func(t *testing.T, res *pb.CreateCommentResponse, err error) {
 				assert.NoError(t, err)
-				assert.Equal(t, req.UserId, res.Comment.UserId)
+				assert.Equal(t, int32(1), res.Comment.UserId)
 				assert.Equal(t, req.ArticleId, res.Comment.ArticleId)
 				assert.NotNil(t, res.Comment.CreatedAt)
 				assert.NotNil(t, res.Comment.UpdatedAt)
@@ -87,7 +89,7 @@ func TestCreateComment(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -140,7 +142,7 @@ func TestListCommentsByUserID(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByUserID(gomock.Any()).Return(&repoResComments, nil)
+				repo.EXPECT().ListCommentsByUserID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResComments, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListCommentsByUserIDResponse, err error) {
 				assert.NoError(t, err)
@@ -160,7 +162,7 @@ func TestListCommentsByUserID(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByUserID(gomock.Any()).Return(&[]domain.Comment{}, gorm.ErrInvalidData)
+				repo.EXPECT().ListCommentsByUserID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Comment{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListCommentsByUserIDResponse, err error) {
 				assert.Error(t, err)
@@ -177,7 +179,7 @@ func TestListCommentsByUserID(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -230,7 +232,7 @@ func TestListCommentsByArticleID(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByArticleID(gomock.Any()).Return(&repoResComments, nil)
+				repo.EXPECT().ListCommentsByArticleID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResComments, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListCommentsByArticleIDResponse, err error) {
 				assert.NoError(t, err)
@@ -243,6 +245,42 @@ func TestListCommentsByArticleID(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Replies",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ListCommentsByArticleIDRequest{ArticleId: 1, ParentCommentId: 1},
+			},
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				parentCommentID := uint(1)
+				repo.EXPECT().ListCommentsByArticleID(1, 1, gomock.Any(), "").Return(&[]domain.Comment{{ID: 3, UserID: 1, ArticleID: 1, ParentCommentID: &parentCommentID, Depth: 1, ReplyCount: 2}}, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListCommentsByArticleIDResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Comments, 1)
+				assert.Equal(t, int32(1), res.Comments[0].ParentCommentId)
+				assert.Equal(t, int32(1), res.Comments[0].Depth)
+				assert.Equal(t, int32(2), res.Comments[0].ReplyCount)
+			},
+		},
+		{
+			name: "DeletedParent",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().ListCommentsByArticleID(1, 0, gomock.Any(), "").Return(&[]domain.Comment{{ID: 1, UserID: 1, ArticleID: 1, Content: "test_content", ReplyCount: 1, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}}}, "", nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListCommentsByArticleIDResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Comments, 1)
+				assert.True(t, res.Comments[0].Deleted)
+				assert.Equal(t, "[deleted]", res.Comments[0].Content)
+				assert.Equal(t, int32(0), res.Comments[0].UserId)
+				assert.Equal(t, int32(1), res.Comments[0].ReplyCount)
+			},
+		},
 		{
 			name: "InvalidData",
 			args: args{
@@ -250,7 +288,7 @@ func TestListCommentsByArticleID(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByArticleID(gomock.Any()).Return(&[]domain.Comment{}, gorm.ErrInvalidData)
+				repo.EXPECT().ListCommentsByArticleID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Comment{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListCommentsByArticleIDResponse, err error) {
 				assert.Error(t, err)
@@ -267,7 +305,7 @@ func TestListCommentsByArticleID(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -291,33 +329,51 @@ func TestDeleteComment(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, res *pb.DeleteCommentResponse, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteComment(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, UserID: 1}, nil)
+				repo.EXPECT().DeleteComment(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentResponse, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, UserID: 1}, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteCommentResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteComment(gomock.Any()).Return(gorm.ErrRecordNotFound)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{}, gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentResponse, err error) {
 				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
 				assert.Contains(t, err.Error(), "failed to delete comment")
 			},
 		},
@@ -329,9 +385,10 @@ func TestDeleteComment(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -356,16 +413,16 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, res *pb.DeleteCommentByUserIDAndArticleIDResponse, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
 				repo.EXPECT().DeleteCommentByUserIDAndArticleID(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByUserIDAndArticleIDResponse, err error) {
@@ -375,10 +432,10 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 		{
 			name: "NotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
 				repo.EXPECT().DeleteCommentByUserIDAndArticleID(gomock.Any(), gomock.Any()).Return(gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByUserIDAndArticleIDResponse, err error) {
@@ -394,9 +451,10 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -420,29 +478,44 @@ func TestDeleteCommentByUserID(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, res *pb.DeleteCommentByUserIDResponse, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
 				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByUserIDResponse, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteCommentByUserIDResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
 				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Return(gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByUserIDResponse, err error) {
@@ -458,9 +531,10 @@ func TestDeleteCommentByUserID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -484,16 +558,17 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, res *pb.DeleteCommentByArticleIDResponse, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
 				repo.EXPECT().DeleteCommentByArticleID(gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByArticleIDResponse, err error) {
@@ -503,10 +578,11 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 		{
 			name: "NotFound",
 			args: args{
-				ctx: context.Background(),
+				ctx: myContext.SetUserID(context.Background(), 1),
 				req: req,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
 				repo.EXPECT().DeleteCommentByArticleID(gomock.Any()).Return(gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, res *pb.DeleteCommentByArticleIDResponse, err error) {
@@ -522,9 +598,10 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := usecase.NewCommentUsecase(repo)
+			usecase := usecase.NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			server := grpc.NewServer()
 			server.GracefulStop()
 
@@ -534,3 +611,96 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreComment(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.RestoreCommentRequest
+	}
+
+	req := &pb.RestoreCommentRequest{
+		Id: 1,
+	}
+
+	repoResComment := domain.Comment{
+		ID:        1,
+		UserID:    1,
+		ArticleID: 1,
+		Content:   "test_content",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res *pb.RestoreCommentResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().RestoreComment(1).Return(nil)
+				repo.EXPECT().GetComment(1).Return(&repoResComment, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RestoreCommentResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResComment.Content, res.Comment.Content)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().RestoreComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RestoreCommentResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().RestoreComment(1).Return(gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.RestoreCommentResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICommentRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			usecase := usecase.NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewCommentGRPCServer(server, usecase)
+			res, err := s.RestoreComment(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
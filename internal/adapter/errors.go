@@ -0,0 +1,27 @@
+package adapter
+
+import (
+	"errors"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, pagination.ErrInvalidPageToken), errors.Is(err, domain.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, domain.ErrVersionConflict):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
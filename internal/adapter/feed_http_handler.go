@@ -0,0 +1,155 @@
+package adapter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/pkg/feed"
+	"gorm.io/gorm"
+)
+
+const feedItemLimit = 50
+
+var feedFormats = map[string]string{
+	"atom": feed.FormatAtom,
+	"rss":  feed.FormatRSS,
+	"json": feed.FormatJSON,
+}
+
+type feedHTTPHandler struct {
+	articleUsecase    usecase.IArticleUsecase
+	userUsecase       usecase.IUserUsecase
+	collectionUsecase usecase.ICollectionUsecase
+}
+
+func NewFeedHTTPHandler(articleUsecase usecase.IArticleUsecase, userUsecase usecase.IUserUsecase, collectionUsecase usecase.ICollectionUsecase) http.Handler {
+	return &feedHTTPHandler{articleUsecase, userUsecase, collectionUsecase}
+}
+
+func (handler *feedHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/feeds/")
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		http.NotFound(w, r)
+		return
+	}
+	name := path[:i]
+	format, ok := feedFormats[path[i+1:]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	base := baseURL(r)
+	out := &feed.Feed{Link: base + "/", FeedUrl: base + r.URL.Path}
+	var articles []domain.Article
+	var err error
+	switch {
+	case name == "articles":
+		out.Title = "Techbranch"
+		out.Description = "Latest articles on Techbranch"
+		articles, _, err = handler.articleUsecase.ListArticles(domain.ArticleOrderNewest, feedItemLimit, "")
+	case strings.HasPrefix(name, "tags/") && len(name) > len("tags/"):
+		tag := strings.TrimPrefix(name, "tags/")
+		out.Title = fmt.Sprintf("Techbranch - %s", tag)
+		out.Description = fmt.Sprintf("Latest articles tagged %s on Techbranch", tag)
+		articles, _, err = handler.articleUsecase.ListArticlesByTag(tag, feedItemLimit, "")
+	case strings.HasPrefix(name, "users/") && strings.HasSuffix(name, "/bookmarks"):
+		userID, convErr := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "users/"), "/bookmarks"))
+		if convErr != nil {
+			http.NotFound(w, r)
+			return
+		}
+		user, userErr := handler.userUsecase.GetUser(userID)
+		if errors.Is(userErr, gorm.ErrRecordNotFound) || (userErr == nil && !user.BookmarksPublic) {
+			http.NotFound(w, r)
+			return
+		}
+		if userErr != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		out.Title = fmt.Sprintf("Techbranch - %s's bookmarks", user.Username)
+		out.Description = fmt.Sprintf("Articles bookmarked by %s on Techbranch", user.Username)
+		articles, _, err = handler.articleUsecase.GetBookmarkedArticles(userID, "", feedItemLimit, "")
+	case strings.HasPrefix(name, "collections/") && len(name) > len("collections/"):
+		collection, items, _, collectionErr := handler.collectionUsecase.GetSharedCollection(strings.TrimPrefix(name, "collections/"), feedItemLimit, "")
+		if errors.Is(collectionErr, gorm.ErrRecordNotFound) || (collectionErr == nil && collection.Visibility != domain.CollectionVisibilityPublic) {
+			http.NotFound(w, r)
+			return
+		}
+		if collectionErr != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		out.Title = fmt.Sprintf("Techbranch - %s", collection.Name)
+		out.Description = collection.Description
+		for _, item := range items {
+			article := item.Article
+			article.CreatedAt = item.AddedAt
+			articles = append(articles, article)
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	out.Updated = time.Unix(0, 0).UTC()
+	for _, article := range articles {
+		out.Items = append(out.Items, feed.Item{
+			ID:          fmt.Sprintf("%s/v1/articles/%d", base, article.ID),
+			Title:       article.Title,
+			Url:         article.Url,
+			Description: article.Description,
+			Body:        article.Body,
+			Image:       article.Image,
+			Tags:        article.Tags,
+			PublishedAt: article.CreatedAt,
+			UpdatedAt:   article.UpdatedAt,
+		})
+		if article.UpdatedAt.After(out.Updated) {
+			out.Updated = article.UpdatedAt
+		}
+	}
+
+	body, err := feed.Render(format, out)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	contentType, _ := feed.ContentType(format)
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", fmt.Sprintf(`"%x"`, sum[:16]))
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	http.ServeContent(w, r, "", out.Updated, bytes.NewReader(body))
+}
+
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return fmt.Sprintf("%s://%s", scheme, r.Host)
+}
@@ -0,0 +1,260 @@
+package adapter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFeedHTTPHandler(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	repoResArticles := []domain.Article{
+		{
+			ID:          1,
+			Title:       "test_Article1",
+			Url:         "https://example1.com",
+			Description: "test_description",
+			Tags:        []string{"go"},
+			CreatedAt:   updatedAt,
+			UpdatedAt:   updatedAt,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		header        map[string]string
+		buildStubs    func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "Atom",
+			method: http.MethodGet,
+			path:   "/feeds/articles.atom",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				articleRepo.EXPECT().ListArticles(domain.ArticleOrderNewest, feedItemLimit, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/atom+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.NotEmpty(t, rec.Header().Get("ETag"))
+				assert.Equal(t, updatedAt.Format(http.TimeFormat), rec.Header().Get("Last-Modified"))
+				assert.Contains(t, rec.Body.String(), "<id>http://example.com/v1/articles/1</id>")
+				assert.Contains(t, rec.Body.String(), `<category term="go"></category>`)
+			},
+		},
+		{
+			name:   "RSS",
+			method: http.MethodGet,
+			path:   "/feeds/tags/go.rss",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				articleRepo.EXPECT().ListArticlesByTag("go", feedItemLimit, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/rss+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Body.String(), "<link>https://example1.com</link>")
+				assert.Contains(t, rec.Body.String(), "<pubDate>Tue, 02 Jan 2024 03:04:05 +0000</pubDate>")
+			},
+		},
+		{
+			name:   "JSONFeed",
+			method: http.MethodGet,
+			path:   "/feeds/users/1/bookmarks.json",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "test_username", BookmarksPublic: true}, nil)
+				articleRepo.EXPECT().GetBookmarkedArticles(1, "", feedItemLimit, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/feed+json; charset=utf-8", rec.Header().Get("Content-Type"))
+				res := struct {
+					Version string `json:"version"`
+					Items   []struct {
+						ID  string `json:"id"`
+						Url string `json:"url"`
+					} `json:"items"`
+				}{}
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+				assert.Equal(t, "https://jsonfeed.org/version/1.1", res.Version)
+				assert.Len(t, res.Items, 1)
+				assert.Equal(t, "https://example1.com", res.Items[0].Url)
+			},
+		},
+		{
+			name:   "PrivateBookmarks",
+			method: http.MethodGet,
+			path:   "/feeds/users/1/bookmarks.json",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "test_username"}, nil)
+				articleRepo.EXPECT().GetBookmarkedArticles(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+		{
+			name:   "UserNotFound",
+			method: http.MethodGet,
+			path:   "/feeds/users/1/bookmarks.atom",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+		{
+			name:   "NotModifiedSince",
+			method: http.MethodGet,
+			path:   "/feeds/articles.rss",
+			header: map[string]string{"If-Modified-Since": updatedAt.Format(http.TimeFormat)},
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				articleRepo.EXPECT().ListArticles(domain.ArticleOrderNewest, feedItemLimit, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+				assert.Empty(t, rec.Body.String())
+			},
+		},
+		{
+			name:   "UnsupportedFormat",
+			method: http.MethodGet,
+			path:   "/feeds/articles.html",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+		{
+			name:   "MethodNotAllowed",
+			method: http.MethodPost,
+			path:   "/feeds/articles.atom",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(articleRepo, userRepo)
+
+			handler := NewFeedHTTPHandler(usecase.NewArticleUsecase(articleRepo, userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl)), usecase.NewUserUsecase(userRepo), nil)
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			for key, value := range tc.header {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			tc.checkResponse(t, rec)
+		})
+	}
+}
+
+func TestFeedHTTPHandlerETag(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+	articleRepo.EXPECT().ListArticles(domain.ArticleOrderNewest, feedItemLimit, "").Return(&[]domain.Article{}, "", nil).Times(2)
+
+	handler := NewFeedHTTPHandler(usecase.NewArticleUsecase(articleRepo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl)), usecase.NewUserUsecase(mock.NewMockIUserRepository(mockCtrl)), nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feeds/articles.json", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/feeds/articles.json", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+}
+
+func TestFeedHTTPHandlerCollection(t *testing.T) {
+	addedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(collectionRepo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Public",
+			path: "/feeds/collections/token.rss",
+			buildStubs: func(collectionRepo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				collectionRepo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Name: "Go onboarding", Visibility: "public"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, CollectionID: 2, PageSize: feedItemLimit}).
+					Return(&[]domain.Bookmark{{ID: 1, ArticleID: 3, CreatedAt: addedAt}}, "", nil)
+				articleRepo.EXPECT().ListArticlesByIDs([]int{3}).Return(&[]domain.Article{{ID: 3, Title: "Effective Go", Url: "https://go.dev/doc/effective_go", UpdatedAt: addedAt}}, nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Contains(t, rec.Body.String(), "<title>Techbranch - Go onboarding</title>")
+				assert.Contains(t, rec.Body.String(), "<link>https://go.dev/doc/effective_go</link>")
+				assert.Contains(t, rec.Body.String(), "<pubDate>Tue, 02 Jan 2024 03:04:05 +0000</pubDate>")
+			},
+		},
+		{
+			name: "Unlisted",
+			path: "/feeds/collections/token.rss",
+			buildStubs: func(collectionRepo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				collectionRepo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Visibility: "unlisted"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{}, "", nil)
+				articleRepo.EXPECT().ListArticlesByIDs(gomock.Any()).Return(&[]domain.Article{}, nil)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+		{
+			name: "Private",
+			path: "/feeds/collections/token.rss",
+			buildStubs: func(collectionRepo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				collectionRepo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Visibility: "private"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			collectionRepo := mock.NewMockICollectionRepository(mockCtrl)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(collectionRepo, bookmarkRepo, articleRepo)
+
+			handler := NewFeedHTTPHandler(nil, nil, usecase.NewCollectionUsecase(collectionRepo, bookmarkRepo, articleRepo, nil))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			tc.checkResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IFeedSourceGRPCServer interface {
+	CreateFeedSource(ctx context.Context, req *pb.CreateFeedSourceRequest) (*pb.CreateFeedSourceResponse, error)
+	GetFeedSource(ctx context.Context, req *pb.GetFeedSourceRequest) (*pb.GetFeedSourceResponse, error)
+	ListFeedSources(ctx context.Context, req *pb.ListFeedSourcesRequest) (*pb.ListFeedSourcesResponse, error)
+	DeleteFeedSource(ctx context.Context, req *pb.DeleteFeedSourceRequest) (*pb.DeleteFeedSourceResponse, error)
+	ImportOPML(ctx context.Context, req *pb.ImportOPMLRequest) (*pb.ImportOPMLResponse, error)
+	ExportOPML(ctx context.Context, req *pb.ExportOPMLRequest) (*httpbody.HttpBody, error)
+}
+
+type feedSourceGRPCServer struct {
+	pb.UnimplementedFeedSourceServiceServer
+	usecase usecase.IFeedSourceUsecase
+}
+
+func NewFeedSourceGRPCServer(grpcServer *grpc.Server, usecase usecase.IFeedSourceUsecase) pb.FeedSourceServiceServer {
+	server := feedSourceGRPCServer{usecase: usecase}
+	pb.RegisterFeedSourceServiceServer(grpcServer, &server)
+	return &server
+}
+
+func (server *feedSourceGRPCServer) CreateFeedSource(ctx context.Context, req *pb.CreateFeedSourceRequest) (*pb.CreateFeedSourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.CreateFeedSourceResponse{}
+	feedSource, err := server.usecase.CreateFeedSource(myContext.GetUserID(ctx), domain.FeedSource{Url: req.Url})
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to create feed source: %v", err)
+	}
+	res.FeedSource = newFeedSourcePB(feedSource)
+
+	return &res, nil
+}
+
+func (server *feedSourceGRPCServer) GetFeedSource(ctx context.Context, req *pb.GetFeedSourceRequest) (*pb.GetFeedSourceResponse, error) {
+	res := pb.GetFeedSourceResponse{}
+	feedSource, err := server.usecase.GetFeedSource(int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to get feed source: %v", err)
+	}
+	res.FeedSource = newFeedSourcePB(feedSource)
+
+	return &res, nil
+}
+
+func (server *feedSourceGRPCServer) ListFeedSources(ctx context.Context, req *pb.ListFeedSourcesRequest) (*pb.ListFeedSourcesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListFeedSourcesResponse{}
+	feedSources, nextPageToken, err := server.usecase.ListFeedSources(int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list feed sources: %v", err)
+	}
+	for _, feedSource := range feedSources {
+		res.FeedSources = append(res.FeedSources, newFeedSourcePB(feedSource))
+	}
+	res.NextPageToken = nextPageToken
+
+	return &res, nil
+}
+
+func (server *feedSourceGRPCServer) DeleteFeedSource(ctx context.Context, req *pb.DeleteFeedSourceRequest) (*pb.DeleteFeedSourceResponse, error) {
+	res := pb.DeleteFeedSourceResponse{}
+	err := server.usecase.DeleteFeedSource(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to delete feed source: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *feedSourceGRPCServer) ImportOPML(ctx context.Context, req *pb.ImportOPMLRequest) (*pb.ImportOPMLResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ImportOPMLResponse{}
+	created, skipped, err := server.usecase.ImportOPML(myContext.GetUserID(ctx), []byte(req.Opml))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to import opml: %v", err)
+	}
+	for _, feedSource := range created {
+		res.Created = append(res.Created, newFeedSourcePB(feedSource))
+	}
+	for _, outline := range skipped {
+		res.Skipped = append(res.Skipped, &pb.SkippedOutline{
+			Title:  outline.Title,
+			Url:    outline.Url,
+			Reason: outline.Reason,
+		})
+	}
+
+	return &res, nil
+}
+
+func (server *feedSourceGRPCServer) ExportOPML(ctx context.Context, req *pb.ExportOPMLRequest) (*httpbody.HttpBody, error) {
+	data, err := server.usecase.ExportOPML()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export opml: %v", err)
+	}
+
+	return &httpbody.HttpBody{ContentType: "text/x-opml; charset=utf-8", Data: data}, nil
+}
+
+func newFeedSourcePB(feedSource domain.FeedSource) *pb.FeedSource {
+	res := &pb.FeedSource{
+		Id:          int32(feedSource.ID),
+		Url:         feedSource.Url,
+		Title:       feedSource.Title,
+		NextFetchAt: &timestamppb.Timestamp{Seconds: int64(feedSource.NextFetchAt.Unix()), Nanos: int32(feedSource.NextFetchAt.Nanosecond())},
+		ErrorCount:  int32(feedSource.ErrorCount),
+		LastError:   feedSource.LastError,
+		CreatedAt:   &timestamppb.Timestamp{Seconds: int64(feedSource.CreatedAt.Unix()), Nanos: int32(feedSource.CreatedAt.Nanosecond())},
+		UpdatedAt:   &timestamppb.Timestamp{Seconds: int64(feedSource.UpdatedAt.Unix()), Nanos: int32(feedSource.UpdatedAt.Nanosecond())},
+	}
+	if feedSource.LastFetchedAt != nil {
+		res.LastFetchedAt = &timestamppb.Timestamp{Seconds: int64(feedSource.LastFetchedAt.Unix()), Nanos: int32(feedSource.LastFetchedAt.Nanosecond())}
+	}
+	return res
+}
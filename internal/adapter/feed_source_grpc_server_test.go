@@ -0,0 +1,257 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/feed"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func newTestFeedSourceGRPCServer(mockCtrl *gomock.Controller, repo *mock.MockIFeedSourceRepository, userRepo *mock.MockIUserRepository) pb.FeedSourceServiceServer {
+	articleUsecase := usecase.NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl))
+	feedSourceUsecase := usecase.NewFeedSourceUsecase(repo, userRepo, articleUsecase, feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	return NewFeedSourceGRPCServer(server, feedSourceUsecase)
+}
+
+func TestCreateFeedSource(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.CreateFeedSourceRequest
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIFeedSourceRepository)
+		checkResponse func(t *testing.T, res *pb.CreateFeedSourceResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.CreateFeedSourceRequest{Url: "https://example.com/feed"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl(gomock.Any()).Return(&domain.FeedSource{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateFeedSource(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeedSourceResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://example.com/feed", res.FeedSource.Url)
+				assert.NotNil(t, res.FeedSource.NextFetchAt)
+				assert.Nil(t, res.FeedSource.LastFetchedAt)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.CreateFeedSourceRequest{Url: "ftp://example.com/feed"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeedSourceResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "AlreadyExists",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.CreateFeedSourceRequest{Url: "https://example.com/feed"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl(gomock.Any()).Return(&domain.FeedSource{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFeedSourceResponse, err error) {
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil).AnyTimes()
+			tc.buildStubs(repo)
+
+			s := newTestFeedSourceGRPCServer(mockCtrl, repo, userRepo)
+			res, err := s.CreateFeedSource(myContext.SetUserID(tc.args.ctx, 1), tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetFeedSource(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.GetFeedSourceRequest
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIFeedSourceRepository)
+		checkResponse func(t *testing.T, res *pb.GetFeedSourceResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.GetFeedSourceRequest{Id: 1},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				now := time.Now()
+				repo.EXPECT().GetFeedSource(1).Return(&domain.FeedSource{ID: 1, Url: "https://example.com/feed", LastFetchedAt: &now, ErrorCount: 1, LastError: "timeout"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeedSourceResponse, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res.FeedSource.LastFetchedAt)
+				assert.Equal(t, int32(1), res.FeedSource.ErrorCount)
+				assert.Equal(t, "timeout", res.FeedSource.LastError)
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.GetFeedSourceRequest{Id: 1},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSource(1).Return(&domain.FeedSource{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeedSourceResponse, err error) {
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestFeedSourceGRPCServer(mockCtrl, repo, mock.NewMockIUserRepository(mockCtrl))
+			res, err := s.GetFeedSource(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestImportOPML(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.ImportOPMLRequest
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIFeedSourceRepository)
+		checkResponse func(t *testing.T, res *pb.ImportOPMLResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ImportOPMLRequest{Opml: `<opml version="2.0"><body><outline text="Example" xmlUrl="https://example.com/feed"/><outline text="No Feed"/></body></opml>`},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl(gomock.Any()).Return(&domain.FeedSource{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateFeedSource(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ImportOPMLResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Created, 1)
+				assert.Len(t, res.Skipped, 1)
+				assert.Equal(t, "missing xmlUrl", res.Skipped[0].Reason)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.ImportOPMLRequest{},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.ImportOPMLResponse, err error) {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil).AnyTimes()
+			tc.buildStubs(repo)
+
+			s := newTestFeedSourceGRPCServer(mockCtrl, repo, userRepo)
+			res, err := s.ImportOPML(myContext.SetUserID(tc.args.ctx, 1), tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestExportOPML(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+	repo.EXPECT().ListAllFeedSources().Return(&[]domain.FeedSource{{ID: 1, Url: "https://example.com/feed"}}, nil)
+
+	s := newTestFeedSourceGRPCServer(mockCtrl, repo, mock.NewMockIUserRepository(mockCtrl))
+	res, err := s.ExportOPML(context.Background(), &pb.ExportOPMLRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "text/x-opml; charset=utf-8", res.ContentType)
+	assert.Contains(t, string(res.Data), "https://example.com/feed")
+}
+
+func TestFeedSourcePermissionDenied(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+	repo.EXPECT().CreateFeedSource(gomock.Any()).Times(0)
+	repo.EXPECT().DeleteFeedSource(gomock.Any()).Times(0)
+	userRepo := mock.NewMockIUserRepository(mockCtrl)
+	userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil).Times(3)
+
+	s := newTestFeedSourceGRPCServer(mockCtrl, repo, userRepo)
+	ctx := myContext.SetUserID(context.Background(), 2)
+	_, err := s.CreateFeedSource(ctx, &pb.CreateFeedSourceRequest{Url: "https://example.com/feed"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeleteFeedSource(ctx, &pb.DeleteFeedSourceRequest{Id: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ImportOPML(ctx, &pb.ImportOPMLRequest{Opml: `<opml version="2.0"><body><outline text="Example" xmlUrl="https://example.com/feed"/></body></opml>`})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
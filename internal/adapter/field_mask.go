@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func updateFields(mask *fieldmaskpb.FieldMask, populated map[string]bool) ([]string, error) {
+	fields := []string{}
+	if len(mask.GetPaths()) == 0 {
+		for field, ok := range populated {
+			if ok {
+				fields = append(fields, field)
+			}
+		}
+		sort.Strings(fields)
+	} else {
+		for _, path := range mask.GetPaths() {
+			if _, ok := populated[path]; !ok {
+				return nil, fmt.Errorf("invalid update_mask path %q", path)
+			}
+			fields = append(fields, path)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	return fields, nil
+}
+
+func validateFields(err error, fields []string, maskable map[string]bool) error {
+	if err == nil {
+		return nil
+	}
+	var multiErr interface{ AllErrors() []error }
+	if !errors.As(err, &multiErr) {
+		return err
+	}
+	for _, fieldErr := range multiErr.AllErrors() {
+		field, ok := fieldErr.(interface{ Field() string })
+		if !ok {
+			return fieldErr
+		}
+		name := fieldName(field.Field())
+		if _, ok := maskable[name]; ok && !containsField(fields, name) {
+			continue
+		}
+		return fieldErr
+	}
+	return nil
+}
+
+func fieldName(field string) string {
+	if i := strings.IndexAny(field, "[."); i >= 0 {
+		field = field[:i]
+	}
+	return strings.ToLower(field)
+}
+
+func containsField(fields []string, name string) bool {
+	for _, field := range fields {
+		if strings.ReplaceAll(field, "_", "") == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package adapter
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/loak155/techbranch-backend/pkg/storage"
+)
+
+type fileHTTPHandler struct {
+	store *storage.LocalStore
+}
+
+func NewFileHTTPHandler(store *storage.LocalStore) http.Handler {
+	return &fileHTTPHandler{store}
+}
+
+func (handler *fileHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/files/")
+	query := r.URL.Query()
+	if err := handler.store.VerifySignedURL(key, query.Get("expires"), query.Get("signature")); err != nil {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	object, err := handler.store.Get(key)
+	switch {
+	case errors.Is(err, storage.ErrNotFound), errors.Is(err, storage.ErrInvalidKey):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer object.Body.Close()
+
+	content, ok := object.Body.(io.ReadSeeker)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if object.ContentType != "" {
+		w.Header().Set("Content-Type", object.ContentType)
+	}
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	http.ServeContent(w, r, "", object.ModTime, content)
+}
@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/loak155/techbranch-backend/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileHTTPHandler(t *testing.T) {
+	store, err := storage.NewLocalStore(t.TempDir(), "http://localhost:8080/files", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("exports/1/bookmarks.csv", strings.NewReader("id,url\n1,https://example.com\n"), "text/csv; charset=utf-8"); err != nil {
+		t.Fatal(err)
+	}
+	signedURL := func(key string, expires time.Duration) string {
+		u, err := store.SignedURL(key, expires)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.TrimPrefix(u, "http://localhost:8080")
+	}
+
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		checkResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "OK",
+			method: http.MethodGet,
+			path:   signedURL("exports/1/bookmarks.csv", time.Minute),
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "id,url\n1,https://example.com\n", rec.Body.String())
+			},
+		},
+		{
+			name:   "Expired",
+			method: http.MethodGet,
+			path:   signedURL("exports/1/bookmarks.csv", -time.Minute),
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, rec.Code)
+			},
+		},
+		{
+			name:   "InvalidSignature",
+			method: http.MethodGet,
+			path:   strings.Replace(signedURL("exports/1/bookmarks.csv", time.Minute), "exports/1", "exports/2", 1),
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, rec.Code)
+			},
+		},
+		{
+			name:   "NotFound",
+			method: http.MethodGet,
+			path:   signedURL("exports/1/missing.csv", time.Minute),
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, rec.Code)
+			},
+		},
+		{
+			name:   "MethodNotAllowed",
+			method: http.MethodDelete,
+			path:   signedURL("exports/1/bookmarks.csv", time.Minute),
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			rec := httptest.NewRecorder()
+			NewFileHTTPHandler(store).ServeHTTP(rec, req)
+			tc.checkResponse(t, rec)
+		})
+	}
+}
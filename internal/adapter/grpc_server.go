@@ -6,19 +6,24 @@ import (
 	"github.com/loak155/techbranch-backend/pkg/auth"
 	"github.com/loak155/techbranch-backend/pkg/config"
 	"github.com/loak155/techbranch-backend/pkg/db"
+	"github.com/loak155/techbranch-backend/pkg/feed"
 	"github.com/loak155/techbranch-backend/pkg/jwt"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
 	"github.com/loak155/techbranch-backend/pkg/logger"
 	"github.com/loak155/techbranch-backend/pkg/mail"
 	"github.com/loak155/techbranch-backend/pkg/oauth"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/loak155/techbranch-backend/pkg/redis"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-func NewGRPCServer(conf *config.Config) (*grpc.Server, pb.ArticleServiceServer, pb.UserServiceServer, pb.BookmarkServiceServer, pb.CommentServiceServer, pb.AuthServiceServer) {
+func NewGRPCServer(conf *config.Config) (*grpc.Server, pb.ArticleServiceServer, pb.UserServiceServer, pb.BookmarkServiceServer, pb.CommentServiceServer, pb.AuthServiceServer, pb.FeedSourceServiceServer, pb.ArticleLinkServiceServer, pb.CollectionServiceServer, pb.WorkspaceServiceServer) {
 	jwtAccessTokenManager := jwt.NewJwtManager(conf.JWTIssuer, conf.JwtSecret, conf.AccessTokenExpires)
 	jwtRefreshTokenManager := jwt.NewJwtManager(conf.JWTIssuer, conf.JwtSecret, conf.RefreshTokenExpires)
 	redisAccessTokenManager := redis.NewRedisManager(conf.RedisAddress, conf.RedisAccessTokenDB, conf.AccessTokenExpires)
@@ -36,25 +41,50 @@ func NewGRPCServer(conf *config.Config) (*grpc.Server, pb.ArticleServiceServer,
 
 	gormDB := db.NewDB(conf.DbSource)
 
-	articleRepository := repository.NewArticleRepository(gormDB)
-	articleUsecase := usecase.NewArticleUsecase(articleRepository)
-	articleServer := NewArticleGRPCServer(grpcServer, articleUsecase)
-
 	userRepository := repository.NewUserRepository(gormDB)
+	articleRepository := repository.NewArticleRepository(gormDB, conf.SearchLanguage)
+	articleRevisionRepository := repository.NewArticleRevisionRepository(gormDB)
+	articleUsecase := usecase.NewArticleUsecase(articleRepository, userRepository, articleRevisionRepository)
+	store, err := NewStore(conf)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create storage")
+	}
+	imageUsecase := usecase.NewImageUsecase(store, safehttp.NewClient(conf.ImageFetchTimeout), urlsign.NewSigner(conf.ImageProxySecret), conf.ImageProxyBaseURL)
+	recommendationUsecase := usecase.NewRecommendationUsecase(repository.NewRecommendationRepository(gormDB), articleRepository, conf.RecommendationLimit)
+	articleServer := NewArticleGRPCServer(grpcServer, articleUsecase, imageUsecase, recommendationUsecase)
+
 	userUsecase := usecase.NewUserUsecase(userRepository)
 	userServer := NewUserGRPCServer(grpcServer, userUsecase)
 
+	workspaceRepository := repository.NewWorkspaceRepository(gormDB)
+	workspaceInvitationMailManager, _ := mail.NewWorkspaceInvitationMailManager(mail.GmailHost, mail.GmailPort, conf.GmailFrom, conf.GmailPassword, conf.WorkspaceInvitationMailSubject, conf.WorkspaceInvitationMailTemplate, conf.WorkspaceInvitationURL)
+	workspaceUsecase := usecase.NewWorkspaceUsecase(workspaceRepository, userRepository, *workspaceInvitationMailManager, conf.WorkspaceInvitationExpires)
+	workspaceServer := NewWorkspaceGRPCServer(grpcServer, workspaceUsecase)
+
 	bookmarkRepository := repository.NewBookmarkRepository(gormDB)
-	bookmarkUsecase := usecase.NewBookmarkUsecase(bookmarkRepository)
-	bookmarkServer := NewBookmarkGRPCServer(grpcServer, bookmarkUsecase)
+	bookmarkUsecase := usecase.NewBookmarkUsecase(bookmarkRepository, userRepository, workspaceRepository)
+	collectionRepository := repository.NewCollectionRepository(gormDB)
+	bookmarkImportUsecase := usecase.NewBookmarkImportUsecase(repository.NewBookmarkImportRepository(gormDB), bookmarkRepository, collectionRepository, articleUsecase, conf.BookmarkImportSyncLimit, conf.BookmarkImportStaleTimeout)
+	bookmarkServer := NewBookmarkGRPCServer(grpcServer, bookmarkUsecase, bookmarkImportUsecase)
+
+	collectionUsecase := usecase.NewCollectionUsecase(collectionRepository, bookmarkRepository, articleRepository, workspaceRepository)
+	collectionServer := NewCollectionGRPCServer(grpcServer, collectionUsecase)
 
 	commentRepository := repository.NewCommentRepository(gormDB)
-	commentUsecase := usecase.NewCommentUsecase(commentRepository)
+	commentUsecase := usecase.NewCommentUsecase(commentRepository, userRepository, workspaceRepository, conf.CommentMaxDepth)
 	commentServer := NewCommentGRPCServer(grpcServer, commentUsecase)
 
+	feedSourceRepository := repository.NewFeedSourceRepository(gormDB)
+	feedSourceUsecase := usecase.NewFeedSourceUsecase(feedSourceRepository, userRepository, articleUsecase, feed.NewFetcher(safehttp.NewClient(conf.FeedFetchTimeout)), conf.FeedFetchInterval, conf.FeedMaxBackoff)
+	feedSourceServer := NewFeedSourceGRPCServer(grpcServer, feedSourceUsecase)
+
+	articleLinkRepository := repository.NewArticleLinkRepository(gormDB)
+	articleLinkUsecase := usecase.NewArticleLinkUsecase(articleLinkRepository, userRepository, linkcheck.NewChecker(safehttp.NewClient(conf.LinkCheckTimeout)), conf.LinkCheckInterval, conf.LinkCheckRetryInterval, conf.LinkBrokenThreshold)
+	articleLinkServer := NewArticleLinkGRPCServer(grpcServer, articleLinkUsecase)
+
 	presignupMailManager, _ := mail.NewPresignupMailManager(mail.GmailHost, mail.GmailPort, conf.GmailFrom, conf.GmailPassword, conf.PresignupMailSubject, conf.PresignupMailTemplate, conf.SignupURL)
 	presignupRedisManager := redis.NewRedisManager(conf.RedisAddress, conf.RedisPresignupDB, conf.PresignupExpires)
-	authUsecase := usecase.NewAuthUsecase(userRepository, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *google, *presignupRedisManager, *presignupMailManager)
+	authUsecase := usecase.NewAuthUsecase(userRepository, bookmarkRepository, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *google, *presignupRedisManager, *presignupMailManager)
 	authServer := NewAuthGRPCServer(grpcServer, authUsecase)
 
 	healthServer := health.NewServer()
@@ -62,5 +92,5 @@ func NewGRPCServer(conf *config.Config) (*grpc.Server, pb.ArticleServiceServer,
 	healthServer.SetServingStatus("grpc-server", healthpb.HealthCheckResponse_SERVING)
 
 	reflection.Register(grpcServer)
-	return grpcServer, articleServer, userServer, bookmarkServer, commentServer, authServer
+	return grpcServer, articleServer, userServer, bookmarkServer, commentServer, authServer, feedSourceServer, articleLinkServer, collectionServer, workspaceServer
 }
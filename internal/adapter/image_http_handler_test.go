@@ -0,0 +1,119 @@
+package adapter
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/pkg/storage"
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestImageUsecase() usecase.IImageUsecase {
+	return usecase.NewImageUsecase(nil, nil, urlsign.NewSigner("secret"), "http://localhost:8080/images")
+}
+
+func TestImageHTTPHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 400, 200))); err != nil {
+		t.Fatal(err)
+	}
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ogp.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(buf.Bytes())
+	}))
+	defer origin.Close()
+
+	store, err := storage.NewLocalStore(t.TempDir(), "http://localhost:8080/files", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	imageUsecase := usecase.NewImageUsecase(store, &http.Client{Timeout: time.Second}, urlsign.NewSigner("secret"), "/images")
+	ok := imageUsecase.GetThumbnailUrls(origin.URL + "/ogp.png")[1]
+	missing := imageUsecase.GetThumbnailUrls(origin.URL + "/missing.png")[1]
+
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		header        map[string]string
+		checkResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "WebP",
+			method: http.MethodGet,
+			path:   ok.WebpUrl,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "image/webp", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+				assert.NotEmpty(t, rec.Header().Get("ETag"))
+				assert.True(t, strings.HasPrefix(rec.Body.String(), "RIFF"))
+			},
+		},
+		{
+			name:   "JPEG",
+			method: http.MethodGet,
+			path:   ok.JpegUrl,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "image/jpeg", rec.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:   "NotModified",
+			method: http.MethodGet,
+			path:   ok.WebpUrl,
+			header: map[string]string{"If-None-Match": "*"},
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+			},
+		},
+		{
+			name:   "InvalidSignature",
+			method: http.MethodGet,
+			path:   "/images/invalid/medium/aW1hZ2U.webp",
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, rec.Code)
+			},
+		},
+		{
+			name:   "OriginError",
+			method: http.MethodGet,
+			path:   missing.WebpUrl,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadGateway, rec.Code)
+			},
+		},
+		{
+			name:   "MethodNotAllowed",
+			method: http.MethodPost,
+			path:   ok.WebpUrl,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			for key, value := range tc.header {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			NewImageHTTPHandler(imageUsecase).ServeHTTP(rec, req)
+			tc.checkResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package adapter
+
+import (
+	"github.com/loak155/techbranch-backend/pkg/config"
+	"github.com/loak155/techbranch-backend/pkg/storage"
+)
+
+func NewStore(conf *config.Config) (storage.Store, error) {
+	return storage.NewStore(storage.Config{
+		Driver:            conf.StorageDriver,
+		LocalDir:          conf.StorageLocalDir,
+		LocalBaseURL:      conf.StorageLocalBaseURL,
+		SigningSecret:     conf.StorageSigningSecret,
+		S3Endpoint:        conf.StorageS3Endpoint,
+		S3Region:          conf.StorageS3Region,
+		S3Bucket:          conf.StorageS3Bucket,
+		S3AccessKeyID:     conf.StorageS3AccessKeyID,
+		S3SecretAccessKey: conf.StorageS3SecretAccessKey,
+		S3UseSSL:          conf.StorageS3UseSSL,
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -18,6 +19,9 @@ type IUserGRPCServer interface {
 	ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error)
 	UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error)
+	UpdateBookmarkVisibility(ctx context.Context, req *pb.UpdateBookmarkVisibilityRequest) (*pb.UpdateBookmarkVisibilityResponse, error)
+	UpdateDigestSubscription(ctx context.Context, req *pb.UpdateDigestSubscriptionRequest) (*pb.UpdateDigestSubscriptionResponse, error)
+	RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error)
 }
 
 type userGRPCServer struct {
@@ -49,12 +53,16 @@ func (server *userGRPCServer) CreateUser(ctx context.Context, req *pb.CreateUser
 	}
 
 	res.User = &pb.User{
-		Id:        int32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		Password:        user.Password,
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 	}
 
 	return &res, nil
@@ -66,13 +74,18 @@ func (server *userGRPCServer) GetUser(ctx context.Context, req *pb.GetUserReques
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
+	setETag(ctx, user.Version)
 	res.User = &pb.User{
-		Id:        int32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		Password:        user.Password,
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 	}
 
 	return &res, nil
@@ -90,27 +103,36 @@ func (server *userGRPCServer) ListUsers(ctx context.Context, req *pb.ListUsersRe
 			return nil, status.Errorf(codes.Internal, "failed to get user by email: %v", err)
 		}
 		res.Users = append(res.Users, &pb.User{
-			Id:        int32(user.ID),
-			Username:  user.Username,
-			Email:     user.Email,
-			Password:  user.Password,
-			CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-			UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+			Id:              int32(user.ID),
+			Username:        user.Username,
+			Email:           user.Email,
+			BookmarksPublic: user.BookmarksPublic,
+			DigestEnabled:   user.DigestEnabled,
+			Role:            user.Role,
+			Version:         int32(user.Version),
+			Password:        user.Password,
+			CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+			UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 		})
 	} else {
-		users, err := server.usecase.ListUsers(int(req.Offset), int(req.Limit))
+		users, nextPageToken, err := server.usecase.ListUsers(int(req.PageSize), req.PageToken)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+			return nil, status.Errorf(errorCode(err), "failed to list users: %v", err)
 		}
+		res.NextPageToken = nextPageToken
 
 		for _, user := range users {
 			res.Users = append(res.Users, &pb.User{
-				Id:        int32(user.ID),
-				Username:  user.Username,
-				Email:     user.Email,
-				Password:  user.Password,
-				CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-				UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+				Id:              int32(user.ID),
+				Username:        user.Username,
+				Email:           user.Email,
+				BookmarksPublic: user.BookmarksPublic,
+				DigestEnabled:   user.DigestEnabled,
+				Role:            user.Role,
+				Version:         int32(user.Version),
+				Password:        user.Password,
+				CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+				UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 			})
 		}
 		if len(users) == 0 {
@@ -122,7 +144,21 @@ func (server *userGRPCServer) ListUsers(ctx context.Context, req *pb.ListUsersRe
 }
 
 func (server *userGRPCServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
-	if err := req.Validate(); err != nil {
+	populated := map[string]bool{
+		"username": req.Username != "",
+		"email":    req.Email != "",
+		"password": req.Password != "",
+	}
+	fields, err := updateFields(req.UpdateMask, populated)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+	if err := validateFields(req.ValidateAll(), fields, populated); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	version, err := requestVersion(ctx, req.Version)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
 	}
 
@@ -133,18 +169,28 @@ func (server *userGRPCServer) UpdateUser(ctx context.Context, req *pb.UpdateUser
 			Username: req.Username,
 			Email:    req.Email,
 			Password: req.Password,
+			Version:  version,
 		},
+		fields,
 	)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update user: %v", err)
+	}
+	setETag(ctx, user.Version)
 
 	res.User = &pb.User{
-		Id:        int32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
-		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		Password:        user.Password,
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
 	}
-	return &res, err
+	return &res, nil
 }
 
 func (server *userGRPCServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
@@ -156,3 +202,74 @@ func (server *userGRPCServer) DeleteUser(ctx context.Context, req *pb.DeleteUser
 
 	return &res, err
 }
+
+func (server *userGRPCServer) UpdateBookmarkVisibility(ctx context.Context, req *pb.UpdateBookmarkVisibilityRequest) (*pb.UpdateBookmarkVisibilityResponse, error) {
+	if int(req.UserId) != myContext.GetUserID(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to update bookmark visibility: %v", domain.ErrPermissionDenied)
+	}
+
+	res := pb.UpdateBookmarkVisibilityResponse{}
+	user, err := server.usecase.UpdateBookmarkVisibility(int(req.UserId), req.Public)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update bookmark visibility: %v", err)
+	}
+	res.User = &pb.User{
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+	}
+
+	return &res, nil
+}
+
+func (server *userGRPCServer) UpdateDigestSubscription(ctx context.Context, req *pb.UpdateDigestSubscriptionRequest) (*pb.UpdateDigestSubscriptionResponse, error) {
+	if int(req.UserId) != myContext.GetUserID(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to update digest subscription: %v", domain.ErrPermissionDenied)
+	}
+
+	res := pb.UpdateDigestSubscriptionResponse{}
+	user, err := server.usecase.UpdateDigestSubscription(int(req.UserId), req.Enabled)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update digest subscription: %v", err)
+	}
+	res.User = &pb.User{
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+	}
+
+	return &res, nil
+}
+
+func (server *userGRPCServer) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	res := pb.RestoreUserResponse{}
+	user, err := server.usecase.RestoreUser(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to restore user: %v", err)
+	}
+	res.User = &pb.User{
+		Id:              int32(user.ID),
+		Username:        user.Username,
+		Email:           user.Email,
+		BookmarksPublic: user.BookmarksPublic,
+		DigestEnabled:   user.DigestEnabled,
+		Role:            user.Role,
+		Version:         int32(user.Version),
+		CreatedAt:       &timestamppb.Timestamp{Seconds: int64(user.CreatedAt.Unix()), Nanos: int32(user.CreatedAt.Nanosecond())},
+		UpdatedAt:       &timestamppb.Timestamp{Seconds: int64(user.UpdatedAt.Unix()), Nanos: int32(user.UpdatedAt.Nanosecond())},
+	}
+
+	return &res, nil
+}
@@ -9,10 +9,15 @@ import (
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/usecase"
 	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
 	"github.com/loak155/techbranch-backend/pkg/password"
 	"github.com/loak155/techbranch-backend/pkg/pb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"gorm.io/gorm"
 )
 
@@ -185,8 +190,7 @@ func TestListUsers(t *testing.T) {
 	}
 
 	req := &pb.ListUsersRequest{
-		Offset: 0,
-		Limit:  10,
+		PageSize: 10,
 	}
 
 	reqEmail := &pb.ListUsersRequest{
@@ -240,7 +244,7 @@ func TestListUsers(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&repoResUsers, nil)
+				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&repoResUsers, "", nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListUsersResponse, err error) {
 				assert.NoError(t, err)
@@ -261,7 +265,7 @@ func TestListUsers(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&[]domain.User{}, gorm.ErrInvalidData)
+				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&[]domain.User{}, "", gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, res *pb.ListUsersResponse, err error) {
 				assert.Error(t, err)
@@ -348,6 +352,7 @@ func TestUpdateUser(t *testing.T) {
 		Username: "test_username",
 		Email:    "test@example.com",
 		Password: "test_password",
+		Version:  1,
 	}
 
 	testCases := []struct {
@@ -363,12 +368,84 @@ func TestUpdateUser(t *testing.T) {
 				req: req,
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "IfMatch",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-if-match", `"3"`)),
+				req: &pb.UpdateUserRequest{Id: 1, Username: "test_username", Email: "test@example.com"},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(user *domain.User, columns []string) error {
+					assert.Equal(t, 3, user.Version)
+					assert.Equal(t, []string{"email", "username"}, columns)
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "UpdateMask",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.UpdateUserRequest{Id: 1, Password: "new_password", Version: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}}},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), []string{"password"}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidMaskedField",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.UpdateUserRequest{Id: 1, Password: "short", Version: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}}},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "VersionConflict",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(domain.ErrVersionConflict)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "VersionRequired",
+			args: args{
+				ctx: context.Background(),
+				req: &pb.UpdateUserRequest{Id: 1, Username: "test_username", Email: "test@example.com"},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
 		{
 			name: "InvalidArgument",
 			args: args{
@@ -466,3 +543,131 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBookmarkVisibility(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *pb.UpdateBookmarkVisibilityRequest
+	}
+
+	req := &pb.UpdateBookmarkVisibilityRequest{
+		UserId: 1,
+		Public: true,
+	}
+
+	repoResUser := domain.User{
+		ID:              1,
+		Username:        "test_username",
+		Email:           "test@example.com",
+		BookmarksPublic: true,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res *pb.UpdateBookmarkVisibilityResponse, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 1),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateBookmarksPublic(1, true).Return(nil)
+				repo.EXPECT().GetUser(1).Return(&repoResUser, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkVisibilityResponse, err error) {
+				assert.NoError(t, err)
+				assert.True(t, res.User.BookmarksPublic)
+				assert.Empty(t, res.User.Password)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				ctx: myContext.SetUserID(context.Background(), 2),
+				req: req,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateBookmarksPublic(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBookmarkVisibilityResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewUserUsecase(repo)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewUserGRPCServer(server, usecase)
+			res, err := s.UpdateBookmarkVisibility(tc.args.ctx, tc.args.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUpdateDigestSubscription(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		buildStubs    func(repo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res *pb.UpdateDigestSubscriptionResponse, err error)
+	}{
+		{
+			name: "OK",
+			ctx:  myContext.SetUserID(context.Background(), 1),
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateDigestEnabled(1, true).Return(nil)
+				repo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "test_username", DigestEnabled: true}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateDigestSubscriptionResponse, err error) {
+				assert.NoError(t, err)
+				assert.True(t, res.User.DigestEnabled)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			ctx:  myContext.SetUserID(context.Background(), 2),
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateDigestEnabled(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateDigestSubscriptionResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := usecase.NewUserUsecase(repo)
+			server := grpc.NewServer()
+			server.GracefulStop()
+
+			s := NewUserGRPCServer(server, usecase)
+			res, err := s.UpdateDigestSubscription(tc.ctx, &pb.UpdateDigestSubscriptionRequest{UserId: 1, Enabled: true})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var ifMatchKeys = []string{"if-match", "grpcgateway-if-match"}
+
+func requestVersion(ctx context.Context, version int32) (int, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range ifMatchKeys {
+			values := md.Get(key)
+			if len(values) == 0 {
+				continue
+			}
+			ifMatch, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(values[0], "W/"), `"`))
+			if err != nil || ifMatch <= 0 {
+				return 0, errors.New("invalid If-Match header")
+			}
+			if version != 0 && int(version) != ifMatch {
+				return 0, errors.New("version does not match If-Match header")
+			}
+			return ifMatch, nil
+		}
+	}
+	if version <= 0 {
+		return 0, errors.New("version is required")
+	}
+	return int(version), nil
+}
+
+func setETag(ctx context.Context, version int) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(strconv.Itoa(version))))
+}
@@ -0,0 +1,197 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IWorkspaceGRPCServer interface {
+	CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error)
+	GetWorkspace(ctx context.Context, req *pb.GetWorkspaceRequest) (*pb.GetWorkspaceResponse, error)
+	ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesRequest) (*pb.ListWorkspacesResponse, error)
+	DeleteWorkspace(ctx context.Context, req *pb.DeleteWorkspaceRequest) (*pb.DeleteWorkspaceResponse, error)
+	ListWorkspaceMembers(ctx context.Context, req *pb.ListWorkspaceMembersRequest) (*pb.ListWorkspaceMembersResponse, error)
+	UpdateWorkspaceMember(ctx context.Context, req *pb.UpdateWorkspaceMemberRequest) (*pb.UpdateWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(ctx context.Context, req *pb.RemoveWorkspaceMemberRequest) (*pb.RemoveWorkspaceMemberResponse, error)
+	InviteWorkspaceMember(ctx context.Context, req *pb.InviteWorkspaceMemberRequest) (*pb.InviteWorkspaceMemberResponse, error)
+	AcceptWorkspaceInvitation(ctx context.Context, req *pb.AcceptWorkspaceInvitationRequest) (*pb.AcceptWorkspaceInvitationResponse, error)
+}
+
+type workspaceGRPCServer struct {
+	pb.UnimplementedWorkspaceServiceServer
+	usecase usecase.IWorkspaceUsecase
+}
+
+func NewWorkspaceGRPCServer(grpcServer *grpc.Server, usecase usecase.IWorkspaceUsecase) pb.WorkspaceServiceServer {
+	server := workspaceGRPCServer{usecase: usecase}
+	pb.RegisterWorkspaceServiceServer(grpcServer, &server)
+	return &server
+}
+
+func (server *workspaceGRPCServer) CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.CreateWorkspaceResponse{}
+	workspace, err := server.usecase.CreateWorkspace(myContext.GetUserID(ctx), req.Name)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to create workspace: %v", err)
+	}
+	res.Workspace = newWorkspacePB(workspace)
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) GetWorkspace(ctx context.Context, req *pb.GetWorkspaceRequest) (*pb.GetWorkspaceResponse, error) {
+	res := pb.GetWorkspaceResponse{}
+	workspace, err := server.usecase.GetWorkspace(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to get workspace: %v", err)
+	}
+	res.Workspace = newWorkspacePB(workspace)
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesRequest) (*pb.ListWorkspacesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListWorkspacesResponse{}
+	workspaces, nextPageToken, err := server.usecase.ListWorkspaces(myContext.GetUserID(ctx), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list workspaces: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+	for _, workspace := range workspaces {
+		res.Workspaces = append(res.Workspaces, newWorkspacePB(workspace))
+	}
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) DeleteWorkspace(ctx context.Context, req *pb.DeleteWorkspaceRequest) (*pb.DeleteWorkspaceResponse, error) {
+	res := pb.DeleteWorkspaceResponse{}
+	err := server.usecase.DeleteWorkspace(myContext.GetUserID(ctx), int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to delete workspace: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) ListWorkspaceMembers(ctx context.Context, req *pb.ListWorkspaceMembersRequest) (*pb.ListWorkspaceMembersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.ListWorkspaceMembersResponse{}
+	members, nextPageToken, err := server.usecase.ListWorkspaceMembers(myContext.GetUserID(ctx), int(req.WorkspaceId), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to list workspace members: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+	for _, member := range members {
+		res.Members = append(res.Members, newWorkspaceMemberPB(member))
+	}
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) UpdateWorkspaceMember(ctx context.Context, req *pb.UpdateWorkspaceMemberRequest) (*pb.UpdateWorkspaceMemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.UpdateWorkspaceMemberResponse{}
+	member, err := server.usecase.UpdateWorkspaceMember(
+		myContext.GetUserID(ctx),
+		domain.WorkspaceMember{
+			WorkspaceID: uint(req.WorkspaceId),
+			UserID:      uint(req.UserId),
+			Role:        req.Role,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to update workspace member: %v", err)
+	}
+	res.Member = newWorkspaceMemberPB(member)
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) RemoveWorkspaceMember(ctx context.Context, req *pb.RemoveWorkspaceMemberRequest) (*pb.RemoveWorkspaceMemberResponse, error) {
+	res := pb.RemoveWorkspaceMemberResponse{}
+	err := server.usecase.RemoveWorkspaceMember(myContext.GetUserID(ctx), int(req.WorkspaceId), int(req.UserId))
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to remove workspace member: %v", err)
+	}
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) InviteWorkspaceMember(ctx context.Context, req *pb.InviteWorkspaceMemberRequest) (*pb.InviteWorkspaceMemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.InviteWorkspaceMemberResponse{}
+	invitation, err := server.usecase.InviteWorkspaceMember(myContext.GetUserID(ctx), int(req.WorkspaceId), req.Email, req.Role)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to invite workspace member: %v", err)
+	}
+	res.Invitation = &pb.WorkspaceInvitation{
+		Id:          int32(invitation.ID),
+		WorkspaceId: int32(invitation.WorkspaceID),
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+		ExpiresAt:   &timestamppb.Timestamp{Seconds: int64(invitation.ExpiresAt.Unix()), Nanos: int32(invitation.ExpiresAt.Nanosecond())},
+		CreatedAt:   &timestamppb.Timestamp{Seconds: int64(invitation.CreatedAt.Unix()), Nanos: int32(invitation.CreatedAt.Nanosecond())},
+	}
+
+	return &res, nil
+}
+
+func (server *workspaceGRPCServer) AcceptWorkspaceInvitation(ctx context.Context, req *pb.AcceptWorkspaceInvitationRequest) (*pb.AcceptWorkspaceInvitationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %v", err)
+	}
+
+	res := pb.AcceptWorkspaceInvitationResponse{}
+	workspace, err := server.usecase.AcceptWorkspaceInvitation(myContext.GetUserID(ctx), req.Token)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err), "failed to accept workspace invitation: %v", err)
+	}
+	res.Workspace = newWorkspacePB(workspace)
+
+	return &res, nil
+}
+
+func newWorkspacePB(workspace domain.WorkspaceMembership) *pb.Workspace {
+	return &pb.Workspace{
+		Id:        int32(workspace.ID),
+		Name:      workspace.Name,
+		Role:      workspace.Role,
+		CreatedAt: &timestamppb.Timestamp{Seconds: int64(workspace.CreatedAt.Unix()), Nanos: int32(workspace.CreatedAt.Nanosecond())},
+		UpdatedAt: &timestamppb.Timestamp{Seconds: int64(workspace.UpdatedAt.Unix()), Nanos: int32(workspace.UpdatedAt.Nanosecond())},
+	}
+}
+
+func newWorkspaceMemberPB(member domain.WorkspaceMember) *pb.WorkspaceMember {
+	return &pb.WorkspaceMember{
+		WorkspaceId: int32(member.WorkspaceID),
+		UserId:      int32(member.UserID),
+		Role:        member.Role,
+		CreatedAt:   &timestamppb.Timestamp{Seconds: int64(member.CreatedAt.Unix()), Nanos: int32(member.CreatedAt.Nanosecond())},
+	}
+}
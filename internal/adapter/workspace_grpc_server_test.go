@@ -0,0 +1,261 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/usecase"
+	"github.com/loak155/techbranch-backend/mock"
+	myContext "github.com/loak155/techbranch-backend/pkg/context"
+	"github.com/loak155/techbranch-backend/pkg/mail"
+	"github.com/loak155/techbranch-backend/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func newTestWorkspaceGRPCServer(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) pb.WorkspaceServiceServer {
+	server := grpc.NewServer()
+	server.GracefulStop()
+
+	return NewWorkspaceGRPCServer(server, usecase.NewWorkspaceUsecase(repo, userRepo, mail.WorkspaceInvitationMailManager{}, time.Hour))
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.CreateWorkspaceRequest
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res *pb.CreateWorkspaceResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateWorkspaceRequest{Name: "team"},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().CreateWorkspace(gomock.Any(), 1).DoAndReturn(func(workspace *domain.Workspace, ownerID int) error {
+					workspace.ID = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWorkspaceResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(1), res.Workspace.Id)
+				assert.Equal(t, "team", res.Workspace.Name)
+				assert.Equal(t, domain.WorkspaceRoleOwner, res.Workspace.Role)
+			},
+		},
+		{
+			name: "InvalidArgument",
+			req:  &pb.CreateWorkspaceRequest{},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateWorkspaceResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestWorkspaceGRPCServer(repo, nil)
+			res, err := s.CreateWorkspace(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetWorkspace(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res *pb.GetWorkspaceResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetWorkspaceResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "team", res.Workspace.Name)
+				assert.Equal(t, domain.WorkspaceRoleEditor, res.Workspace.Role)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetWorkspaceResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestWorkspaceGRPCServer(repo, nil)
+			res, err := s.GetWorkspace(myContext.SetUserID(context.Background(), 1), &pb.GetWorkspaceRequest{Id: 1})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListWorkspaceMembers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+	repo.EXPECT().ListWorkspaceMembers(1, 2, "").Return(&[]domain.WorkspaceMember{
+		{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleOwner},
+		{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer},
+	}, "next", nil)
+
+	s := newTestWorkspaceGRPCServer(repo, nil)
+	res, err := s.ListWorkspaceMembers(myContext.SetUserID(context.Background(), 1), &pb.ListWorkspaceMembersRequest{WorkspaceId: 1, PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, res.Members, 2)
+	assert.Equal(t, domain.WorkspaceRoleOwner, res.Members[0].Role)
+	assert.Equal(t, "next", res.NextPageToken)
+}
+
+func TestUpdateWorkspaceMember(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateWorkspaceMemberRequest
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res *pb.UpdateWorkspaceMemberResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UpdateWorkspaceMemberRequest{WorkspaceId: 1, UserId: 2, Role: domain.WorkspaceRoleEditor},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+				repo.EXPECT().GetWorkspaceMember(1, 2).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().UpdateWorkspaceMember(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateWorkspaceMemberResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.WorkspaceRoleEditor, res.Member.Role)
+			},
+		},
+		{
+			name: "InvalidRole",
+			req:  &pb.UpdateWorkspaceMemberRequest{WorkspaceId: 1, UserId: 2, Role: "admin"},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateWorkspaceMemberResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "LastOwner",
+			req:  &pb.UpdateWorkspaceMemberRequest{WorkspaceId: 1, UserId: 1, Role: domain.WorkspaceRoleViewer},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Times(2)
+				repo.EXPECT().CountWorkspaceOwners(1).Return(1, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateWorkspaceMemberResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			s := newTestWorkspaceGRPCServer(repo, nil)
+			res, err := s.UpdateWorkspaceMember(myContext.SetUserID(context.Background(), 1), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestAcceptWorkspaceInvitation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res *pb.AcceptWorkspaceInvitationResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				invitation := &domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", Role: domain.WorkspaceRoleViewer, ExpiresAt: time.Now().Add(time.Hour)}
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(invitation, nil)
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Email: "member@example.com"}, nil)
+				repo.EXPECT().AcceptWorkspaceInvitation(invitation, 1).Return(nil)
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptWorkspaceInvitationResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int32(1), res.Workspace.Id)
+				assert.Equal(t, domain.WorkspaceRoleViewer, res.Workspace.Role)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptWorkspaceInvitationResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "EmailMismatch",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Email: "other@example.com"}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptWorkspaceInvitationResponse, err error) {
+				assert.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			s := newTestWorkspaceGRPCServer(repo, userRepo)
+			res, err := s.AcceptWorkspaceInvitation(myContext.SetUserID(context.Background(), 1), &pb.AcceptWorkspaceInvitationRequest{Token: "token"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -2,13 +2,51 @@ package domain
 
 import (
 	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 type Article struct {
-	ID        uint      `json:"id"`
-	Title     string    `json:"title"`
-	Url       string    `json:"url"`
-	Image     string    `json:"image"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                uint           `json:"id"`
+	Title             string         `json:"title"`
+	Url               string         `json:"url"`
+	NormalizedUrl     string         `json:"normalized_url"`
+	Image             string         `json:"image"`
+	Description       string         `json:"description"`
+	Body              string         `json:"body"`
+	Site              string         `json:"site"`
+	Tags              pq.StringArray `json:"tags" gorm:"type:text[]"`
+	Language          string         `json:"language"`
+	SubmittedByUserID *uint          `json:"submitted_by_user_id"`
+	Version           int            `json:"version" gorm:"default:1"`
+	BookmarkCount     int            `json:"bookmark_count" gorm:"->"`
+	CommentCount      int            `json:"comment_count" gorm:"->"`
+	TrendingScore     float64        `json:"trending_score" gorm:"->"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+const (
+	ArticleOrderNewest         = "newest"
+	ArticleOrderMostBookmarked = "most_bookmarked"
+	ArticleOrderMostCommented  = "most_commented"
+	ArticleOrderTrending       = "trending"
+)
+
+type ArticleSearchQuery struct {
+	Query     string
+	Tag       string
+	Site      string
+	From      time.Time
+	To        time.Time
+	PageSize  int
+	PageToken string
+}
+
+type ArticleSearchResult struct {
+	Article   `gorm:"embedded"`
+	Rank      float32 `json:"rank"`
+	Highlight string  `json:"highlight"`
 }
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+)
+
+type ArticleLink struct {
+	ID            uint       `json:"id"`
+	ArticleID     uint       `json:"article_id"`
+	Url           string     `json:"url"`
+	StatusCode    int        `json:"status_code"`
+	RedirectUrl   string     `json:"redirect_url"`
+	LastError     string     `json:"last_error"`
+	FailureCount  int        `json:"failure_count"`
+	Broken        bool       `json:"broken"`
+	LastCheckedAt *time.Time `json:"last_checked_at"`
+	NextCheckAt   time.Time  `json:"next_check_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Article       Article    `json:"article"`
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type ArticleRevision struct {
+	ID           uint           `json:"id"`
+	ArticleID    uint           `json:"article_id"`
+	Revision     int            `json:"revision"`
+	EditorUserID *uint          `json:"editor_user_id"`
+	Title        string         `json:"title"`
+	Url          string         `json:"url"`
+	Image        string         `json:"image"`
+	Description  string         `json:"description"`
+	Body         string         `json:"body"`
+	Tags         pq.StringArray `json:"tags" gorm:"type:text[]"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type ArticleFieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
@@ -2,12 +2,77 @@ package domain
 
 import (
 	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 type Bookmark struct {
-	ID        uint      `json:"id"`
-	UserID    uint      `json:"user_id"`
-	ArticleID uint      `json:"article_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                 uint           `json:"id"`
+	UserID             uint           `json:"user_id"`
+	ArticleID          uint           `json:"article_id"`
+	CollectionID       *uint          `json:"collection_id"`
+	Position           int            `json:"position"`
+	Note               string         `json:"note"`
+	Highlights         pq.StringArray `json:"highlights" gorm:"type:text[]"`
+	Tags               pq.StringArray `json:"tags" gorm:"type:text[]"`
+	ReadAt             *time.Time     `json:"read_at"`
+	Progress           int            `json:"progress"`
+	RemindAt           *time.Time     `json:"remind_at"`
+	RemindedAt         *time.Time     `json:"reminded_at"`
+	ReminderErrorCount int            `json:"reminder_error_count" gorm:"<-:update"`
+	ReminderRetryAt    *time.Time     `json:"reminder_retry_at" gorm:"<-:update"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+const (
+	BookmarkReadStateRead   = "read"
+	BookmarkReadStateUnread = "unread"
+)
+
+type BookmarkListQuery struct {
+	UserID       int
+	WorkspaceID  int
+	CollectionID int
+	Tag          string
+	ReadState    string
+	PageSize     int
+	PageToken    string
+}
+
+type BookmarkSearchQuery struct {
+	UserID    int
+	Query     string
+	Tag       string
+	PageSize  int
+	PageToken string
+}
+
+type BookmarkSearchResult struct {
+	Bookmark `gorm:"embedded"`
+	Rank     float32 `json:"rank"`
+	Snippet  string  `json:"snippet"`
+}
+
+type BookmarkExportItem struct {
+	Bookmark       `gorm:"embedded"`
+	Title          string `json:"title"`
+	Url            string `json:"url"`
+	CollectionName string `json:"collection_name"`
+}
+
+type BookmarkDigestItem struct {
+	Bookmark `gorm:"embedded"`
+	Title    string `json:"title"`
+	Url      string `json:"url"`
+}
+
+type BookmarkReminder struct {
+	Bookmark `gorm:"embedded"`
+	Title    string `json:"title"`
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
 }
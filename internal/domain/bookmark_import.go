@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	BookmarkImportStatusPending   = "pending"
+	BookmarkImportStatusRunning   = "running"
+	BookmarkImportStatusCompleted = "completed"
+	BookmarkImportStatusFailed    = "failed"
+)
+
+type BookmarkImport struct {
+	ID             uint                  `json:"id"`
+	UserID         uint                  `json:"user_id"`
+	Format         string                `json:"format"`
+	Status         string                `json:"status"`
+	Data           string                `json:"-"`
+	TotalCount     int                   `json:"total_count"`
+	ProcessedCount int                   `json:"processed_count"`
+	CreatedCount   int                   `json:"created_count"`
+	ExistingCount  int                   `json:"existing_count"`
+	FailedCount    int                   `json:"failed_count"`
+	LastError      string                `json:"last_error"`
+	StartedAt      *time.Time            `json:"started_at"`
+	FinishedAt     *time.Time            `json:"finished_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	Errors         []BookmarkImportError `json:"errors" gorm:"foreignKey:ImportID"`
+}
+
+type BookmarkImportError struct {
+	ID        uint      `json:"id"`
+	ImportID  uint      `json:"import_id"`
+	ItemIndex int       `json:"item_index"`
+	Url       string    `json:"url"`
+	Title     string    `json:"title"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
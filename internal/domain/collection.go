@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	CollectionVisibilityPrivate  = "private"
+	CollectionVisibilityUnlisted = "unlisted"
+	CollectionVisibilityPublic   = "public"
+)
+
+type Collection struct {
+	ID          uint      `json:"id"`
+	UserID      uint      `json:"user_id"`
+	WorkspaceID *uint     `json:"workspace_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Position    int       `json:"position"`
+	Visibility  string    `json:"visibility"`
+	ShareToken  string    `json:"share_token"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type SharedCollectionItem struct {
+	Article Article   `json:"article"`
+	AddedAt time.Time `json:"added_at"`
+}
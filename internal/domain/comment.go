@@ -2,13 +2,20 @@ package domain
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Comment struct {
-	ID        uint      `json:"id"`
-	UserID    uint      `json:"user_id"`
-	ArticleID uint      `json:"article_id"`
-	Content   string    `json:"content"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uint           `json:"id"`
+	UserID          uint           `json:"user_id"`
+	ArticleID       uint           `json:"article_id"`
+	WorkspaceID     *uint          `json:"workspace_id"`
+	ParentCommentID *uint          `json:"parent_comment_id"`
+	Depth           int            `json:"depth"`
+	Content         string         `json:"content"`
+	ReplyCount      int            `json:"reply_count" gorm:"->"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
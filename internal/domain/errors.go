@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrAlreadyExists    = errors.New("already exists")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrVersionConflict  = errors.New("version conflict")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrInvalidArgument  = errors.New("invalid argument")
+)
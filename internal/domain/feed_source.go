@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+)
+
+type FeedSource struct {
+	ID            uint       `json:"id"`
+	Url           string     `json:"url"`
+	Title         string     `json:"title"`
+	Etag          string     `json:"etag"`
+	LastModified  string     `json:"last_modified"`
+	LastFetchedAt *time.Time `json:"last_fetched_at"`
+	NextFetchAt   time.Time  `json:"next_fetch_at"`
+	ErrorCount    int        `json:"error_count"`
+	LastError     string     `json:"last_error"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type SkippedOutline struct {
+	Title  string `json:"title"`
+	Url    string `json:"url"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,8 @@
+package domain
+
+type ImageThumbnail struct {
+	Size    string `json:"size"`
+	Width   int    `json:"width"`
+	WebpUrl string `json:"webp_url"`
+	JpegUrl string `json:"jpeg_url"`
+}
@@ -0,0 +1,6 @@
+package domain
+
+type RecommendedArticle struct {
+	Article `gorm:"embedded"`
+	Score   float64 `json:"score"`
+}
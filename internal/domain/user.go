@@ -2,14 +2,33 @@ package domain
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type User struct {
-	ID        uint      `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Password  string    `json:"password"`
-	GoogleID  string    `json:"google_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               uint           `json:"id"`
+	Username         string         `json:"username"`
+	Email            string         `json:"email"`
+	Password         string         `json:"password"`
+	GoogleID         string         `json:"google_id"`
+	BookmarksPublic  bool           `json:"bookmarks_public"`
+	DigestEnabled    bool           `json:"digest_enabled" gorm:"default:true"`
+	DigestSentAt     *time.Time     `json:"digest_sent_at"`
+	DigestErrorCount int            `json:"digest_error_count" gorm:"<-:update"`
+	DigestRetryAt    *time.Time     `json:"digest_retry_at" gorm:"<-:update"`
+	Role             string         `json:"role" gorm:"default:user"`
+	Version          int            `json:"version" gorm:"default:1"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+const (
+	UserRoleUser      = "user"
+	UserRoleModerator = "moderator"
+)
+
+func (user User) IsModerator() bool {
+	return user.Role == UserRoleModerator
 }
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleEditor = "editor"
+	WorkspaceRoleViewer = "viewer"
+)
+
+var workspaceRoleRanks = map[string]int{
+	WorkspaceRoleViewer: 1,
+	WorkspaceRoleEditor: 2,
+	WorkspaceRoleOwner:  3,
+}
+
+type Workspace struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID uint      `json:"workspace_id" gorm:"primaryKey;autoIncrement:false"`
+	UserID      uint      `json:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (member WorkspaceMember) HasRole(role string) bool {
+	return workspaceRoleRanks[member.Role] >= workspaceRoleRanks[role]
+}
+
+type WorkspaceMembership struct {
+	Workspace `gorm:"embedded"`
+	Role      string `json:"role"`
+}
+
+type WorkspaceInvitation struct {
+	ID              uint       `json:"id"`
+	WorkspaceID     uint       `json:"workspace_id"`
+	Email           string     `json:"email"`
+	Role            string     `json:"role"`
+	Token           string     `json:"token"`
+	InvitedByUserID *uint      `json:"invited_by_user_id"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	AcceptedAt      *time.Time `json:"accepted_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
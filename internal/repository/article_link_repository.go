@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type IArticleLinkRepository interface {
+	ListDueArticleLinks(now time.Time, limit int) (*[]domain.ArticleLink, error)
+	UpdateArticleLinkCheckState(link *domain.ArticleLink) error
+	ListBrokenArticleLinks(pageSize int, pageToken string) (*[]domain.ArticleLink, string, error)
+}
+
+type articleLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewArticleLinkRepository(db *gorm.DB) IArticleLinkRepository {
+	return &articleLinkRepository{db}
+}
+
+func saveArticleLink(tx *gorm.DB, article *domain.Article) error {
+	err := tx.Exec(`INSERT INTO article_links (article_id, url) VALUES (?, ?)
+ON CONFLICT (article_id) DO UPDATE SET url = EXCLUDED.url, status_code = 0, redirect_url = '', last_error = '', failure_count = 0, broken = false, next_check_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE article_links.url <> EXCLUDED.url`, article.ID, article.Url).Error
+	return err
+}
+
+func (repo *articleLinkRepository) ListDueArticleLinks(now time.Time, limit int) (*[]domain.ArticleLink, error) {
+	links := &[]domain.ArticleLink{}
+	err := repo.db.Where("next_check_at <= ? AND article_id IN (?)", now, repo.db.Model(&domain.Article{}).Select("id")).
+		Order("next_check_at").Limit(limit).Find(links).Error
+	return links, err
+}
+
+func (repo *articleLinkRepository) UpdateArticleLinkCheckState(link *domain.ArticleLink) error {
+	err := repo.db.Model(link).
+		Select("status_code", "redirect_url", "last_error", "failure_count", "broken", "last_checked_at", "next_check_at").
+		Updates(link).Error
+	return err
+}
+
+func (repo *articleLinkRepository) ListBrokenArticleLinks(pageSize int, pageToken string) (*[]domain.ArticleLink, string, error) {
+	links := &[]domain.ArticleLink{}
+	query, err := keysetPage(repo.db, pageToken, pageSize, "article_links", true)
+	if err != nil {
+		return links, "", err
+	}
+	if err := query.Preload("Article").Where("broken = ?", true).Find(links).Error; err != nil {
+		return links, "", err
+	}
+	return links, trimPage(links, pageSize, articleLinkCursor), nil
+}
+
+func articleLinkCursor(link domain.ArticleLink) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: link.CreatedAt, ID: link.ID}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+)
+
+func TestListDueArticleLinks(t *testing.T) {
+	now := time.Now()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "article_id", "url", "next_check_at", "created_at", "updated_at"}).
+		AddRow(1, 1, "https://example.com", now, now, now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "article_links" WHERE next_check_at <= $1 AND article_id IN (SELECT "id" FROM "articles" WHERE "articles"."deleted_at" IS NULL) ORDER BY next_check_at LIMIT $2`)).
+		WithArgs(now, 50).
+		WillReturnRows(rows)
+
+	repo := NewArticleLinkRepository(db)
+	links, err := repo.ListDueArticleLinks(now, 50)
+	if err != nil {
+		t.Fatalf("failed to list article links: %s", err)
+	}
+	if len(*links) != 1 {
+		t.Errorf("unexpected article links: %v", *links)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Due Article Links: %v", err)
+	}
+}
+
+func TestUpdateArticleLinkCheckState(t *testing.T) {
+	now := time.Now()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "article_links" SET "status_code"=$1,"redirect_url"=$2,"last_error"=$3,"failure_count"=$4,"broken"=$5,"last_checked_at"=$6,"next_check_at"=$7,"updated_at"=$8 WHERE "id" = $9`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewArticleLinkRepository(db)
+	err = repo.UpdateArticleLinkCheckState(&domain.ArticleLink{ID: 1, StatusCode: 404, FailureCount: 3, Broken: true, LastCheckedAt: &now, NextCheckAt: now})
+	if err != nil {
+		t.Fatalf("failed to update article link: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Article Link Check State: %v", err)
+	}
+}
+
+func TestListBrokenArticleLinks(t *testing.T) {
+	now := time.Now()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "article_id", "url", "broken", "created_at"}).
+		AddRow(1, 1, "https://example.com", true, now)
+	articleRows := sqlmock.NewRows([]string{"id", "title", "url"}).
+		AddRow(1, "title", "https://example.com")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "article_links" WHERE broken = $1 ORDER BY article_links.created_at desc, article_links.id desc LIMIT $2`)).
+		WithArgs(true, 21).
+		WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE "articles"."id" = $1 AND "articles"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(articleRows)
+
+	repo := NewArticleLinkRepository(db)
+	links, nextPageToken, err := repo.ListBrokenArticleLinks(20, "")
+	if err != nil {
+		t.Fatalf("failed to list broken article links: %s", err)
+	}
+	if len(*links) != 1 || nextPageToken != "" || (*links)[0].Article.Title != "title" {
+		t.Errorf("unexpected article links: %v %q", *links, nextPageToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Broken Article Links: %v", err)
+	}
+}
@@ -1,7 +1,12 @@
 package repository
 
 import (
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -9,24 +14,46 @@ import (
 type IArticleRepository interface {
 	CreateArticle(article *domain.Article) error
 	GetArticle(id int) (*domain.Article, error)
-	ListArticles(offset, limit int) (*[]domain.Article, error)
-	UpdateArticle(article *domain.Article) error
+	ListArticles(orderBy string, pageSize int, pageToken string) (*[]domain.Article, string, error)
+	UpdateArticle(article *domain.Article, columns []string, editorUserID uint) error
 	DeleteArticle(id int) error
 	GetArticleCount() (int, error)
-	GetBookmarkedArticles(userID int) (*[]domain.Article, error)
+	GetBookmarkedArticles(userID int, readState string, pageSize int, pageToken string) (*[]domain.Article, string, error)
+	ListArticlesByTag(tag string, pageSize int, pageToken string) (*[]domain.Article, string, error)
+	SearchArticles(query domain.ArticleSearchQuery) (*[]domain.ArticleSearchResult, string, error)
+	ListRelatedArticles(id, limit int) (*[]domain.Article, error)
+	RefreshArticleScores(gravity float64) error
+	ExistsArticleByNormalizedUrl(normalizedUrl string) (bool, error)
+	GetArticleByNormalizedUrl(normalizedUrl string) (*domain.Article, error)
+	ListArticleUrls(afterID, limit int) (*[]domain.Article, error)
+	UpdateArticleNormalizedUrl(id int, normalizedUrl string) error
+	ListArticlesByIDs(ids []int) (*[]domain.Article, error)
+	RestoreArticle(id int) error
+	PurgeDeletedArticles(before time.Time) error
 }
 
 type articleRepository struct {
-	db *gorm.DB
+	db             *gorm.DB
+	searchLanguage string
 }
 
-func NewArticleRepository(db *gorm.DB) IArticleRepository {
-	return &articleRepository{db}
+func NewArticleRepository(db *gorm.DB, searchLanguage string) IArticleRepository {
+	return &articleRepository{db, searchLanguage}
 }
 
 func (repo *articleRepository) CreateArticle(article *domain.Article) error {
-	err := repo.db.Create(article).Error
-	return err
+	if article.Language == "" {
+		article.Language = repo.searchLanguage
+	}
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(article).Error; err != nil {
+			return err
+		}
+		if err := saveArticleLink(tx, article); err != nil {
+			return err
+		}
+		return createArticleRevision(tx, article, article.SubmittedByUserID)
+	})
 }
 
 func (repo *articleRepository) GetArticle(id int) (*domain.Article, error) {
@@ -35,15 +62,53 @@ func (repo *articleRepository) GetArticle(id int) (*domain.Article, error) {
 	return article, err
 }
 
-func (repo *articleRepository) ListArticles(offset, limit int) (*[]domain.Article, error) {
+var articleOrderColumns = map[string]string{
+	domain.ArticleOrderMostBookmarked: "bookmark_count",
+	domain.ArticleOrderMostCommented:  "comment_count",
+	domain.ArticleOrderTrending:       "trending_score",
+}
+
+func (repo *articleRepository) ListArticles(orderBy string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
 	articles := &[]domain.Article{}
-	err := repo.db.Order("created_at desc").Offset(offset).Limit(limit).Find(articles).Error
-	return articles, err
+	column, ok := articleOrderColumns[orderBy]
+	if !ok {
+		query, err := keysetPage(repo.db, pageToken, pageSize, "articles", true)
+		if err != nil {
+			return articles, "", err
+		}
+		if err := query.Find(articles).Error; err != nil {
+			return articles, "", err
+		}
+		return articles, trimPage(articles, pageSize, articleCursor), nil
+	}
+
+	query, err := scorePage(repo.db, pageToken, pageSize, "articles", column)
+	if err != nil {
+		return articles, "", err
+	}
+	if err := query.Find(articles).Error; err != nil {
+		return articles, "", err
+	}
+	return articles, trimPage(articles, pageSize, func(article domain.Article) pagination.Cursor {
+		return articleScoreCursor(orderBy, article)
+	}), nil
 }
 
-func (repo *articleRepository) UpdateArticle(article *domain.Article) error {
-	err := repo.db.Model(article).Clauses(clause.Returning{}).Updates(article).Error
-	return err
+func (repo *articleRepository) UpdateArticle(article *domain.Article, columns []string, editorUserID uint) error {
+	version := article.Version
+	article.Version = version + 1
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(article).Clauses(clause.Returning{}).Select(append(columns, "version")).Where("version = ?", version).Updates(article)
+		if err := checkVersion(tx, result, &domain.Article{}, article.ID); err != nil {
+			return err
+		}
+		if slices.Contains(columns, "url") {
+			if err := saveArticleLink(tx, article); err != nil {
+				return err
+			}
+		}
+		return createArticleRevision(tx, article, &editorUserID)
+	})
 }
 
 func (repo *articleRepository) DeleteArticle(id int) error {
@@ -57,8 +122,158 @@ func (repo *articleRepository) GetArticleCount() (int, error) {
 	return int(count), err
 }
 
-func (repo *articleRepository) GetBookmarkedArticles(userID int) (*[]domain.Article, error) {
+func (repo *articleRepository) GetBookmarkedArticles(userID int, readState string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
+	articles := &[]domain.Article{}
+	db := repo.db.Model(&domain.Article{}).Joins("JOIN bookmarks ON articles.id = bookmarks.article_id").Where("bookmarks.user_id = ? AND bookmarks.deleted_at IS NULL", userID)
+	query, err := keysetPage(whereReadState(db, readState), pageToken, pageSize, "articles", true)
+	if err != nil {
+		return articles, "", err
+	}
+	if err := query.Find(articles).Error; err != nil {
+		return articles, "", err
+	}
+	return articles, trimPage(articles, pageSize, articleCursor), nil
+}
+
+func (repo *articleRepository) ListArticlesByTag(tag string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
+	articles := &[]domain.Article{}
+	query, err := keysetPage(repo.db.Where("? = ANY(articles.tags)", tag), pageToken, pageSize, "articles", true)
+	if err != nil {
+		return articles, "", err
+	}
+	if err := query.Find(articles).Error; err != nil {
+		return articles, "", err
+	}
+	return articles, trimPage(articles, pageSize, articleCursor), nil
+}
+
+func (repo *articleRepository) SearchArticles(query domain.ArticleSearchQuery) (*[]domain.ArticleSearchResult, string, error) {
+	results := &[]domain.ArticleSearchResult{}
+	cursor, err := pagination.DecodeToken(query.PageToken)
+	if err != nil {
+		return results, "", err
+	}
+
+	conditions := []string{"articles.search_vector @@ q.query", "articles.deleted_at IS NULL"}
+	args := []interface{}{repo.searchLanguage, query.Query, repo.searchLanguage, query.Query}
+	if query.Tag != "" {
+		conditions = append(conditions, "? = ANY(articles.tags)")
+		args = append(args, query.Tag)
+	}
+	if query.Site != "" {
+		conditions = append(conditions, "articles.site = ?")
+		args = append(args, query.Site)
+	}
+	if !query.From.IsZero() {
+		conditions = append(conditions, "articles.created_at >= ?")
+		args = append(args, query.From)
+	}
+	if !query.To.IsZero() {
+		conditions = append(conditions, "articles.created_at < ?")
+		args = append(args, query.To)
+	}
+	if cursor != nil {
+		conditions = append(conditions, "(ts_rank_cd(articles.search_vector, q.query), articles.id) < (?::real, ?)")
+		args = append(args, cursor.Rank, cursor.ID)
+	}
+	args = append(args, query.PageSize+1)
+
+	sql := `SELECT articles.*, ts_rank_cd(articles.search_vector, q.query) AS rank, ` +
+		`ts_headline(?::regconfig, coalesce(nullif(articles.description, ''), nullif(articles.body, ''), articles.title), q.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS highlight ` +
+		`FROM articles, (SELECT websearch_to_tsquery('simple', ?) || websearch_to_tsquery(?::regconfig, ?) AS query) q ` +
+		`WHERE ` + strings.Join(conditions, " AND ") + ` ORDER BY rank DESC, articles.id DESC LIMIT ?`
+
+	if err := repo.db.Raw(sql, args...).Scan(results).Error; err != nil {
+		return results, "", err
+	}
+	nextPageToken := trimPage(results, query.PageSize, func(result domain.ArticleSearchResult) pagination.Cursor {
+		return pagination.Cursor{ID: result.ID, Rank: result.Rank}
+	})
+	return results, nextPageToken, nil
+}
+
+func (repo *articleRepository) ListRelatedArticles(id, limit int) (*[]domain.Article, error) {
+	articles := &[]domain.Article{}
+	sql := `SELECT articles.* FROM articles, (` +
+		`SELECT id, tags, to_tsquery(language, replace(plainto_tsquery(language, title)::text, ' & ', ' | ')) AS query ` +
+		`FROM articles WHERE id = ? AND deleted_at IS NULL) source ` +
+		`WHERE articles.id <> source.id AND articles.deleted_at IS NULL ` +
+		`AND (articles.tags && source.tags OR articles.search_vector @@ source.query) ` +
+		`ORDER BY cardinality(ARRAY(SELECT unnest(articles.tags) INTERSECT SELECT unnest(source.tags))) + ts_rank(articles.search_vector, source.query) DESC, articles.id DESC ` +
+		`LIMIT ?`
+	err := repo.db.Raw(sql, id, limit).Scan(articles).Error
+	return articles, err
+}
+
+func (repo *articleRepository) RefreshArticleScores(gravity float64) error {
+	sql := `UPDATE articles SET bookmark_count = s.bookmark_count, comment_count = s.comment_count, ` +
+		`trending_score = (s.bookmark_count + s.comment_count) / power(extract(epoch FROM (now() - articles.created_at)) / 3600 + 2, ?) ` +
+		`FROM (SELECT articles.id, ` +
+		`(SELECT count(*) FROM bookmarks WHERE bookmarks.article_id = articles.id AND bookmarks.deleted_at IS NULL) AS bookmark_count, ` +
+		`(SELECT count(*) FROM comments WHERE comments.article_id = articles.id AND comments.deleted_at IS NULL) AS comment_count FROM articles) s ` +
+		`WHERE articles.id = s.id AND (articles.bookmark_count <> s.bookmark_count OR articles.comment_count <> s.comment_count OR articles.trending_score <> 0)`
+	return repo.db.Exec(sql, gravity).Error
+}
+
+func (repo *articleRepository) ExistsArticleByNormalizedUrl(normalizedUrl string) (bool, error) {
+	var count int64
+	err := repo.db.Unscoped().Model(&domain.Article{}).Where("normalized_url = ?", normalizedUrl).Count(&count).Error
+	return count > 0, err
+}
+
+func (repo *articleRepository) GetArticleByNormalizedUrl(normalizedUrl string) (*domain.Article, error) {
+	article := &domain.Article{}
+	err := repo.db.Where("normalized_url = ?", normalizedUrl).First(article).Error
+	return article, err
+}
+
+func (repo *articleRepository) ListArticleUrls(afterID, limit int) (*[]domain.Article, error) {
 	articles := &[]domain.Article{}
-	err := repo.db.Model(&domain.Article{}).Joins("JOIN bookmarks ON articles.id = bookmarks.article_id").Where("bookmarks.user_id = ?", userID).Find(articles).Error
+	err := repo.db.Unscoped().Select("id", "url", "normalized_url").Where("id > ?", afterID).Order("id").Limit(limit).Find(articles).Error
 	return articles, err
 }
+
+func (repo *articleRepository) UpdateArticleNormalizedUrl(id int, normalizedUrl string) error {
+	err := repo.db.Unscoped().Model(&domain.Article{ID: uint(id)}).UpdateColumn("normalized_url", normalizedUrl).Error
+	return err
+}
+
+func (repo *articleRepository) ListArticlesByIDs(ids []int) (*[]domain.Article, error) {
+	articles := &[]domain.Article{}
+	err := repo.db.Where("id = ANY(?::bigint[])", int64Array(ids)).Find(articles).Error
+	return articles, err
+}
+
+func (repo *articleRepository) RestoreArticle(id int) error {
+	return restore(repo.db, &domain.Article{}, id)
+}
+
+func (repo *articleRepository) PurgeDeletedArticles(before time.Time) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		deleted := tx.Unscoped().Model(&domain.Article{}).Select("id").Where("deleted_at < ?", before)
+		if err := tx.Unscoped().Where("article_id IN (?)", deleted).Delete(&domain.Bookmark{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("article_id IN (?)", deleted).Delete(&domain.Comment{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("deleted_at < ?", before).Delete(&domain.Article{}).Error
+	})
+}
+
+func articleCursor(article domain.Article) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: article.CreatedAt, ID: article.ID}
+}
+
+func articleScoreCursor(orderBy string, article domain.Article) pagination.Cursor {
+	cursor := pagination.Cursor{ID: article.ID}
+	switch orderBy {
+	case domain.ArticleOrderMostBookmarked:
+		cursor.Score = float64(article.BookmarkCount)
+	case domain.ArticleOrderMostCommented:
+		cursor.Score = float64(article.CommentCount)
+	case domain.ArticleOrderTrending:
+		cursor.Score = article.TrendingScore
+	}
+	return cursor
+}
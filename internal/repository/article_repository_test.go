@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/mock"
+	"gorm.io/gorm"
 )
 
 func testArticle() *domain.Article {
@@ -38,11 +40,22 @@ func TestCreateArticle(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "articles" ("title","url","image","created_at","updated_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		`INSERT INTO "articles" ("title","url","normalized_url","image","description","body","site","tags","language","submitted_by_user_id","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) RETURNING "id"`)).
 		WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_links (article_id, url) VALUES ($1, $2)`)).
+		WithArgs(1, testArticle.Url).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT COALESCE(MAX(revision), 0) FROM "article_revisions" WHERE article_id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "article_revisions" ("article_id","revision","editor_user_id","title","url","image","description","body","tags","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(1, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
-	repo := NewArticleRepository(db)
+	repo := NewArticleRepository(db, "english")
 	err = repo.CreateArticle(testArticle)
 	if err != nil {
 		t.Fatal(err)
@@ -65,11 +78,11 @@ func TestGetArticle(t *testing.T) {
 		AddRow(1, testArticle.Title, testArticle.Url, time.Now(), time.Now(), nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "articles" WHERE "articles"."id" = $1 ORDER BY "articles"."id" LIMIT $2`)).
+		`SELECT * FROM "articles" WHERE "articles"."id" = $1 AND "articles"."deleted_at" IS NULL ORDER BY "articles"."id" LIMIT $2`)).
 		WithArgs(1, 1).
 		WillReturnRows(rows)
 
-	repo := NewArticleRepository(db)
+	repo := NewArticleRepository(db, "english")
 	_, err = repo.GetArticle(1)
 	if err != nil {
 		t.Fatalf("failed to get article: %s", err)
@@ -97,8 +110,50 @@ func TestListArticles(t *testing.T) {
 		`SELECT * FROM "articles"`)).
 		WillReturnRows(rows)
 
-	repo := NewArticleRepository(db)
-	_, err = repo.ListArticles(1, 2)
+	repo := NewArticleRepository(db, "english")
+	_, _, err = repo.ListArticles(domain.ArticleOrderNewest, 2, "")
+	if err != nil {
+		t.Fatalf("failed to list article: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Find Article: %v", err)
+	}
+}
+
+func TestListArticlesOrderByTrending(t *testing.T) {
+	testArticle1 := testArticle()
+	testArticle2 := testArticle2()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "image", "trending_score", "created_at", "updated_at"}).
+		AddRow(2, testArticle2.Title, testArticle2.Url, testArticle2.Image, 0.5, time.Now(), time.Now()).
+		AddRow(1, testArticle1.Title, testArticle1.Url, testArticle1.Image, 0.25, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE "articles"."deleted_at" IS NULL ORDER BY articles.trending_score desc, articles.id desc LIMIT $1`)).
+		WithArgs(2).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	articles, nextPageToken, err := repo.ListArticles(domain.ArticleOrderTrending, 1, "")
+	if err != nil {
+		t.Fatalf("failed to list article: %s", err)
+	}
+	if len(*articles) != 1 || (*articles)[0].ID != 2 {
+		t.Errorf("unexpected articles: %v", *articles)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE (articles.trending_score, articles.id) < ($1, $2) AND "articles"."deleted_at" IS NULL ORDER BY articles.trending_score desc, articles.id desc LIMIT $3`)).
+		WithArgs(0.5, 2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, _, err = repo.ListArticles(domain.ArticleOrderTrending, 1, nextPageToken)
 	if err != nil {
 		t.Fatalf("failed to list article: %s", err)
 	}
@@ -120,22 +175,44 @@ func TestUpdateArticle(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "articles" ("title","url","image","created_at","updated_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		`INSERT INTO "articles" ("title","url","normalized_url","image","description","body","site","tags","language","submitted_by_user_id","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) RETURNING "id"`)).
 		WillReturnRows(rows)
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_links (article_id, url) VALUES ($1, $2)`)).
+		WithArgs(1, testArticle.Url).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT COALESCE(MAX(revision), 0) FROM "article_revisions" WHERE article_id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "article_revisions" ("article_id","revision","editor_user_id","title","url","image","description","body","tags","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(1, 1, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`UPDATE "articles" SET "title"=$1,"url"=$2,"image"=$3,"created_at"=$4,"updated_at"=$5 WHERE "id" = $6 RETURNING *`)).
-		WillReturnRows(rows)
+		`UPDATE "articles" SET "title"=$1,"url"=$2,"image"=$3,"version"=$4,"updated_at"=$5 WHERE version = $6 AND "articles"."deleted_at" IS NULL AND "id" = $7 RETURNING *`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_links (article_id, url) VALUES ($1, $2)`)).
+		WithArgs(1, testArticle.Url).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT COALESCE(MAX(revision), 0) FROM "article_revisions" WHERE article_id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "article_revisions" ("article_id","revision","editor_user_id","title","url","image","description","body","tags","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs(1, 2, 1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
 	mock.ExpectCommit()
 
-	repo := NewArticleRepository(db)
+	repo := NewArticleRepository(db, "english")
 	err = repo.CreateArticle(testArticle)
 	if err != nil {
 		t.Fatalf("failed to create article: %s", err)
 	}
-	err = repo.UpdateArticle(testArticle)
+	err = repo.UpdateArticle(testArticle, []string{"title", "url", "image"}, 1)
 	if err != nil {
 		t.Fatalf("failed to list article: %s", err)
 	}
@@ -145,6 +222,34 @@ func TestUpdateArticle(t *testing.T) {
 	}
 }
 
+func TestUpdateArticleVersionConflict(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE "articles" SET "title"=$1,"version"=$2,"updated_at"=$3 WHERE version = $4 AND "articles"."deleted_at" IS NULL AND "id" = $5 RETURNING *`)).
+		WithArgs("test_title", 2, sqlmock.AnyArg(), 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT count(*) FROM "articles" WHERE id = $1 AND "articles"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	repo := NewArticleRepository(db, "english")
+	err = repo.UpdateArticle(&domain.Article{ID: 1, Title: "test_title", Version: 1}, []string{"title"}, 1)
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Errorf("expected version conflict, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Article Version Conflict: %v", err)
+	}
+}
+
 func TestDeleteArticle(t *testing.T) {
 	db, mock, err := mock.NewDBMock()
 	if err != nil {
@@ -153,12 +258,12 @@ func TestDeleteArticle(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "articles" WHERE "articles"."id" = $1`)).
-		WithArgs(1).
+		`UPDATE "articles" SET "deleted_at"=$1 WHERE "articles"."id" = $2 AND "articles"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
-	repo := NewArticleRepository(db)
+	repo := NewArticleRepository(db, "english")
 	err = repo.DeleteArticle(1)
 	if err != nil {
 		t.Fatalf("failed to delete article: %s", err)
@@ -181,7 +286,7 @@ func TestGetArticleCount(t *testing.T) {
 		`SELECT count(*) FROM "articles"`)).
 		WillReturnRows(rows)
 
-	repo := NewArticleRepository(db)
+	repo := NewArticleRepository(db, "english")
 	_, err = repo.GetArticleCount()
 	if err != nil {
 		t.Fatalf("failed to get article: %s", err)
@@ -204,12 +309,12 @@ func TestGetBookmarkedArticles(t *testing.T) {
 		AddRow(1, testArticle.Title, testArticle.Url, time.Now(), time.Now(), nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT "articles"."id","articles"."title","articles"."url","articles"."image","articles"."created_at","articles"."updated_at" FROM "articles" JOIN bookmarks ON articles.id = bookmarks.article_id WHERE bookmarks.user_id = $1`)).
-		WithArgs(1).
+		`SELECT "articles"."id","articles"."title","articles"."url","articles"."normalized_url","articles"."image","articles"."description","articles"."body","articles"."site","articles"."tags","articles"."language","articles"."submitted_by_user_id","articles"."version","articles"."bookmark_count","articles"."comment_count","articles"."trending_score","articles"."created_at","articles"."updated_at","articles"."deleted_at" FROM "articles" JOIN bookmarks ON articles.id = bookmarks.article_id WHERE (bookmarks.user_id = $1 AND bookmarks.deleted_at IS NULL) AND "articles"."deleted_at" IS NULL ORDER BY articles.created_at desc, articles.id desc LIMIT $2`)).
+		WithArgs(1, 11).
 		WillReturnRows(rows)
 
-	repo := NewArticleRepository(db)
-	_, err = repo.GetBookmarkedArticles(1)
+	repo := NewArticleRepository(db, "english")
+	_, _, err = repo.GetBookmarkedArticles(1, "", 10, "")
 	if err != nil {
 		t.Fatalf("failed to get article: %s", err)
 	}
@@ -218,3 +323,315 @@ func TestGetBookmarkedArticles(t *testing.T) {
 		t.Errorf("Test Find Article: %v", err)
 	}
 }
+
+func TestListArticlesByTag(t *testing.T) {
+	testArticle := testArticle()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "image", "created_at", "updated_at"}).
+		AddRow(1, testArticle.Title, testArticle.Url, testArticle.Image, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE $1 = ANY(articles.tags) AND "articles"."deleted_at" IS NULL ORDER BY articles.created_at desc, articles.id desc LIMIT $2`)).
+		WithArgs("go", 11).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	articles, _, err := repo.ListArticlesByTag("go", 10, "")
+	if err != nil {
+		t.Fatalf("failed to list articles by tag: %s", err)
+	}
+	if len(*articles) != 1 {
+		t.Errorf("expected 1 article, got %d", len(*articles))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Articles By Tag: %v", err)
+	}
+}
+
+func TestSearchArticles(t *testing.T) {
+	testArticle := testArticle()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "image", "created_at", "updated_at", "rank", "highlight"}).
+		AddRow(1, testArticle.Title, testArticle.Url, testArticle.Image, time.Now(), time.Now(), 0.5, "<mark>test</mark>_title")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT articles.*, ts_rank_cd(articles.search_vector, q.query) AS rank, ts_headline($1::regconfig, coalesce(nullif(articles.description, ''), nullif(articles.body, ''), articles.title), q.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS highlight FROM articles, (SELECT websearch_to_tsquery('simple', $2) || websearch_to_tsquery($3::regconfig, $4) AS query) q WHERE articles.search_vector @@ q.query AND articles.deleted_at IS NULL AND $5 = ANY(articles.tags) AND articles.site = $6 ORDER BY rank DESC, articles.id DESC LIMIT $7`)).
+		WithArgs("english", "test", "english", "test", "go", "example.com", 11).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	results, _, err := repo.SearchArticles(domain.ArticleSearchQuery{Query: "test", Tag: "go", Site: "example.com", PageSize: 10})
+	if err != nil {
+		t.Fatalf("failed to search articles: %s", err)
+	}
+	if len(*results) != 1 || (*results)[0].Highlight != "<mark>test</mark>_title" {
+		t.Errorf("unexpected search results: %v", *results)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Search Articles: %v", err)
+	}
+}
+
+func TestListRelatedArticles(t *testing.T) {
+	testArticle := testArticle()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "image", "created_at", "updated_at"}).
+		AddRow(2, testArticle.Title, testArticle.Url, testArticle.Image, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT articles.* FROM articles, (SELECT id, tags, to_tsquery(language, replace(plainto_tsquery(language, title)::text, ' & ', ' | ')) AS query FROM articles WHERE id = $1 AND deleted_at IS NULL) source WHERE articles.id <> source.id AND articles.deleted_at IS NULL AND (articles.tags && source.tags OR articles.search_vector @@ source.query)`)).
+		WithArgs(1, 10).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	articles, err := repo.ListRelatedArticles(1, 10)
+	if err != nil {
+		t.Fatalf("failed to list related articles: %s", err)
+	}
+	if len(*articles) != 1 || (*articles)[0].ID != 2 {
+		t.Errorf("unexpected related articles: %v", *articles)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Related Articles: %v", err)
+	}
+}
+
+func TestRefreshArticleScores(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE articles SET bookmark_count = s.bookmark_count, comment_count = s.comment_count, trending_score = (s.bookmark_count + s.comment_count) / power(extract(epoch FROM (now() - articles.created_at)) / 3600 + 2, $1) FROM (SELECT articles.id, (SELECT count(*) FROM bookmarks WHERE bookmarks.article_id = articles.id AND bookmarks.deleted_at IS NULL) AS bookmark_count, (SELECT count(*) FROM comments WHERE comments.article_id = articles.id AND comments.deleted_at IS NULL) AS comment_count FROM articles) s WHERE articles.id = s.id AND (articles.bookmark_count <> s.bookmark_count OR articles.comment_count <> s.comment_count OR articles.trending_score <> 0)`)).
+		WithArgs(1.8).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewArticleRepository(db, "english")
+	err = repo.RefreshArticleScores(1.8)
+	if err != nil {
+		t.Fatalf("failed to refresh article scores: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Refresh Article Scores: %v", err)
+	}
+}
+
+func TestExistsArticleByNormalizedUrl(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT count(*) FROM "articles" WHERE normalized_url = $1`)).
+		WithArgs("http://example.com").
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	exists, err := repo.ExistsArticleByNormalizedUrl("http://example.com")
+	if err != nil {
+		t.Fatalf("failed to check article: %s", err)
+	}
+	if !exists {
+		t.Errorf("expected article to exist")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Exists Article: %v", err)
+	}
+}
+
+func TestGetArticleByNormalizedUrl(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "normalized_url"}).
+		AddRow(1, "title", "http://example.com", "http://example.com")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE normalized_url = $1 AND "articles"."deleted_at" IS NULL ORDER BY "articles"."id" LIMIT $2`)).
+		WithArgs("http://example.com", 1).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	article, err := repo.GetArticleByNormalizedUrl("http://example.com")
+	if err != nil {
+		t.Fatalf("failed to get article: %s", err)
+	}
+	if article.ID != 1 {
+		t.Errorf("unexpected article: %v", article)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Article By Normalized Url: %v", err)
+	}
+}
+
+func TestListArticleUrls(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "url", "normalized_url"}).
+		AddRow(2, "https://Example.com/", "https://Example.com")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT "id","url","normalized_url" FROM "articles" WHERE id > $1 ORDER BY id LIMIT $2`)).
+		WithArgs(1, 500).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	articles, err := repo.ListArticleUrls(1, 500)
+	if err != nil {
+		t.Fatalf("failed to list article urls: %s", err)
+	}
+	if len(*articles) != 1 || (*articles)[0].Url != "https://Example.com/" {
+		t.Errorf("unexpected articles: %v", articles)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Article Urls: %v", err)
+	}
+}
+
+func TestUpdateArticleNormalizedUrl(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "articles" SET "normalized_url"=$1 WHERE "id" = $2`)).
+		WithArgs("https://example.com", 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewArticleRepository(db, "english")
+	err = repo.UpdateArticleNormalizedUrl(2, "https://example.com")
+	if err != nil {
+		t.Fatalf("failed to update normalized url: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Article Normalized Url: %v", err)
+	}
+}
+
+func TestListArticlesByIDs(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url"}).
+		AddRow(1, "title1", "http://example.com/1").
+		AddRow(2, "title2", "http://example.com/2")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "articles" WHERE id = ANY($1::bigint[]) AND "articles"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	repo := NewArticleRepository(db, "english")
+	articles, err := repo.ListArticlesByIDs([]int{1, 2})
+	if err != nil {
+		t.Fatalf("failed to list articles: %s", err)
+	}
+	if len(*articles) != 2 {
+		t.Errorf("unexpected articles: %v", articles)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Articles By IDs: %v", err)
+	}
+}
+
+func TestRestoreArticle(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "articles" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "articles" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewArticleRepository(db, "english")
+	if err := repo.RestoreArticle(1); err != nil {
+		t.Fatalf("failed to restore article: %s", err)
+	}
+	if err := repo.RestoreArticle(2); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Restore Article: %v", err)
+	}
+}
+
+func TestPurgeDeletedArticles(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	before := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "bookmarks" WHERE article_id IN (SELECT "id" FROM "articles" WHERE deleted_at < $1)`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "comments" WHERE article_id IN (SELECT "id" FROM "articles" WHERE deleted_at < $1)`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "articles" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewArticleRepository(db, "english")
+	if err := repo.PurgeDeletedArticles(before); err != nil {
+		t.Fatalf("failed to purge deleted articles: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Purge Deleted Articles: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type IArticleRevisionRepository interface {
+	GetArticleRevision(articleID, revision int) (*domain.ArticleRevision, error)
+	ListArticleRevisions(articleID, pageSize int, pageToken string) (*[]domain.ArticleRevision, string, error)
+}
+
+type articleRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewArticleRevisionRepository(db *gorm.DB) IArticleRevisionRepository {
+	return &articleRevisionRepository{db}
+}
+
+func (repo *articleRevisionRepository) GetArticleRevision(articleID, revision int) (*domain.ArticleRevision, error) {
+	articleRevision := &domain.ArticleRevision{}
+	err := repo.db.Where("article_id = ? AND revision = ?", articleID, revision).First(articleRevision).Error
+	return articleRevision, err
+}
+
+func (repo *articleRevisionRepository) ListArticleRevisions(articleID, pageSize int, pageToken string) (*[]domain.ArticleRevision, string, error) {
+	revisions := &[]domain.ArticleRevision{}
+	query, err := keysetPage(repo.db.Where("article_id = ?", articleID), pageToken, pageSize, "article_revisions", true)
+	if err != nil {
+		return revisions, "", err
+	}
+	if err := query.Find(revisions).Error; err != nil {
+		return revisions, "", err
+	}
+	return revisions, trimPage(revisions, pageSize, articleRevisionCursor), nil
+}
+
+func articleRevisionCursor(revision domain.ArticleRevision) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: revision.CreatedAt, ID: revision.ID}
+}
+
+// createArticleRevision must run in the transaction that wrote the article so
+// the article row lock serializes revision numbering per article.
+func createArticleRevision(tx *gorm.DB, article *domain.Article, editorUserID *uint) error {
+	var latest int
+	err := tx.Model(&domain.ArticleRevision{}).Select("COALESCE(MAX(revision), 0)").Where("article_id = ?", article.ID).Scan(&latest).Error
+	if err != nil {
+		return err
+	}
+	return tx.Create(&domain.ArticleRevision{
+		ArticleID:    article.ID,
+		Revision:     latest + 1,
+		EditorUserID: editorUserID,
+		Title:        article.Title,
+		Url:          article.Url,
+		Image:        article.Image,
+		Description:  article.Description,
+		Body:         article.Body,
+		Tags:         article.Tags,
+	}).Error
+}
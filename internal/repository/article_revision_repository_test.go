@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+)
+
+func testArticleRevision() *domain.ArticleRevision {
+	editorUserID := uint(1)
+	return &domain.ArticleRevision{
+		ArticleID:    1,
+		EditorUserID: &editorUserID,
+		Title:        "test_title",
+		Url:          "https://example.com",
+		Tags:         []string{"go"},
+	}
+}
+
+func TestGetArticleRevision(t *testing.T) {
+	testArticleRevision := testArticleRevision()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "article_id", "revision", "title", "url", "created_at"}).
+		AddRow(1, testArticleRevision.ArticleID, 2, testArticleRevision.Title, testArticleRevision.Url, time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "article_revisions" WHERE article_id = $1 AND revision = $2 ORDER BY "article_revisions"."id" LIMIT $3`)).
+		WithArgs(1, 2, 1).
+		WillReturnRows(rows)
+
+	repo := NewArticleRevisionRepository(db)
+	revision, err := repo.GetArticleRevision(1, 2)
+	if err != nil {
+		t.Fatalf("failed to get article revision: %s", err)
+	}
+	if revision.Revision != 2 {
+		t.Errorf("expected revision 2, got %d", revision.Revision)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Article Revision: %v", err)
+	}
+}
+
+func TestListArticleRevisions(t *testing.T) {
+	testArticleRevision := testArticleRevision()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "article_id", "revision", "title", "url", "created_at"}).
+		AddRow(2, testArticleRevision.ArticleID, 2, testArticleRevision.Title, testArticleRevision.Url, time.Now()).
+		AddRow(1, testArticleRevision.ArticleID, 1, testArticleRevision.Title, testArticleRevision.Url, time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "article_revisions" WHERE article_id = $1 ORDER BY article_revisions.created_at desc, article_revisions.id desc LIMIT $2`)).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+
+	repo := NewArticleRevisionRepository(db)
+	revisions, nextPageToken, err := repo.ListArticleRevisions(1, 1, "")
+	if err != nil {
+		t.Fatalf("failed to list article revisions: %s", err)
+	}
+	if len(*revisions) != 1 || nextPageToken == "" {
+		t.Errorf("expected 1 revision with next page token, got %d %q", len(*revisions), nextPageToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Article Revisions: %v", err)
+	}
+}
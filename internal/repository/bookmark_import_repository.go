@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+type IBookmarkImportRepository interface {
+	CreateBookmarkImport(bookmarkImport *domain.BookmarkImport) error
+	GetBookmarkImport(id int) (*domain.BookmarkImport, error)
+	ClaimPendingBookmarkImport(staleBefore time.Time) (*domain.BookmarkImport, error)
+	UpdateBookmarkImport(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error
+}
+
+type bookmarkImportRepository struct {
+	db *gorm.DB
+}
+
+func NewBookmarkImportRepository(db *gorm.DB) IBookmarkImportRepository {
+	return &bookmarkImportRepository{db}
+}
+
+func (repo *bookmarkImportRepository) CreateBookmarkImport(bookmarkImport *domain.BookmarkImport) error {
+	err := repo.db.Omit("Errors").Create(bookmarkImport).Error
+	return err
+}
+
+func (repo *bookmarkImportRepository) GetBookmarkImport(id int) (*domain.BookmarkImport, error) {
+	bookmarkImport := &domain.BookmarkImport{}
+	err := repo.db.Omit("data").Preload("Errors", func(db *gorm.DB) *gorm.DB {
+		return db.Order("item_index")
+	}).First(bookmarkImport, id).Error
+	return bookmarkImport, err
+}
+
+func (repo *bookmarkImportRepository) ClaimPendingBookmarkImport(staleBefore time.Time) (*domain.BookmarkImport, error) {
+	bookmarkImport := &domain.BookmarkImport{}
+	result := repo.db.Raw(
+		"UPDATE bookmark_imports SET status = ?, started_at = COALESCE(started_at, now()), updated_at = now() "+
+			"WHERE id = (SELECT id FROM bookmark_imports WHERE status = ? OR (status = ? AND updated_at < ?) ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED) RETURNING *",
+		domain.BookmarkImportStatusRunning, domain.BookmarkImportStatusPending, domain.BookmarkImportStatusRunning, staleBefore,
+	).Scan(bookmarkImport)
+	if result.Error != nil {
+		return bookmarkImport, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return bookmarkImport, gorm.ErrRecordNotFound
+	}
+	return bookmarkImport, nil
+}
+
+func (repo *bookmarkImportRepository) UpdateBookmarkImport(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if len(importErrors) > 0 {
+			if err := tx.Create(&importErrors).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(bookmarkImport).
+			Select("status", "data", "processed_count", "created_count", "existing_count", "failed_count", "last_error", "started_at", "finished_at").
+			Updates(bookmarkImport).Error
+	})
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateBookmarkImport(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "bookmark_imports" ("user_id","format","status","data","total_count","processed_count","created_count","existing_count","failed_count","last_error","started_at","finished_at","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) RETURNING "id"`)).
+		WithArgs(1, "pocket", "pending", "data", 200, 0, 0, 0, 0, "", nil, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewBookmarkImportRepository(db)
+	bookmarkImport := &domain.BookmarkImport{UserID: 1, Format: "pocket", Status: "pending", Data: "data", TotalCount: 200}
+	err = repo.CreateBookmarkImport(bookmarkImport)
+	if err != nil {
+		t.Fatalf("failed to create bookmark import: %s", err)
+	}
+	if bookmarkImport.ID != 1 {
+		t.Errorf("unexpected bookmark import: %v", bookmarkImport)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Create Bookmark Import: %v", err)
+	}
+}
+
+func TestGetBookmarkImport(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT "bookmark_imports"."id","bookmark_imports"."user_id","bookmark_imports"."format","bookmark_imports"."status","bookmark_imports"."total_count"`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "format", "status", "total_count", "failed_count"}).AddRow(1, 1, "pocket", "completed", 2, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmark_import_errors" WHERE "bookmark_import_errors"."import_id" = $1 ORDER BY item_index`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "import_id", "item_index", "url", "reason"}).AddRow(1, 1, 1, "invalid", "invalid url"))
+
+	repo := NewBookmarkImportRepository(db)
+	bookmarkImport, err := repo.GetBookmarkImport(1)
+	if err != nil {
+		t.Fatalf("failed to get bookmark import: %s", err)
+	}
+	if bookmarkImport.Status != "completed" || len(bookmarkImport.Errors) != 1 || bookmarkImport.Errors[0].Reason != "invalid url" {
+		t.Errorf("unexpected bookmark import: %v", bookmarkImport)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Bookmark Import: %v", err)
+	}
+}
+
+func TestClaimPendingBookmarkImport(t *testing.T) {
+	staleBefore := time.Now().Add(-10 * time.Minute)
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE bookmark_imports SET status = $1, started_at = COALESCE(started_at, now()), updated_at = now() WHERE id = (SELECT id FROM bookmark_imports WHERE status = $2 OR (status = $3 AND updated_at < $4) ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED) RETURNING *`)).
+		WithArgs("running", "pending", "running", staleBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "format", "status", "data"}).AddRow(1, 1, "pocket", "running", "data"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE bookmark_imports SET status = $1`)).
+		WithArgs("running", "pending", "running", staleBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "format", "status", "data"}))
+
+	repo := NewBookmarkImportRepository(db)
+	bookmarkImport, err := repo.ClaimPendingBookmarkImport(staleBefore)
+	if err != nil {
+		t.Fatalf("failed to claim bookmark import: %s", err)
+	}
+	if bookmarkImport.ID != 1 || bookmarkImport.Data != "data" {
+		t.Errorf("unexpected bookmark import: %v", bookmarkImport)
+	}
+	_, err = repo.ClaimPendingBookmarkImport(staleBefore)
+	if err != gorm.ErrRecordNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Claim Pending Bookmark Import: %v", err)
+	}
+}
+
+func TestUpdateBookmarkImport(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	finishedAt := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "bookmark_import_errors" ("import_id","item_index","url","title","reason","created_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs(1, 3, "invalid", "", "invalid url", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "bookmark_imports" SET "status"=$1,"data"=$2,"processed_count"=$3,"created_count"=$4,"existing_count"=$5,"failed_count"=$6,"last_error"=$7,"started_at"=$8,"finished_at"=$9,"updated_at"=$10 WHERE "id" = $11`)).
+		WithArgs("completed", "", 4, 2, 1, 1, "", nil, finishedAt, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewBookmarkImportRepository(db)
+	err = repo.UpdateBookmarkImport(
+		&domain.BookmarkImport{ID: 1, Status: "completed", ProcessedCount: 4, CreatedCount: 2, ExistingCount: 1, FailedCount: 1, FinishedAt: &finishedAt},
+		[]domain.BookmarkImportError{{ImportID: 1, ItemIndex: 3, Url: "invalid", Reason: "invalid url"}},
+	)
+	if err != nil {
+		t.Fatalf("failed to update bookmark import: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Bookmark Import: %v", err)
+	}
+}
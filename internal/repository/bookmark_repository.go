@@ -1,18 +1,36 @@
 package repository
 
 import (
+	"strings"
+	"time"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type IBookmarkRepository interface {
 	CreateBookmark(bookmark *domain.Bookmark) error
 	GetBookmarkCountByArticleID(articleID int) (int, error)
-	ListBookmarksByUserID(userID int) (*[]domain.Bookmark, error)
-	ListBookmarksByArticleID(articleID int) (*[]domain.Bookmark, error)
+	GetBookmark(id int) (*domain.Bookmark, error)
+	GetBookmarkByUserIDAndArticleID(userID, articleID int) (*domain.Bookmark, error)
+	ListBookmarksByUserID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error)
+	ListBookmarksByWorkspaceID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error)
+	UpdateBookmark(bookmark *domain.Bookmark, columns []string) error
+	SearchBookmarks(query domain.BookmarkSearchQuery) (*[]domain.BookmarkSearchResult, string, error)
+	SetBookmarksRead(userID int, articleIDs []int, read bool) (int, error)
+	GetUnreadBookmarkCount(userID int) (int, error)
+	ListBookmarkExportItems(userID int) (*[]domain.BookmarkExportItem, error)
+	ListBookmarkDigestItems(userID, limit int) (*[]domain.BookmarkDigestItem, error)
+	ListDueBookmarkReminders(now time.Time, limit int) (*[]domain.BookmarkReminder, error)
+	MarkBookmarkReminded(id int, remindedAt time.Time) error
+	MarkBookmarkReminderFailed(id, errorCount int, retryAt time.Time) error
+	ListBookmarksByArticleID(articleID, pageSize int, pageToken string) (*[]domain.Bookmark, string, error)
 	DeleteBookmarkByUserIDAndArticleID(userID, articleID int) error
 	DeleteBookmarkByUserID(UserID int) error
 	DeleteBookmarkByArticleID(ArticleID int) error
+	PurgeDeletedBookmarks(before time.Time) error
 }
 
 type bookmarkRepository struct {
@@ -24,8 +42,18 @@ func NewBookmarkRepository(db *gorm.DB) IBookmarkRepository {
 }
 
 func (repo *bookmarkRepository) CreateBookmark(bookmark *domain.Bookmark) error {
-	err := repo.db.Create(bookmark).Error
-	return err
+	result := repo.db.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "user_id"}, {Name: "article_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+		DoNothing:   true,
+	}).Create(bookmark)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAlreadyExists
+	}
+	return nil
 }
 
 func (repo *bookmarkRepository) GetBookmarkCountByArticleID(articleID int) (int, error) {
@@ -34,16 +62,169 @@ func (repo *bookmarkRepository) GetBookmarkCountByArticleID(articleID int) (int,
 	return int(count), err
 }
 
-func (repo *bookmarkRepository) ListBookmarksByUserID(userID int) (*[]domain.Bookmark, error) {
+func (repo *bookmarkRepository) GetBookmark(id int) (*domain.Bookmark, error) {
+	bookmark := &domain.Bookmark{}
+	err := repo.db.First(bookmark, id).Error
+	return bookmark, err
+}
+
+func (repo *bookmarkRepository) GetBookmarkByUserIDAndArticleID(userID, articleID int) (*domain.Bookmark, error) {
+	bookmark := &domain.Bookmark{}
+	err := repo.db.Where("user_id=? AND article_id=?", userID, articleID).First(bookmark).Error
+	return bookmark, err
+}
+
+func (repo *bookmarkRepository) ListBookmarksByUserID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error) {
+	return listBookmarks(repo.db.Where("user_id=?", query.UserID), query)
+}
+
+func (repo *bookmarkRepository) ListBookmarksByWorkspaceID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error) {
+	return listBookmarks(repo.db.Where("collection_id IN (SELECT id FROM collections WHERE workspace_id=?)", query.WorkspaceID), query)
+}
+
+func listBookmarks(db *gorm.DB, query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error) {
 	bookmarks := &[]domain.Bookmark{}
-	err := repo.db.Where("user_id=?", userID).Find(bookmarks).Error
-	return bookmarks, err
+	if query.Tag != "" {
+		db = db.Where("? = ANY(tags)", query.Tag)
+	}
+	db = whereReadState(db, query.ReadState)
+	if query.CollectionID != 0 {
+		page, err := positionPage(db.Where("collection_id=?", query.CollectionID), query.PageToken, query.PageSize, "bookmarks")
+		if err != nil {
+			return bookmarks, "", err
+		}
+		if err := page.Find(bookmarks).Error; err != nil {
+			return bookmarks, "", err
+		}
+		return bookmarks, trimPage(bookmarks, query.PageSize, bookmarkPositionCursor), nil
+	}
+	page, err := keysetPage(db, query.PageToken, query.PageSize, "bookmarks", true)
+	if err != nil {
+		return bookmarks, "", err
+	}
+	if err := page.Find(bookmarks).Error; err != nil {
+		return bookmarks, "", err
+	}
+	return bookmarks, trimPage(bookmarks, query.PageSize, bookmarkCursor), nil
 }
 
-func (repo *bookmarkRepository) ListBookmarksByArticleID(articleID int) (*[]domain.Bookmark, error) {
+func (repo *bookmarkRepository) UpdateBookmark(bookmark *domain.Bookmark, columns []string) error {
+	err := repo.db.Model(bookmark).Clauses(clause.Returning{}).Select(columns).Updates(bookmark).Error
+	return err
+}
+
+func (repo *bookmarkRepository) SearchBookmarks(query domain.BookmarkSearchQuery) (*[]domain.BookmarkSearchResult, string, error) {
+	results := &[]domain.BookmarkSearchResult{}
+	cursor, err := pagination.DecodeToken(query.PageToken)
+	if err != nil {
+		return results, "", err
+	}
+
+	conditions := []string{"bookmarks.user_id = ?", "bookmarks.search_vector @@ q.query", "bookmarks.deleted_at IS NULL"}
+	args := []interface{}{query.Query, query.UserID}
+	if query.Tag != "" {
+		conditions = append(conditions, "? = ANY(bookmarks.tags)")
+		args = append(args, query.Tag)
+	}
+	if cursor != nil {
+		conditions = append(conditions, "(ts_rank_cd(bookmarks.search_vector, q.query), bookmarks.id) < (?::real, ?)")
+		args = append(args, cursor.Rank, cursor.ID)
+	}
+	args = append(args, query.PageSize+1)
+
+	sql := `SELECT bookmarks.*, ts_rank_cd(bookmarks.search_vector, q.query) AS rank, ` +
+		`ts_headline('simple', coalesce(nullif(bookmarks.note, ''), array_to_string(bookmarks.highlights, ' ')), q.query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet ` +
+		`FROM bookmarks, (SELECT websearch_to_tsquery('simple', ?) AS query) q ` +
+		`WHERE ` + strings.Join(conditions, " AND ") + ` ORDER BY rank DESC, bookmarks.id DESC LIMIT ?`
+
+	if err := repo.db.Raw(sql, args...).Scan(results).Error; err != nil {
+		return results, "", err
+	}
+	nextPageToken := trimPage(results, query.PageSize, func(result domain.BookmarkSearchResult) pagination.Cursor {
+		return pagination.Cursor{ID: result.ID, Rank: result.Rank}
+	})
+	return results, nextPageToken, nil
+}
+
+func (repo *bookmarkRepository) SetBookmarksRead(userID int, articleIDs []int, read bool) (int, error) {
+	readAt := "NULL"
+	if read {
+		readAt = "coalesce(read_at, now())"
+	}
+	result := repo.db.Exec(
+		"UPDATE bookmarks SET read_at = "+readAt+", updated_at = now() WHERE user_id = ? AND article_id = ANY(?::bigint[]) AND deleted_at IS NULL",
+		userID, int64Array(articleIDs),
+	)
+	return int(result.RowsAffected), result.Error
+}
+
+func (repo *bookmarkRepository) GetUnreadBookmarkCount(userID int) (int, error) {
+	var count int64
+	err := repo.db.Model(&domain.Bookmark{}).Where("user_id=? AND read_at IS NULL", userID).Count(&count).Error
+	return int(count), err
+}
+
+func (repo *bookmarkRepository) ListBookmarkExportItems(userID int) (*[]domain.BookmarkExportItem, error) {
+	items := &[]domain.BookmarkExportItem{}
+	err := repo.db.Raw(
+		"SELECT bookmarks.*, articles.title, articles.url, coalesce(collections.name, '') AS collection_name FROM bookmarks "+
+			"JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL "+
+			"LEFT JOIN collections ON collections.id = bookmarks.collection_id "+
+			"WHERE bookmarks.user_id = ? AND bookmarks.deleted_at IS NULL "+
+			"ORDER BY collections.position NULLS LAST, collections.id, bookmarks.position, bookmarks.id",
+		userID,
+	).Scan(items).Error
+	return items, err
+}
+
+func (repo *bookmarkRepository) ListBookmarkDigestItems(userID, limit int) (*[]domain.BookmarkDigestItem, error) {
+	items := &[]domain.BookmarkDigestItem{}
+	err := repo.db.Raw(
+		"SELECT bookmarks.*, articles.title, articles.url FROM bookmarks "+
+			"JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL "+
+			"WHERE bookmarks.user_id = ? AND bookmarks.read_at IS NULL AND bookmarks.deleted_at IS NULL "+
+			"ORDER BY bookmarks.created_at DESC, bookmarks.id DESC LIMIT ?",
+		userID, limit,
+	).Scan(items).Error
+	return items, err
+}
+
+func (repo *bookmarkRepository) ListDueBookmarkReminders(now time.Time, limit int) (*[]domain.BookmarkReminder, error) {
+	reminders := &[]domain.BookmarkReminder{}
+	err := repo.db.Raw(
+		"SELECT bookmarks.*, articles.title, articles.url, users.username, users.email FROM bookmarks "+
+			"JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL "+
+			"JOIN users ON users.id = bookmarks.user_id AND users.deleted_at IS NULL "+
+			"WHERE bookmarks.remind_at <= ? AND bookmarks.reminded_at IS NULL AND bookmarks.deleted_at IS NULL "+
+			"AND (bookmarks.reminder_retry_at IS NULL OR bookmarks.reminder_retry_at <= ?) "+
+			"ORDER BY bookmarks.remind_at, bookmarks.id LIMIT ?",
+		now, now, limit,
+	).Scan(reminders).Error
+	return reminders, err
+}
+
+func (repo *bookmarkRepository) MarkBookmarkReminded(id int, remindedAt time.Time) error {
+	err := repo.db.Model(&domain.Bookmark{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"reminded_at": remindedAt, "reminder_error_count": 0, "reminder_retry_at": nil}).Error
+	return err
+}
+
+func (repo *bookmarkRepository) MarkBookmarkReminderFailed(id, errorCount int, retryAt time.Time) error {
+	err := repo.db.Model(&domain.Bookmark{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"reminder_error_count": errorCount, "reminder_retry_at": retryAt}).Error
+	return err
+}
+
+func (repo *bookmarkRepository) ListBookmarksByArticleID(articleID, pageSize int, pageToken string) (*[]domain.Bookmark, string, error) {
 	bookmarks := &[]domain.Bookmark{}
-	err := repo.db.Where("article_id=?", articleID).Find(bookmarks).Error
-	return bookmarks, err
+	query, err := keysetPage(repo.db.Where("article_id=?", articleID), pageToken, pageSize, "bookmarks", true)
+	if err != nil {
+		return bookmarks, "", err
+	}
+	if err := query.Find(bookmarks).Error; err != nil {
+		return bookmarks, "", err
+	}
+	return bookmarks, trimPage(bookmarks, pageSize, bookmarkCursor), nil
 }
 
 func (repo *bookmarkRepository) DeleteBookmarkByUserIDAndArticleID(userID, articleID int) error {
@@ -60,3 +241,26 @@ func (repo *bookmarkRepository) DeleteBookmarkByArticleID(articleID int) error {
 	err := repo.db.Delete(&domain.Bookmark{}, "article_id=?", articleID).Error
 	return err
 }
+
+func (repo *bookmarkRepository) PurgeDeletedBookmarks(before time.Time) error {
+	err := repo.db.Unscoped().Where("deleted_at < ?", before).Delete(&domain.Bookmark{}).Error
+	return err
+}
+
+func whereReadState(db *gorm.DB, readState string) *gorm.DB {
+	switch readState {
+	case domain.BookmarkReadStateRead:
+		return db.Where("bookmarks.read_at IS NOT NULL")
+	case domain.BookmarkReadStateUnread:
+		return db.Where("bookmarks.read_at IS NULL")
+	}
+	return db
+}
+
+func bookmarkCursor(bookmark domain.Bookmark) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: bookmark.CreatedAt, ID: bookmark.ID}
+}
+
+func bookmarkPositionCursor(bookmark domain.Bookmark) pagination.Cursor {
+	return pagination.Cursor{Position: bookmark.Position, ID: bookmark.ID}
+}
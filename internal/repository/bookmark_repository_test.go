@@ -1,13 +1,16 @@
 package repository
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 )
 
 func testBookmark() *domain.Bookmark {
@@ -36,7 +39,7 @@ func TestCreateBookmark(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "bookmarks" ("user_id","article_id","created_at","updated_at") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		`INSERT INTO "bookmarks" ("user_id","article_id","collection_id","position","note","highlights","tags","read_at","progress","remind_at","reminded_at","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT ("user_id","article_id") WHERE deleted_at IS NULL DO NOTHING RETURNING "id"`)).
 		WillReturnRows(rows)
 	mock.ExpectCommit()
 
@@ -51,6 +54,56 @@ func TestCreateBookmark(t *testing.T) {
 	}
 }
 
+func TestCreateBookmarkDuplicate(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`ON CONFLICT ("user_id","article_id") WHERE deleted_at IS NULL DO NOTHING RETURNING "id"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	repo := NewBookmarkRepository(db)
+	err = repo.CreateBookmark(testBookmark())
+	if !errors.Is(err, domain.ErrAlreadyExists) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Create Duplicate Bookmark: %v", err)
+	}
+}
+
+func TestGetBookmarkByUserIDAndArticleID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id"}).AddRow(3, 1, 2)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmarks" WHERE (user_id=$1 AND article_id=$2) AND "bookmarks"."deleted_at" IS NULL ORDER BY "bookmarks"."id" LIMIT $3`)).
+		WithArgs(1, 2, 1).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	bookmark, err := repo.GetBookmarkByUserIDAndArticleID(1, 2)
+	if err != nil {
+		t.Fatalf("failed to get bookmark: %s", err)
+	}
+	if bookmark.ID != 3 {
+		t.Errorf("unexpected bookmark: %v", bookmark)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Bookmark By User ID And Article ID: %v", err)
+	}
+}
+
 func TestGetBookmarkCountByArticleID(t *testing.T) {
 	db, mock, err := mock.NewDBMock()
 	if err != nil {
@@ -89,21 +142,114 @@ func TestListBookmarksByUserID(t *testing.T) {
 		AddRow(2, testBookmark2.UserID, testBookmark2.ArticleID, time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "bookmarks" WHERE user_id=$1`)).
-		WithArgs(1).
+		`SELECT * FROM "bookmarks" WHERE user_id=$1 AND "bookmarks"."deleted_at" IS NULL ORDER BY bookmarks.created_at desc, bookmarks.id desc LIMIT $2`)).
+		WithArgs(1, 2).
 		WillReturnRows(rows)
 
 	repo := NewBookmarkRepository(db)
-	_, err = repo.ListBookmarksByUserID(1)
+	bookmarks, nextPageToken, err := repo.ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, PageSize: 1})
 	if err != nil {
 		t.Fatalf("failed to list Bookmark: %s", err)
 	}
+	if len(*bookmarks) != 1 || nextPageToken == "" {
+		t.Errorf("unexpected page: %v, %q", *bookmarks, nextPageToken)
+	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("Test Find Bookmark: %v", err)
 	}
 }
 
+func TestListBookmarksByUserIDAndCollectionID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "collection_id", "position", "created_at", "updated_at"}).
+		AddRow(4, 1, 1, 2, 1, time.Now(), time.Now()).
+		AddRow(3, 1, 2, 2, 2, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmarks" WHERE user_id=$1 AND collection_id=$2 AND "bookmarks"."deleted_at" IS NULL ORDER BY bookmarks.position asc, bookmarks.id asc LIMIT $3`)).
+		WithArgs(1, 2, 2).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	bookmarks, nextPageToken, err := repo.ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, CollectionID: 2, PageSize: 1})
+	if err != nil {
+		t.Fatalf("failed to list Bookmark: %s", err)
+	}
+	if len(*bookmarks) != 1 || (*bookmarks)[0].ID != 4 {
+		t.Errorf("unexpected page: %v", *bookmarks)
+	}
+	cursor, err := pagination.DecodeToken(nextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.ID != 4 || cursor.Position != 1 {
+		t.Errorf("unexpected next page token: %v", cursor)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Find Bookmark By Collection: %v", err)
+	}
+}
+
+func TestListBookmarksByWorkspaceID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "collection_id", "created_at", "updated_at"}).
+		AddRow(2, 1, 1, 3, time.Now(), time.Now()).
+		AddRow(1, 2, 2, 3, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmarks" WHERE collection_id IN (SELECT id FROM collections WHERE workspace_id=$1) AND "bookmarks"."deleted_at" IS NULL ORDER BY bookmarks.created_at desc, bookmarks.id desc LIMIT $2`)).
+		WithArgs(1, 11).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	bookmarks, _, err := repo.ListBookmarksByWorkspaceID(domain.BookmarkListQuery{WorkspaceID: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("failed to list Bookmark: %s", err)
+	}
+	if len(*bookmarks) != 2 {
+		t.Errorf("unexpected page: %v", *bookmarks)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Bookmarks By Workspace ID: %v", err)
+	}
+}
+
+func TestListUnreadBookmarksByUserID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "created_at", "updated_at"}).
+		AddRow(1, 1, 1, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmarks" WHERE user_id=$1 AND $2 = ANY(tags) AND bookmarks.read_at IS NULL AND "bookmarks"."deleted_at" IS NULL ORDER BY bookmarks.created_at desc, bookmarks.id desc LIMIT $3`)).
+		WithArgs(1, "go", 21).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	_, _, err = repo.ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, Tag: "go", ReadState: domain.BookmarkReadStateUnread, PageSize: 20})
+	if err != nil {
+		t.Fatalf("failed to list Bookmark: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Find Unread Bookmark: %v", err)
+	}
+}
+
 func TestListBookmarksByArticleID(t *testing.T) {
 	testBookmark1 := testBookmark()
 	testBookmark2 := testBookmark2()
@@ -118,12 +264,12 @@ func TestListBookmarksByArticleID(t *testing.T) {
 		AddRow(2, testBookmark2.UserID, testBookmark2.ArticleID, time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "bookmarks" WHERE article_id=$1`)).
-		WithArgs(1).
+		`SELECT * FROM "bookmarks" WHERE article_id=$1 AND "bookmarks"."deleted_at" IS NULL ORDER BY bookmarks.created_at desc, bookmarks.id desc LIMIT $2`)).
+		WithArgs(1, 11).
 		WillReturnRows(rows)
 
 	repo := NewBookmarkRepository(db)
-	_, err = repo.ListBookmarksByArticleID(1)
+	_, _, err = repo.ListBookmarksByArticleID(1, 10, "")
 	if err != nil {
 		t.Fatalf("failed to list Bookmark: %s", err)
 	}
@@ -141,8 +287,8 @@ func TestDeleteBookmarkByUserIDAndArticleID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "bookmarks" WHERE user_id=$1 AND article_id=$2`)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		`UPDATE "bookmarks" SET "deleted_at"=$1 WHERE (user_id=$2 AND article_id=$3) AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -165,8 +311,8 @@ func TestDeleteBookmarkByUserID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "bookmarks" WHERE user_id=$1`)).
-		WithArgs(sqlmock.AnyArg()).
+		`UPDATE "bookmarks" SET "deleted_at"=$1 WHERE user_id=$2 AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -189,8 +335,8 @@ func TestDeleteBookmarkByArticleID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "bookmarks" WHERE article_id=$1`)).
-		WithArgs(sqlmock.AnyArg()).
+		`UPDATE "bookmarks" SET "deleted_at"=$1 WHERE article_id=$2 AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -204,3 +350,302 @@ func TestDeleteBookmarkByArticleID(t *testing.T) {
 		t.Errorf("Test Find Bookmark: %v", err)
 	}
 }
+
+func TestGetBookmark(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "note"}).
+		AddRow(1, 1, 1, "note")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "bookmarks" WHERE "bookmarks"."id" = $1 AND "bookmarks"."deleted_at" IS NULL ORDER BY "bookmarks"."id" LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	bookmark, err := repo.GetBookmark(1)
+	if err != nil {
+		t.Fatalf("failed to get bookmark: %s", err)
+	}
+	if bookmark.Note != "note" {
+		t.Errorf("unexpected bookmark: %v", bookmark)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Bookmark: %v", err)
+	}
+}
+
+func TestUpdateBookmark(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "note", "tags"}).
+		AddRow(1, 1, 1, "note", "{go}")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`UPDATE "bookmarks" SET "note"=$1,"tags"=$2,"updated_at"=$3 WHERE "bookmarks"."deleted_at" IS NULL AND "id" = $4 RETURNING *`)).
+		WithArgs("note", pq.StringArray{"go"}, sqlmock.AnyArg(), 1).
+		WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	repo := NewBookmarkRepository(db)
+	bookmark := &domain.Bookmark{ID: 1, Note: "note", Tags: []string{"go"}}
+	err = repo.UpdateBookmark(bookmark, []string{"note", "tags"})
+	if err != nil {
+		t.Fatalf("failed to update bookmark: %s", err)
+	}
+	if bookmark.UserID != 1 || bookmark.ArticleID != 1 {
+		t.Errorf("unexpected bookmark: %v", bookmark)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Bookmark: %v", err)
+	}
+}
+
+func TestSearchBookmarks(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "note", "rank", "snippet"}).
+		AddRow(3, 1, 1, "golang generics", 0.5, "<mark>golang</mark> generics").
+		AddRow(2, 1, 2, "golang modules", 0.2, "<mark>golang</mark> modules")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`FROM bookmarks, (SELECT websearch_to_tsquery('simple', $1) AS query) q WHERE bookmarks.user_id = $2 AND bookmarks.search_vector @@ q.query AND bookmarks.deleted_at IS NULL AND $3 = ANY(bookmarks.tags) ORDER BY rank DESC, bookmarks.id DESC LIMIT $4`)).
+		WithArgs("golang", 1, "go", 2).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	results, nextPageToken, err := repo.SearchBookmarks(domain.BookmarkSearchQuery{UserID: 1, Query: "golang", Tag: "go", PageSize: 1})
+	if err != nil {
+		t.Fatalf("failed to search bookmarks: %s", err)
+	}
+	if len(*results) != 1 || (*results)[0].ID != 3 || (*results)[0].Snippet != "<mark>golang</mark> generics" {
+		t.Errorf("unexpected results: %v", *results)
+	}
+	cursor, err := pagination.DecodeToken(nextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.ID != 3 || cursor.Rank != 0.5 {
+		t.Errorf("unexpected next page token: %v", cursor)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`AND (ts_rank_cd(bookmarks.search_vector, q.query), bookmarks.id) < ($3::real, $4) ORDER BY rank DESC, bookmarks.id DESC LIMIT $5`)).
+		WithArgs("golang", 1, float32(0.5), 3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "rank"}))
+
+	_, _, err = repo.SearchBookmarks(domain.BookmarkSearchQuery{UserID: 1, Query: "golang", PageSize: 1, PageToken: nextPageToken})
+	if err != nil {
+		t.Fatalf("failed to search bookmarks: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Search Bookmarks: %v", err)
+	}
+}
+
+func TestSetBookmarksRead(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE bookmarks SET read_at = coalesce(read_at, now()), updated_at = now() WHERE user_id = $1 AND article_id = ANY($2::bigint[]) AND deleted_at IS NULL`)).
+		WithArgs(1, pq.Int64Array{2, 3}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE bookmarks SET read_at = NULL, updated_at = now() WHERE user_id = $1 AND article_id = ANY($2::bigint[]) AND deleted_at IS NULL`)).
+		WithArgs(1, pq.Int64Array{2}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewBookmarkRepository(db)
+	count, err := repo.SetBookmarksRead(1, []int{2, 3}, true)
+	if err != nil {
+		t.Fatalf("failed to mark bookmarks read: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count: %d", count)
+	}
+	count, err = repo.SetBookmarksRead(1, []int{2}, false)
+	if err != nil {
+		t.Fatalf("failed to mark bookmarks unread: %s", err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected count: %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Set Bookmarks Read: %v", err)
+	}
+}
+
+func TestGetUnreadBookmarkCount(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT count(*) FROM "bookmarks" WHERE (user_id=$1 AND read_at IS NULL) AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	repo := NewBookmarkRepository(db)
+	count, err := repo.GetUnreadBookmarkCount(1)
+	if err != nil {
+		t.Fatalf("failed to get unread bookmark count: %s", err)
+	}
+	if count != 4 {
+		t.Errorf("unexpected count: %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Unread Bookmark Count: %v", err)
+	}
+}
+
+func TestListBookmarkExportItems(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "note", "tags", "title", "url", "collection_name"}).
+		AddRow(1, 1, 2, "must read", "{go,web}", "First Post", "https://example.com/1", "Tech").
+		AddRow(2, 1, 3, "", "{}", "Second Post", "https://example.com/2", "")
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT bookmarks.*, articles.title, articles.url, coalesce(collections.name, '') AS collection_name FROM bookmarks JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL LEFT JOIN collections ON collections.id = bookmarks.collection_id WHERE bookmarks.user_id = $1 AND bookmarks.deleted_at IS NULL ORDER BY collections.position NULLS LAST, collections.id, bookmarks.position, bookmarks.id`)).
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	repo := NewBookmarkRepository(db)
+	items, err := repo.ListBookmarkExportItems(1)
+	if err != nil {
+		t.Fatalf("failed to list bookmark export items: %s", err)
+	}
+	if len(*items) != 2 || (*items)[0].CollectionName != "Tech" || (*items)[0].Url != "https://example.com/1" || len((*items)[0].Tags) != 2 {
+		t.Errorf("unexpected items: %v", items)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Bookmark Export Items: %v", err)
+	}
+}
+
+func TestListBookmarkDigestItems(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT bookmarks.*, articles.title, articles.url FROM bookmarks JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL WHERE bookmarks.user_id = $1 AND bookmarks.read_at IS NULL AND bookmarks.deleted_at IS NULL ORDER BY bookmarks.created_at DESC, bookmarks.id DESC LIMIT $2`)).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "article_id", "title", "url"}).
+			AddRow(2, 1, 2, "second", "https://example.com/2").
+			AddRow(1, 1, 1, "first", "https://example.com/1"))
+
+	repo := NewBookmarkRepository(db)
+	items, err := repo.ListBookmarkDigestItems(1, 10)
+	if err != nil {
+		t.Fatalf("failed to list bookmark digest items: %s", err)
+	}
+	if len(*items) != 2 || (*items)[0].Title != "second" || (*items)[1].Url != "https://example.com/1" {
+		t.Errorf("unexpected items: %v", *items)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Bookmark Digest Items: %v", err)
+	}
+}
+
+func TestListDueBookmarkReminders(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT bookmarks.*, articles.title, articles.url, users.username, users.email FROM bookmarks JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL JOIN users ON users.id = bookmarks.user_id AND users.deleted_at IS NULL WHERE bookmarks.remind_at <= $1 AND bookmarks.reminded_at IS NULL AND bookmarks.deleted_at IS NULL AND (bookmarks.reminder_retry_at IS NULL OR bookmarks.reminder_retry_at <= $2) ORDER BY bookmarks.remind_at, bookmarks.id LIMIT $3`)).
+		WithArgs(now, now, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "article_id", "remind_at", "title", "url", "username", "email"}).
+			AddRow(1, 1, 1, now.Add(-time.Minute), "title", "https://example.com", "test_username", "test@example.com"))
+
+	repo := NewBookmarkRepository(db)
+	reminders, err := repo.ListDueBookmarkReminders(now, 100)
+	if err != nil {
+		t.Fatalf("failed to list due bookmark reminders: %s", err)
+	}
+	if len(*reminders) != 1 || (*reminders)[0].Email != "test@example.com" || (*reminders)[0].RemindAt == nil {
+		t.Errorf("unexpected reminders: %v", *reminders)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Due Bookmark Reminders: %v", err)
+	}
+}
+
+func TestMarkBookmarkReminded(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	remindedAt := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "bookmarks" SET "reminded_at"=$1,"reminder_error_count"=$2,"reminder_retry_at"=$3 WHERE id = $4 AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(remindedAt, 0, nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewBookmarkRepository(db)
+	err = repo.MarkBookmarkReminded(1, remindedAt)
+	if err != nil {
+		t.Fatalf("failed to mark bookmark reminded: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Mark Bookmark Reminded: %v", err)
+	}
+}
+
+func TestMarkBookmarkReminderFailed(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	retryAt := time.Now().Add(5 * time.Minute)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "bookmarks" SET "reminder_error_count"=$1,"reminder_retry_at"=$2 WHERE id = $3 AND "bookmarks"."deleted_at" IS NULL`)).
+		WithArgs(1, retryAt, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewBookmarkRepository(db)
+	err = repo.MarkBookmarkReminderFailed(1, 1, retryAt)
+	if err != nil {
+		t.Fatalf("failed to mark bookmark reminder failed: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Mark Bookmark Reminder Failed: %v", err)
+	}
+}
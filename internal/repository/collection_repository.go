@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"github.com/lib/pq"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type ICollectionRepository interface {
+	CreateCollection(collection *domain.Collection) error
+	GetCollection(id int) (*domain.Collection, error)
+	GetCollectionByUserIDAndName(userID int, name string) (*domain.Collection, error)
+	GetCollectionByWorkspaceIDAndName(workspaceID int, name string) (*domain.Collection, error)
+	GetCollectionByShareToken(shareToken string) (*domain.Collection, error)
+	ListCollectionsByUserID(userID, pageSize int, pageToken string) (*[]domain.Collection, string, error)
+	ListCollectionsByWorkspaceID(workspaceID, pageSize int, pageToken string) (*[]domain.Collection, string, error)
+	UpdateCollection(collection *domain.Collection) error
+	UpdateCollectionVisibility(collection *domain.Collection) error
+	DeleteCollection(id int) error
+	ReorderCollections(userID int, collectionIDs []int) error
+	ReorderCollectionBookmarks(collectionID int, bookmarkIDs []int) error
+	MoveBookmarks(userID int, bookmarkIDs []int, collectionID *uint) error
+}
+
+type collectionRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionRepository(db *gorm.DB) ICollectionRepository {
+	return &collectionRepository{db}
+}
+
+func (repo *collectionRepository) CreateCollection(collection *domain.Collection) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&domain.Collection{}).Select("coalesce(max(position), 0) + 1")
+		if collection.WorkspaceID != nil {
+			query = query.Where("workspace_id = ?", *collection.WorkspaceID)
+		} else {
+			query = query.Where("user_id = ? AND workspace_id IS NULL", collection.UserID)
+		}
+		err := query.Scan(&collection.Position).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(collection).Error
+	})
+}
+
+func (repo *collectionRepository) GetCollection(id int) (*domain.Collection, error) {
+	collection := &domain.Collection{}
+	err := repo.db.First(collection, id).Error
+	return collection, err
+}
+
+func (repo *collectionRepository) GetCollectionByUserIDAndName(userID int, name string) (*domain.Collection, error) {
+	collection := &domain.Collection{}
+	err := repo.db.Where("user_id = ? AND name = ? AND workspace_id IS NULL", userID, name).First(collection).Error
+	return collection, err
+}
+
+func (repo *collectionRepository) GetCollectionByWorkspaceIDAndName(workspaceID int, name string) (*domain.Collection, error) {
+	collection := &domain.Collection{}
+	err := repo.db.Where("workspace_id = ? AND name = ?", workspaceID, name).First(collection).Error
+	return collection, err
+}
+
+func (repo *collectionRepository) GetCollectionByShareToken(shareToken string) (*domain.Collection, error) {
+	collection := &domain.Collection{}
+	err := repo.db.Where("share_token = ?", shareToken).First(collection).Error
+	return collection, err
+}
+
+func (repo *collectionRepository) ListCollectionsByUserID(userID, pageSize int, pageToken string) (*[]domain.Collection, string, error) {
+	collections := &[]domain.Collection{}
+	query, err := positionPage(repo.db.Where("user_id = ? AND workspace_id IS NULL", userID), pageToken, pageSize, "collections")
+	if err != nil {
+		return collections, "", err
+	}
+	if err := query.Find(collections).Error; err != nil {
+		return collections, "", err
+	}
+	return collections, trimPage(collections, pageSize, collectionCursor), nil
+}
+
+func (repo *collectionRepository) ListCollectionsByWorkspaceID(workspaceID, pageSize int, pageToken string) (*[]domain.Collection, string, error) {
+	collections := &[]domain.Collection{}
+	query, err := positionPage(repo.db.Where("workspace_id = ?", workspaceID), pageToken, pageSize, "collections")
+	if err != nil {
+		return collections, "", err
+	}
+	if err := query.Find(collections).Error; err != nil {
+		return collections, "", err
+	}
+	return collections, trimPage(collections, pageSize, collectionCursor), nil
+}
+
+func (repo *collectionRepository) UpdateCollection(collection *domain.Collection) error {
+	err := repo.db.Model(collection).Select("name", "description").Updates(collection).Error
+	return err
+}
+
+func (repo *collectionRepository) UpdateCollectionVisibility(collection *domain.Collection) error {
+	err := repo.db.Model(collection).Select("visibility").Updates(collection).Error
+	return err
+}
+
+func (repo *collectionRepository) DeleteCollection(id int) error {
+	err := repo.db.Delete(&domain.Collection{}, id).Error
+	return err
+}
+
+func (repo *collectionRepository) ReorderCollections(userID int, collectionIDs []int) error {
+	err := repo.db.Exec(
+		"UPDATE collections SET position = coalesce(array_position(?::bigint[], id), ? + position), updated_at = now() WHERE user_id = ? AND workspace_id IS NULL",
+		int64Array(collectionIDs), len(collectionIDs), userID,
+	).Error
+	return err
+}
+
+func (repo *collectionRepository) ReorderCollectionBookmarks(collectionID int, bookmarkIDs []int) error {
+	err := repo.db.Exec(
+		"UPDATE bookmarks SET position = coalesce(array_position(?::bigint[], id), ? + position), updated_at = now() WHERE collection_id = ? AND deleted_at IS NULL",
+		int64Array(bookmarkIDs), len(bookmarkIDs), collectionID,
+	).Error
+	return err
+}
+
+func (repo *collectionRepository) MoveBookmarks(userID int, bookmarkIDs []int, collectionID *uint) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(
+			"UPDATE bookmarks SET collection_id = ?, position = (SELECT coalesce(max(position), 0) FROM bookmarks WHERE collection_id IS NOT DISTINCT FROM ? AND deleted_at IS NULL) + array_position(?::bigint[], id), updated_at = now() "+
+				"WHERE user_id = ? AND id = ANY(?::bigint[]) AND deleted_at IS NULL",
+			collectionID, collectionID, int64Array(bookmarkIDs), userID, int64Array(bookmarkIDs),
+		)
+		if result.Error != nil {
+			return result.Error
+		}
+		if int(result.RowsAffected) != len(bookmarkIDs) {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func collectionCursor(collection domain.Collection) pagination.Cursor {
+	return pagination.Cursor{Position: collection.Position, ID: collection.ID}
+}
+
+func int64Array(ids []int) pq.Int64Array {
+	array := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		array = append(array, int64(id))
+	}
+	return array
+}
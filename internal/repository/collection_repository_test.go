@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+func TestCreateCollection(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT coalesce(max(position), 0) + 1 FROM "collections" WHERE user_id = $1 AND workspace_id IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "collections" ("user_id","workspace_id","name","description","position","visibility","share_token","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
+		WithArgs(1, nil, "reading", "", 3, "private", "token", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewCollectionRepository(db)
+	collection := &domain.Collection{UserID: 1, Name: "reading", Visibility: "private", ShareToken: "token"}
+	err = repo.CreateCollection(collection)
+	if err != nil {
+		t.Fatalf("failed to create collection: %s", err)
+	}
+	if collection.ID != 1 || collection.Position != 3 {
+		t.Errorf("unexpected collection: %v", collection)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Create Collection: %v", err)
+	}
+}
+
+func TestGetCollectionByUserIDAndName(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "collections" WHERE user_id = $1 AND name = $2 AND workspace_id IS NULL ORDER BY "collections"."id" LIMIT $3`)).
+		WithArgs(1, "reading", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name"}))
+
+	repo := NewCollectionRepository(db)
+	_, err = repo.GetCollectionByUserIDAndName(1, "reading")
+	if err != gorm.ErrRecordNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Collection By User ID And Name: %v", err)
+	}
+}
+
+func TestGetCollectionByShareToken(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "collections" WHERE share_token = $1 ORDER BY "collections"."id" LIMIT $2`)).
+		WithArgs("token", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "visibility", "share_token"}).AddRow(1, 1, "reading", "public", "token"))
+
+	repo := NewCollectionRepository(db)
+	collection, err := repo.GetCollectionByShareToken("token")
+	if err != nil {
+		t.Fatalf("failed to get collection: %s", err)
+	}
+	if collection.ID != 1 || collection.Visibility != "public" {
+		t.Errorf("unexpected collection: %v", collection)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Get Collection By Share Token: %v", err)
+	}
+}
+
+func TestListCollectionsByUserID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "position", "created_at", "updated_at"}).
+		AddRow(3, 1, "reading", 2, time.Now(), time.Now()).
+		AddRow(2, 1, "later", 3, time.Now(), time.Now())
+
+	pageToken := pagination.EncodeToken(pagination.Cursor{ID: 1, Position: 1})
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "collections" WHERE (user_id = $1 AND workspace_id IS NULL) AND (collections.position, collections.id) > ($2, $3) ORDER BY collections.position asc, collections.id asc LIMIT $4`)).
+		WithArgs(1, 1, 1, 2).
+		WillReturnRows(rows)
+
+	repo := NewCollectionRepository(db)
+	collections, nextPageToken, err := repo.ListCollectionsByUserID(1, 1, pageToken)
+	if err != nil {
+		t.Fatalf("failed to list collections: %s", err)
+	}
+	if len(*collections) != 1 || (*collections)[0].ID != 3 {
+		t.Errorf("unexpected collections: %v", *collections)
+	}
+	cursor, err := pagination.DecodeToken(nextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.ID != 3 || cursor.Position != 2 {
+		t.Errorf("unexpected next page token: %v", cursor)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Collections By User ID: %v", err)
+	}
+}
+
+func TestListCollectionsByWorkspaceID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "workspace_id", "name", "position", "created_at", "updated_at"}).
+		AddRow(1, 1, 2, "team reading", 1, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "collections" WHERE workspace_id = $1 ORDER BY collections.position asc, collections.id asc LIMIT $2`)).
+		WithArgs(2, 11).
+		WillReturnRows(rows)
+
+	repo := NewCollectionRepository(db)
+	collections, nextPageToken, err := repo.ListCollectionsByWorkspaceID(2, 10, "")
+	if err != nil {
+		t.Fatalf("failed to list collections: %s", err)
+	}
+	if len(*collections) != 1 || *(*collections)[0].WorkspaceID != 2 || nextPageToken != "" {
+		t.Errorf("unexpected collections: %v, %q", *collections, nextPageToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Collections By Workspace ID: %v", err)
+	}
+}
+
+func TestUpdateCollection(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "collections" SET "name"=$1,"description"=$2,"updated_at"=$3 WHERE "id" = $4`)).
+		WithArgs("renamed", "description", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewCollectionRepository(db)
+	err = repo.UpdateCollection(&domain.Collection{ID: 1, UserID: 1, Name: "renamed", Description: "description"})
+	if err != nil {
+		t.Fatalf("failed to update collection: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Collection: %v", err)
+	}
+}
+
+func TestUpdateCollectionVisibility(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "collections" SET "visibility"=$1,"updated_at"=$2 WHERE "id" = $3`)).
+		WithArgs("public", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewCollectionRepository(db)
+	err = repo.UpdateCollectionVisibility(&domain.Collection{ID: 1, UserID: 1, Name: "reading", Visibility: "public"})
+	if err != nil {
+		t.Fatalf("failed to update collection visibility: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Collection Visibility: %v", err)
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "collections" WHERE "collections"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewCollectionRepository(db)
+	err = repo.DeleteCollection(1)
+	if err != nil {
+		t.Fatalf("failed to delete collection: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Delete Collection: %v", err)
+	}
+}
+
+func TestReorderCollections(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE collections SET position = coalesce(array_position($1::bigint[], id), $2 + position), updated_at = now() WHERE user_id = $3 AND workspace_id IS NULL`)).
+		WithArgs(pq.Int64Array{3, 1, 2}, 3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := NewCollectionRepository(db)
+	err = repo.ReorderCollections(1, []int{3, 1, 2})
+	if err != nil {
+		t.Fatalf("failed to reorder collections: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Reorder Collections: %v", err)
+	}
+}
+
+func TestReorderCollectionBookmarks(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE bookmarks SET position = coalesce(array_position($1::bigint[], id), $2 + position), updated_at = now() WHERE collection_id = $3 AND deleted_at IS NULL`)).
+		WithArgs(pq.Int64Array{5, 4}, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewCollectionRepository(db)
+	err = repo.ReorderCollectionBookmarks(1, []int{5, 4})
+	if err != nil {
+		t.Fatalf("failed to reorder collection bookmarks: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Reorder Collection Bookmarks: %v", err)
+	}
+}
+
+func TestMoveBookmarks(t *testing.T) {
+	collectionID := uint(2)
+	sql := `UPDATE bookmarks SET collection_id = $1, position = (SELECT coalesce(max(position), 0) FROM bookmarks WHERE collection_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL) + array_position($3::bigint[], id), updated_at = now() WHERE user_id = $4 AND id = ANY($5::bigint[]) AND deleted_at IS NULL`
+
+	testCases := []struct {
+		name         string
+		rowsAffected int64
+		checkError   func(t *testing.T, err error)
+	}{
+		{
+			name:         "OK",
+			rowsAffected: 2,
+			checkError: func(t *testing.T, err error) {
+				if err != nil {
+					t.Fatalf("failed to move bookmarks: %s", err)
+				}
+			},
+		},
+		{
+			name:         "NotFound",
+			rowsAffected: 1,
+			checkError: func(t *testing.T, err error) {
+				if err != gorm.ErrRecordNotFound {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := mock.NewDBMock()
+			if err != nil {
+				t.Errorf("Failed to initialize mock DB: %v", err)
+			}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(sql)).
+				WithArgs(&collectionID, &collectionID, pq.Int64Array{4, 5}, 1, pq.Int64Array{4, 5}).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			if tc.rowsAffected == 2 {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			repo := NewCollectionRepository(db)
+			err = repo.MoveBookmarks(1, []int{4, 5}, &collectionID)
+			tc.checkError(t, err)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Test Move Bookmarks: %v", err)
+			}
+		})
+	}
+}
@@ -1,20 +1,34 @@
 package repository
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"gorm.io/gorm"
 )
 
 type ICommentRepository interface {
 	CreateComment(comment *domain.Comment) error
-	ListCommentsByUserID(userID int) (*[]domain.Comment, error)
-	ListCommentsByArticleID(articleID int) (*[]domain.Comment, error)
+	GetComment(id int) (*domain.Comment, error)
+	ListCommentsByUserID(userID, pageSize int, pageToken string) (*[]domain.Comment, string, error)
+	ListCommentsByArticleID(articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error)
+	ListCommentsByWorkspaceIDAndArticleID(workspaceID, articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error)
 	DeleteComment(id int) error
 	DeleteCommentByUserIDAndArticleID(userID, articleID int) error
 	DeleteCommentByUserID(UserID int) error
 	DeleteCommentByArticleID(ArticleID int) error
+	RestoreComment(id int) error
+	PurgeDeletedComments(before time.Time) error
 }
 
+// A deleted comment stays in its thread while any comment below it is alive, so
+// those replies remain reachable and are counted in reply_count.
+const visibleComment = "(%[1]s.deleted_at IS NULL OR EXISTS (WITH RECURSIVE thread AS (SELECT descendants.id, descendants.deleted_at FROM comments AS descendants WHERE descendants.parent_comment_id = %[1]s.id UNION ALL SELECT descendants.id, descendants.deleted_at FROM comments AS descendants JOIN thread ON descendants.parent_comment_id = thread.id) SELECT 1 FROM thread WHERE thread.deleted_at IS NULL))"
+
+var commentColumns = "comments.*, (SELECT count(*) FROM comments AS replies WHERE replies.parent_comment_id = comments.id AND " + fmt.Sprintf(visibleComment, "replies") + ") AS reply_count"
+
 type commentRepository struct {
 	db *gorm.DB
 }
@@ -28,16 +42,48 @@ func (repo *commentRepository) CreateComment(comment *domain.Comment) error {
 	return err
 }
 
-func (repo *commentRepository) ListCommentsByUserID(userID int) (*[]domain.Comment, error) {
+func (repo *commentRepository) GetComment(id int) (*domain.Comment, error) {
+	comment := &domain.Comment{}
+	err := repo.db.Select(commentColumns).First(comment, id).Error
+	return comment, err
+}
+
+func (repo *commentRepository) ListCommentsByUserID(userID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
 	comments := &[]domain.Comment{}
-	err := repo.db.Where("user_id=?", userID).Find(comments).Error
-	return comments, err
+	query, err := keysetPage(repo.db.Select(commentColumns).Where("user_id=? AND workspace_id IS NULL", userID), pageToken, pageSize, "comments", true)
+	if err != nil {
+		return comments, "", err
+	}
+	if err := query.Find(comments).Error; err != nil {
+		return comments, "", err
+	}
+	return comments, trimPage(comments, pageSize, commentCursor), nil
 }
 
-func (repo *commentRepository) ListCommentsByArticleID(articleID int) (*[]domain.Comment, error) {
+func (repo *commentRepository) ListCommentsByArticleID(articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
 	comments := &[]domain.Comment{}
-	err := repo.db.Where("article_id=?", articleID).Find(comments).Error
-	return comments, err
+	query := whereParentComment(repo.threadComments().Where("article_id=? AND workspace_id IS NULL", articleID), parentCommentID)
+	query, err := keysetPage(query, pageToken, pageSize, "comments", false)
+	if err != nil {
+		return comments, "", err
+	}
+	if err := query.Find(comments).Error; err != nil {
+		return comments, "", err
+	}
+	return comments, trimPage(comments, pageSize, commentCursor), nil
+}
+
+func (repo *commentRepository) ListCommentsByWorkspaceIDAndArticleID(workspaceID, articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
+	comments := &[]domain.Comment{}
+	query := whereParentComment(repo.threadComments().Where("workspace_id=? AND article_id=?", workspaceID, articleID), parentCommentID)
+	query, err := keysetPage(query, pageToken, pageSize, "comments", false)
+	if err != nil {
+		return comments, "", err
+	}
+	if err := query.Find(comments).Error; err != nil {
+		return comments, "", err
+	}
+	return comments, trimPage(comments, pageSize, commentCursor), nil
 }
 
 func (repo *commentRepository) DeleteComment(id int) error {
@@ -59,3 +105,43 @@ func (repo *commentRepository) DeleteCommentByArticleID(articleID int) error {
 	err := repo.db.Delete(&domain.Comment{}, "article_id=?", articleID).Error
 	return err
 }
+
+func (repo *commentRepository) RestoreComment(id int) error {
+	return restore(repo.db, &domain.Comment{}, id)
+}
+
+func (repo *commentRepository) PurgeDeletedComments(before time.Time) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("deleted_at < ? AND NOT "+fmt.Sprintf(visibleComment, "comments"), before).Delete(&domain.Comment{}).Error; err != nil {
+			return err
+		}
+		return resetOrphanedCommentDepth(tx)
+	})
+}
+
+// Replies whose parent was purged are detached by ON DELETE SET NULL and become
+// top-level comments, so their subtrees are renumbered from depth 0.
+func resetOrphanedCommentDepth(tx *gorm.DB) error {
+	err := tx.Exec(`WITH RECURSIVE tree AS (
+SELECT id, 0 AS depth FROM comments WHERE parent_comment_id IS NULL AND depth > 0
+UNION ALL
+SELECT comments.id, tree.depth + 1 FROM comments JOIN tree ON comments.parent_comment_id = tree.id
+)
+UPDATE comments SET depth = tree.depth FROM tree WHERE comments.id = tree.id`).Error
+	return err
+}
+
+func (repo *commentRepository) threadComments() *gorm.DB {
+	return repo.db.Unscoped().Select(commentColumns).Where(fmt.Sprintf(visibleComment, "comments"))
+}
+
+func whereParentComment(query *gorm.DB, parentCommentID int) *gorm.DB {
+	if parentCommentID == 0 {
+		return query.Where("parent_comment_id IS NULL")
+	}
+	return query.Where("parent_comment_id=?", parentCommentID)
+}
+
+func commentCursor(comment domain.Comment) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
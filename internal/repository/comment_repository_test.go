@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 	"time"
@@ -38,7 +39,7 @@ func TestCreateComment(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "comments" ("user_id","article_id","content","created_at","updated_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		`INSERT INTO "comments" ("user_id","article_id","workspace_id","parent_comment_id","depth","content","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
 		WillReturnRows(rows)
 	mock.ExpectCommit()
 
@@ -67,12 +68,12 @@ func TestListCommentsByUserID(t *testing.T) {
 		AddRow(2, testComment2.UserID, testComment2.ArticleID, testComment2.Content, time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "comments" WHERE user_id=$1`)).
-		WithArgs(1).
+		`SELECT `+commentColumns+` FROM "comments" WHERE (user_id=$1 AND workspace_id IS NULL) AND "comments"."deleted_at" IS NULL ORDER BY comments.created_at desc, comments.id desc LIMIT $2`)).
+		WithArgs(1, 11).
 		WillReturnRows(rows)
 
 	repo := NewCommentRepository(db)
-	_, err = repo.ListCommentsByUserID(1)
+	_, _, err = repo.ListCommentsByUserID(1, 10, "")
 	if err != nil {
 		t.Fatalf("failed to list Comment: %s", err)
 	}
@@ -91,26 +92,85 @@ func TestListCommentsByArticleID(t *testing.T) {
 		t.Errorf("Failed to initialize mock DB: %v", err)
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "content", "created_at", "updated_at"}).
-		AddRow(1, testComment1.UserID, testComment1.ArticleID, testComment1.Content, time.Now(), time.Now()).
-		AddRow(2, testComment2.UserID, testComment2.ArticleID, testComment2.Content, time.Now(), time.Now())
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "content", "reply_count", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, testComment1.UserID, testComment1.ArticleID, testComment1.Content, 1, time.Now(), time.Now(), time.Now()).
+		AddRow(2, testComment2.UserID, testComment2.ArticleID, testComment2.Content, 0, time.Now(), time.Now(), nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "comments" WHERE article_id=$1`)).
-		WithArgs(1).
+		`SELECT `+commentColumns+` FROM "comments" WHERE (`+fmt.Sprintf(visibleComment, "comments")+`) AND (article_id=$1 AND workspace_id IS NULL) AND parent_comment_id IS NULL ORDER BY comments.created_at asc, comments.id asc LIMIT $2`)).
+		WithArgs(1, 11).
 		WillReturnRows(rows)
 
 	repo := NewCommentRepository(db)
-	_, err = repo.ListCommentsByArticleID(1)
+	comments, _, err := repo.ListCommentsByArticleID(1, 0, 10, "")
 	if err != nil {
 		t.Fatalf("failed to list Comment: %s", err)
 	}
+	if len(*comments) != 2 || !(*comments)[0].DeletedAt.Valid || (*comments)[0].ReplyCount != 1 {
+		t.Errorf("expected the deleted comment to stay in its thread: %v", comments)
+	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("Test Find Comment: %v", err)
 	}
 }
 
+func TestListCommentReplies(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "parent_comment_id", "depth", "content", "reply_count", "created_at", "updated_at"}).
+		AddRow(2, 2, 1, 1, 1, "reply", 3, time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT `+commentColumns+` FROM "comments" WHERE (`+fmt.Sprintf(visibleComment, "comments")+`) AND (article_id=$1 AND workspace_id IS NULL) AND parent_comment_id=$2 ORDER BY comments.created_at asc, comments.id asc LIMIT $3`)).
+		WithArgs(1, 1, 11).
+		WillReturnRows(rows)
+
+	repo := NewCommentRepository(db)
+	comments, _, err := repo.ListCommentsByArticleID(1, 1, 10, "")
+	if err != nil {
+		t.Fatalf("failed to list Comment: %s", err)
+	}
+	if len(*comments) != 1 || *(*comments)[0].ParentCommentID != 1 || (*comments)[0].Depth != 1 || (*comments)[0].ReplyCount != 3 {
+		t.Errorf("unexpected comments: %v", *comments)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Comment Replies: %v", err)
+	}
+}
+
+func TestListCommentsByWorkspaceIDAndArticleID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "article_id", "workspace_id", "content", "created_at", "updated_at"}).
+		AddRow(1, 1, 1, 2, "content", time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT `+commentColumns+` FROM "comments" WHERE (`+fmt.Sprintf(visibleComment, "comments")+`) AND (workspace_id=$1 AND article_id=$2) AND parent_comment_id=$3 ORDER BY comments.created_at asc, comments.id asc LIMIT $4`)).
+		WithArgs(2, 1, 3, 11).
+		WillReturnRows(rows)
+
+	repo := NewCommentRepository(db)
+	comments, _, err := repo.ListCommentsByWorkspaceIDAndArticleID(2, 1, 3, 10, "")
+	if err != nil {
+		t.Fatalf("failed to list Comment: %s", err)
+	}
+	if len(*comments) != 1 || *(*comments)[0].WorkspaceID != 2 {
+		t.Errorf("unexpected comments: %v", *comments)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Comments By Workspace ID And Article ID: %v", err)
+	}
+}
+
 func TestDeleteComment(t *testing.T) {
 	db, mock, err := mock.NewDBMock()
 	if err != nil {
@@ -119,8 +179,8 @@ func TestDeleteComment(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "comments" WHERE "comments"."id" = $1`)).
-		WithArgs(1).
+		`UPDATE "comments" SET "deleted_at"=$1 WHERE "comments"."id" = $2 AND "comments"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -143,8 +203,8 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "comments" WHERE user_id=$1 AND article_id=$2`)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		`UPDATE "comments" SET "deleted_at"=$1 WHERE (user_id=$2 AND article_id=$3) AND "comments"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -167,8 +227,8 @@ func TestDeleteCommentByUserID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "comments" WHERE user_id=$1`)).
-		WithArgs(sqlmock.AnyArg()).
+		`UPDATE "comments" SET "deleted_at"=$1 WHERE user_id=$2 AND "comments"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -191,8 +251,8 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "comments" WHERE article_id=$1`)).
-		WithArgs(sqlmock.AnyArg()).
+		`UPDATE "comments" SET "deleted_at"=$1 WHERE article_id=$2 AND "comments"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -206,3 +266,30 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 		t.Errorf("Test Find Comment: %v", err)
 	}
 }
+
+func TestPurgeDeletedComments(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	before := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "comments" WHERE deleted_at < $1 AND NOT ` + fmt.Sprintf(visibleComment, "comments"))).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE comments SET depth = tree.depth FROM tree WHERE comments.id = tree.id`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewCommentRepository(db)
+	if err := repo.PurgeDeletedComments(before); err != nil {
+		t.Fatalf("failed to purge deleted comments: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Purge Deleted Comments: %v", err)
+	}
+}
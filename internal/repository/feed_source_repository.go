@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type IFeedSourceRepository interface {
+	CreateFeedSource(feedSource *domain.FeedSource) error
+	GetFeedSource(id int) (*domain.FeedSource, error)
+	GetFeedSourceByUrl(url string) (*domain.FeedSource, error)
+	ListFeedSources(pageSize int, pageToken string) (*[]domain.FeedSource, string, error)
+	ListAllFeedSources() (*[]domain.FeedSource, error)
+	ListDueFeedSources(now time.Time, limit int) (*[]domain.FeedSource, error)
+	UpdateFeedSourceFetchState(feedSource *domain.FeedSource) error
+	DeleteFeedSource(id int) error
+}
+
+type feedSourceRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedSourceRepository(db *gorm.DB) IFeedSourceRepository {
+	return &feedSourceRepository{db}
+}
+
+func (repo *feedSourceRepository) CreateFeedSource(feedSource *domain.FeedSource) error {
+	err := repo.db.Create(feedSource).Error
+	return err
+}
+
+func (repo *feedSourceRepository) GetFeedSource(id int) (*domain.FeedSource, error) {
+	feedSource := &domain.FeedSource{}
+	err := repo.db.First(feedSource, id).Error
+	return feedSource, err
+}
+
+func (repo *feedSourceRepository) GetFeedSourceByUrl(url string) (*domain.FeedSource, error) {
+	feedSource := &domain.FeedSource{}
+	err := repo.db.Where("url = ?", url).First(feedSource).Error
+	return feedSource, err
+}
+
+func (repo *feedSourceRepository) ListFeedSources(pageSize int, pageToken string) (*[]domain.FeedSource, string, error) {
+	feedSources := &[]domain.FeedSource{}
+	query, err := keysetPage(repo.db, pageToken, pageSize, "feed_sources", true)
+	if err != nil {
+		return feedSources, "", err
+	}
+	if err := query.Find(feedSources).Error; err != nil {
+		return feedSources, "", err
+	}
+	return feedSources, trimPage(feedSources, pageSize, feedSourceCursor), nil
+}
+
+func (repo *feedSourceRepository) ListAllFeedSources() (*[]domain.FeedSource, error) {
+	feedSources := &[]domain.FeedSource{}
+	err := repo.db.Order("id").Find(feedSources).Error
+	return feedSources, err
+}
+
+func (repo *feedSourceRepository) ListDueFeedSources(now time.Time, limit int) (*[]domain.FeedSource, error) {
+	feedSources := &[]domain.FeedSource{}
+	err := repo.db.Where("next_fetch_at <= ?", now).Order("next_fetch_at").Limit(limit).Find(feedSources).Error
+	return feedSources, err
+}
+
+func (repo *feedSourceRepository) UpdateFeedSourceFetchState(feedSource *domain.FeedSource) error {
+	err := repo.db.Model(feedSource).
+		Select("title", "etag", "last_modified", "last_fetched_at", "next_fetch_at", "error_count", "last_error").
+		Updates(feedSource).Error
+	return err
+}
+
+func (repo *feedSourceRepository) DeleteFeedSource(id int) error {
+	err := repo.db.Delete(&domain.FeedSource{}, id).Error
+	return err
+}
+
+func feedSourceCursor(feedSource domain.FeedSource) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: feedSource.CreatedAt, ID: feedSource.ID}
+}
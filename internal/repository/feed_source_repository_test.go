@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+)
+
+func testFeedSource() *domain.FeedSource {
+	return &domain.FeedSource{
+		Url:         "https://example.com/feed",
+		NextFetchAt: time.Now(),
+	}
+}
+
+func TestCreateFeedSource(t *testing.T) {
+	testFeedSource := testFeedSource()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "feed_sources" ("url","title","etag","last_modified","last_fetched_at","next_fetch_at","error_count","last_error","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	repo := NewFeedSourceRepository(db)
+	err = repo.CreateFeedSource(testFeedSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Create Feed Source: %v", err)
+	}
+}
+
+func TestGetFeedSourceByUrl(t *testing.T) {
+	testFeedSource := testFeedSource()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "url", "next_fetch_at", "created_at", "updated_at"}).
+		AddRow(1, testFeedSource.Url, time.Now(), time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "feed_sources" WHERE url = $1 ORDER BY "feed_sources"."id" LIMIT $2`)).
+		WithArgs(testFeedSource.Url, 1).
+		WillReturnRows(rows)
+
+	repo := NewFeedSourceRepository(db)
+	_, err = repo.GetFeedSourceByUrl(testFeedSource.Url)
+	if err != nil {
+		t.Fatalf("failed to get feed source: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Find Feed Source: %v", err)
+	}
+}
+
+func TestListDueFeedSources(t *testing.T) {
+	testFeedSource := testFeedSource()
+	now := time.Now()
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "url", "next_fetch_at", "created_at", "updated_at"}).
+		AddRow(1, testFeedSource.Url, now, now, now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "feed_sources" WHERE next_fetch_at <= $1 ORDER BY next_fetch_at LIMIT $2`)).
+		WithArgs(now, 20).
+		WillReturnRows(rows)
+
+	repo := NewFeedSourceRepository(db)
+	feedSources, err := repo.ListDueFeedSources(now, 20)
+	if err != nil {
+		t.Fatalf("failed to list feed sources: %s", err)
+	}
+	if len(*feedSources) != 1 {
+		t.Errorf("unexpected feed sources: %v", *feedSources)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Find Feed Source: %v", err)
+	}
+}
+
+func TestUpdateFeedSourceFetchState(t *testing.T) {
+	testFeedSource := testFeedSource()
+	testFeedSource.ID = 1
+
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "feed_sources" SET "title"=$1,"etag"=$2,"last_modified"=$3,"last_fetched_at"=$4,"next_fetch_at"=$5,"error_count"=$6,"last_error"=$7,"updated_at"=$8 WHERE "id" = $9`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewFeedSourceRepository(db)
+	err = repo.UpdateFeedSourceFetchState(testFeedSource)
+	if err != nil {
+		t.Fatalf("failed to update feed source: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Feed Source: %v", err)
+	}
+}
+
+func TestDeleteFeedSource(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "feed_sources" WHERE "feed_sources"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewFeedSourceRepository(db)
+	err = repo.DeleteFeedSource(1)
+	if err != nil {
+		t.Fatalf("failed to delete feed source: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Delete Feed Source: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+func keysetPage(query *gorm.DB, pageToken string, pageSize int, table string, desc bool) (*gorm.DB, error) {
+	return keysetPageBy(query, pageToken, pageSize, table, "id", desc)
+}
+
+func keysetPageBy(query *gorm.DB, pageToken string, pageSize int, table, idColumn string, desc bool) (*gorm.DB, error) {
+	cursor, err := pagination.DecodeToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	order, op := "asc", ">"
+	if desc {
+		order, op = "desc", "<"
+	}
+	if cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s.created_at, %s.%s) %s (?, ?)", table, table, idColumn, op), cursor.CreatedAt, cursor.ID)
+	}
+	return query.Order(fmt.Sprintf("%s.created_at %s, %s.%s %s", table, order, table, idColumn, order)).Limit(pageSize + 1), nil
+}
+
+func scorePage(query *gorm.DB, pageToken string, pageSize int, table, column string) (*gorm.DB, error) {
+	cursor, err := pagination.DecodeToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s.%s, %s.id) < (?, ?)", table, column, table), cursor.Score, cursor.ID)
+	}
+	return query.Order(fmt.Sprintf("%s.%s desc, %s.id desc", table, column, table)).Limit(pageSize + 1), nil
+}
+
+func positionPage(query *gorm.DB, pageToken string, pageSize int, table string) (*gorm.DB, error) {
+	cursor, err := pagination.DecodeToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s.position, %s.id) > (?, ?)", table, table), cursor.Position, cursor.ID)
+	}
+	return query.Order(fmt.Sprintf("%s.position asc, %s.id asc", table, table)).Limit(pageSize + 1), nil
+}
+
+func trimPage[T any](items *[]T, pageSize int, cursor func(item T) pagination.Cursor) string {
+	if len(*items) <= pageSize {
+		return ""
+	}
+	*items = (*items)[:pageSize]
+	return pagination.EncodeToken(cursor((*items)[pageSize-1]))
+}
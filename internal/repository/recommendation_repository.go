@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type IRecommendationRepository interface {
+	RefreshRecommendations(limitPerUser int) error
+	ListRecommendedArticles(userID, pageSize int, pageToken string) (*[]domain.RecommendedArticle, string, error)
+}
+
+type recommendationRepository struct {
+	db *gorm.DB
+}
+
+func NewRecommendationRepository(db *gorm.DB) IRecommendationRepository {
+	return &recommendationRepository{db}
+}
+
+func (repo *recommendationRepository) RefreshRecommendations(limitPerUser int) error {
+	sql := `INSERT INTO article_recommendations (user_id, article_id, score) ` +
+		`SELECT user_id, article_id, score FROM (` +
+		`SELECT b.user_id, s.candidate_id AS article_id, sum(s.similarity) AS score, ` +
+		`row_number() OVER (PARTITION BY b.user_id ORDER BY sum(s.similarity) DESC, s.candidate_id DESC) AS position ` +
+		`FROM (SELECT DISTINCT user_id, article_id FROM bookmarks WHERE deleted_at IS NULL) b ` +
+		`JOIN (SELECT p.article_id, p.candidate_id, p.co_count / sqrt(ca.n * cc.n) AS similarity FROM (` +
+		`SELECT b1.article_id, b2.article_id AS candidate_id, count(*) AS co_count ` +
+		`FROM (SELECT DISTINCT user_id, article_id FROM bookmarks WHERE deleted_at IS NULL) b1 ` +
+		`JOIN (SELECT DISTINCT user_id, article_id FROM bookmarks WHERE deleted_at IS NULL) b2 ON b1.user_id = b2.user_id AND b1.article_id <> b2.article_id ` +
+		`GROUP BY b1.article_id, b2.article_id) p ` +
+		`JOIN (SELECT article_id, count(DISTINCT user_id) AS n FROM bookmarks WHERE deleted_at IS NULL GROUP BY article_id) ca ON ca.article_id = p.article_id ` +
+		`JOIN (SELECT article_id, count(DISTINCT user_id) AS n FROM bookmarks WHERE deleted_at IS NULL GROUP BY article_id) cc ON cc.article_id = p.candidate_id) s ON s.article_id = b.article_id ` +
+		`JOIN articles ON articles.id = s.candidate_id AND articles.deleted_at IS NULL ` +
+		`WHERE NOT EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.user_id = b.user_id AND bookmarks.article_id = s.candidate_id AND bookmarks.deleted_at IS NULL) ` +
+		`GROUP BY b.user_id, s.candidate_id) r ` +
+		`WHERE position <= ?`
+
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM article_recommendations").Error; err != nil {
+			return err
+		}
+		return tx.Exec(sql, limitPerUser).Error
+	})
+}
+
+func (repo *recommendationRepository) ListRecommendedArticles(userID, pageSize int, pageToken string) (*[]domain.RecommendedArticle, string, error) {
+	articles := &[]domain.RecommendedArticle{}
+	cursor, err := pagination.DecodeToken(pageToken)
+	if err != nil {
+		return articles, "", err
+	}
+
+	query := repo.db.Model(&domain.Article{}).
+		Select("articles.*, article_recommendations.score").
+		Joins("JOIN article_recommendations ON article_recommendations.article_id = articles.id").
+		Where("article_recommendations.user_id = ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.user_id = article_recommendations.user_id AND bookmarks.article_id = articles.id AND bookmarks.deleted_at IS NULL)")
+	if cursor != nil {
+		query = query.Where("(article_recommendations.score, articles.id) < (?, ?)", cursor.Score, cursor.ID)
+	}
+	err = query.Order("article_recommendations.score desc, articles.id desc").Limit(pageSize + 1).Scan(articles).Error
+	if err != nil {
+		return articles, "", err
+	}
+	return articles, trimPage(articles, pageSize, func(article domain.RecommendedArticle) pagination.Cursor {
+		return pagination.Cursor{ID: article.ID, Score: article.Score}
+	}), nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+)
+
+func TestRefreshRecommendations(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM article_recommendations`)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO article_recommendations (user_id, article_id, score) SELECT user_id, article_id, score FROM (`)).
+		WithArgs(100).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectCommit()
+
+	repo := NewRecommendationRepository(db)
+	err = repo.RefreshRecommendations(100)
+	if err != nil {
+		t.Fatalf("failed to refresh recommendations: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Refresh Recommendations: %v", err)
+	}
+}
+
+func TestListRecommendedArticles(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "url", "created_at", "updated_at", "score"}).
+		AddRow(3, "test_title3", "https://example.com/3", time.Now(), time.Now(), 0.9).
+		AddRow(2, "test_title2", "https://example.com/2", time.Now(), time.Now(), 0.5)
+
+	pageToken := pagination.EncodeToken(pagination.Cursor{ID: 4, Score: 1.2})
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT articles.*, article_recommendations.score FROM "articles" JOIN article_recommendations ON article_recommendations.article_id = articles.id WHERE article_recommendations.user_id = $1 AND (NOT EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.user_id = article_recommendations.user_id AND bookmarks.article_id = articles.id AND bookmarks.deleted_at IS NULL)) AND (article_recommendations.score, articles.id) < ($2, $3) AND "articles"."deleted_at" IS NULL ORDER BY article_recommendations.score desc, articles.id desc LIMIT $4`)).
+		WithArgs(1, 1.2, 4, 2).
+		WillReturnRows(rows)
+
+	repo := NewRecommendationRepository(db)
+	articles, nextPageToken, err := repo.ListRecommendedArticles(1, 1, pageToken)
+	if err != nil {
+		t.Fatalf("failed to list recommended articles: %s", err)
+	}
+	if len(*articles) != 1 || (*articles)[0].ID != 3 || (*articles)[0].Score != 0.9 {
+		t.Errorf("unexpected recommended articles: %v", *articles)
+	}
+	cursor, err := pagination.DecodeToken(nextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.ID != 3 || cursor.Score != 0.9 {
+		t.Errorf("unexpected next page token: %v", cursor)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Recommended Articles: %v", err)
+	}
+}
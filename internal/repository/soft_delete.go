@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+func restore(db *gorm.DB, model interface{}, id int) error {
+	result := db.Unscoped().Model(model).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
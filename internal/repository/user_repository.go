@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -10,9 +13,16 @@ type IUserRepository interface {
 	CreateUser(user *domain.User) error
 	GetUser(id int) (*domain.User, error)
 	GetUserByEmail(email string) (*domain.User, error)
-	ListUsers(offset, limit int) (*[]domain.User, error)
-	UpdateUser(user *domain.User) error
+	ListUsers(pageSize int, pageToken string) (*[]domain.User, string, error)
+	UpdateUser(user *domain.User, columns []string) error
+	UpdateBookmarksPublic(id int, public bool) error
+	UpdateDigestEnabled(id int, enabled bool) error
+	ListDigestRecipients(now, sentBefore time.Time, limit int) (*[]domain.User, error)
+	UpdateDigestSentAt(id int, sentAt time.Time) error
+	UpdateDigestFailed(id, errorCount int, retryAt time.Time) error
 	DeleteUser(id int) error
+	RestoreUser(id int) error
+	PurgeDeletedUsers(before time.Time) error
 }
 
 type userRepository struct {
@@ -40,14 +50,64 @@ func (repo *userRepository) GetUserByEmail(email string) (*domain.User, error) {
 	return user, err
 }
 
-func (repo *userRepository) ListUsers(offset, limit int) (*[]domain.User, error) {
+func (repo *userRepository) ListUsers(pageSize int, pageToken string) (*[]domain.User, string, error) {
 	users := &[]domain.User{}
-	err := repo.db.Order("created_at desc").Offset(offset).Limit(limit).Find(users).Error
+	query, err := keysetPage(repo.db, pageToken, pageSize, "users", true)
+	if err != nil {
+		return users, "", err
+	}
+	if err := query.Find(users).Error; err != nil {
+		return users, "", err
+	}
+	return users, trimPage(users, pageSize, func(user domain.User) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}
+	}), nil
+}
+
+func (repo *userRepository) UpdateUser(user *domain.User, columns []string) error {
+	version := user.Version
+	user.Version = version + 1
+	result := repo.db.Model(user).Clauses(clause.Returning{}).Select(append(columns, "version")).Where("version = ?", version).Updates(user)
+	return checkVersion(repo.db, result, &domain.User{}, user.ID)
+}
+
+func (repo *userRepository) UpdateBookmarksPublic(id int, public bool) error {
+	err := repo.db.Model(&domain.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"bookmarks_public": public,
+		"version":          gorm.Expr("version + 1"),
+	}).Error
+	return err
+}
+
+func (repo *userRepository) UpdateDigestEnabled(id int, enabled bool) error {
+	err := repo.db.Model(&domain.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"digest_enabled": enabled,
+		"version":        gorm.Expr("version + 1"),
+	}).Error
+	return err
+}
+
+func (repo *userRepository) ListDigestRecipients(now, sentBefore time.Time, limit int) (*[]domain.User, error) {
+	users := &[]domain.User{}
+	err := repo.db.
+		Where("digest_enabled AND (digest_sent_at IS NULL OR digest_sent_at < ?)", sentBefore).
+		Where("digest_retry_at IS NULL OR digest_retry_at <= ?", now).
+		Where("EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.user_id = users.id AND bookmarks.read_at IS NULL AND bookmarks.deleted_at IS NULL)").
+		Order("id").
+		Limit(limit).
+		Find(users).Error
 	return users, err
 }
 
-func (repo *userRepository) UpdateUser(user *domain.User) error {
-	err := repo.db.Model(user).Clauses(clause.Returning{}).Updates(user).Error
+func (repo *userRepository) UpdateDigestSentAt(id int, sentAt time.Time) error {
+	err := repo.db.Model(&domain.User{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"digest_sent_at": sentAt, "digest_error_count": 0, "digest_retry_at": nil}).Error
+	return err
+}
+
+func (repo *userRepository) UpdateDigestFailed(id, errorCount int, retryAt time.Time) error {
+	err := repo.db.Model(&domain.User{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{"digest_error_count": errorCount, "digest_retry_at": retryAt}).Error
 	return err
 }
 
@@ -55,3 +115,23 @@ func (repo *userRepository) DeleteUser(id int) error {
 	err := repo.db.Delete(&domain.User{}, id).Error
 	return err
 }
+
+func (repo *userRepository) RestoreUser(id int) error {
+	return restore(repo.db, &domain.User{}, id)
+}
+
+func (repo *userRepository) PurgeDeletedUsers(before time.Time) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		deleted := tx.Unscoped().Model(&domain.User{}).Select("id").Where("deleted_at < ?", before)
+		if err := tx.Unscoped().Where("user_id IN (?)", deleted).Delete(&domain.Bookmark{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("user_id IN (?)", deleted).Delete(&domain.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := resetOrphanedCommentDepth(tx); err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("deleted_at < ?", before).Delete(&domain.User{}).Error
+	})
+}
@@ -40,7 +40,7 @@ func TestCreateUser(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "users" ("username","email","password","google_id","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		`INSERT INTO "users" ("username","email","password","google_id","bookmarks_public","digest_enabled","digest_sent_at","role","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
 		WillReturnRows(rows)
 	mock.ExpectCommit()
 
@@ -67,7 +67,7 @@ func TestGetUser(t *testing.T) {
 		AddRow(1, testUser.Username, testUser.Email, testUser.Password, testUser.GoogleID, time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT $2`)).
+		`SELECT * FROM "users" WHERE "users"."id" = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT $2`)).
 		WithArgs(1, 1).
 		WillReturnRows(rows)
 
@@ -94,7 +94,7 @@ func TestGetUserByEmail(t *testing.T) {
 		AddRow(1, testUser.Username, testUser.Email, testUser.Password, testUser.GoogleID, time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "users" WHERE email=$1 ORDER BY "users"."id" LIMIT $2`)).
+		`SELECT * FROM "users" WHERE email=$1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT $2`)).
 		WithArgs("test@example.com", 1).
 		WillReturnRows(rows)
 
@@ -127,7 +127,7 @@ func TestListUsers(t *testing.T) {
 		WillReturnRows(rows)
 
 	repo := NewUserRepository(db)
-	_, err = repo.ListUsers(1, 2)
+	_, _, err = repo.ListUsers(2, "")
 	if err != nil {
 		t.Fatalf("failed to list user: %s", err)
 	}
@@ -149,14 +149,14 @@ func TestUpdateUser(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "users" ("username","email","password","google_id","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		`INSERT INTO "users" ("username","email","password","google_id","bookmarks_public","digest_enabled","digest_sent_at","role","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
 		WillReturnRows(rows)
 	mock.ExpectCommit()
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`UPDATE "users" SET "username"=$1,"email"=$2,"password"=$3,"google_id"=$4,"created_at"=$5,"updated_at"=$6 WHERE "id" = $7 RETURNING *`)).
-		WillReturnRows(rows)
+		`UPDATE "users" SET "username"=$1,"email"=$2,"password"=$3,"version"=$4,"updated_at"=$5 WHERE version = $6 AND "users"."deleted_at" IS NULL AND "id" = $7 RETURNING *`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 2))
 	mock.ExpectCommit()
 
 	repo := NewUserRepository(db)
@@ -164,7 +164,7 @@ func TestUpdateUser(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to create user: %s", err)
 	}
-	err = repo.UpdateUser(testUser)
+	err = repo.UpdateUser(testUser, []string{"username", "email", "password"})
 	if err != nil {
 		t.Fatalf("failed to list user: %s", err)
 	}
@@ -174,6 +174,131 @@ func TestUpdateUser(t *testing.T) {
 	}
 }
 
+func TestUpdateBookmarksPublic(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "users" SET "bookmarks_public"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3 AND "users"."deleted_at" IS NULL`)).
+		WithArgs(true, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewUserRepository(db)
+	err = repo.UpdateBookmarksPublic(1, true)
+	if err != nil {
+		t.Fatalf("failed to update bookmarks public: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Bookmarks Public: %v", err)
+	}
+}
+
+func TestUpdateDigestEnabled(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "users" SET "digest_enabled"=$1,"version"=version + 1,"updated_at"=$2 WHERE id = $3 AND "users"."deleted_at" IS NULL`)).
+		WithArgs(false, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewUserRepository(db)
+	err = repo.UpdateDigestEnabled(1, false)
+	if err != nil {
+		t.Fatalf("failed to update digest enabled: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Digest Enabled: %v", err)
+	}
+}
+
+func TestListDigestRecipients(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	now := time.Now()
+	before := now.Add(-7 * 24 * time.Hour)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "users" WHERE (digest_enabled AND (digest_sent_at IS NULL OR digest_sent_at < $1)) AND (digest_retry_at IS NULL OR digest_retry_at <= $2) AND (EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.user_id = users.id AND bookmarks.read_at IS NULL AND bookmarks.deleted_at IS NULL)) AND "users"."deleted_at" IS NULL ORDER BY id LIMIT $3`)).
+		WithArgs(before, now, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "digest_enabled"}).AddRow(1, "test_username", "test@example.com", true))
+
+	repo := NewUserRepository(db)
+	users, err := repo.ListDigestRecipients(now, before, 100)
+	if err != nil {
+		t.Fatalf("failed to list digest recipients: %s", err)
+	}
+	if len(*users) != 1 || (*users)[0].Email != "test@example.com" {
+		t.Errorf("unexpected users: %v", *users)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Digest Recipients: %v", err)
+	}
+}
+
+func TestUpdateDigestSentAt(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	sentAt := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "users" SET "digest_error_count"=$1,"digest_retry_at"=$2,"digest_sent_at"=$3 WHERE id = $4 AND "users"."deleted_at" IS NULL`)).
+		WithArgs(0, nil, sentAt, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewUserRepository(db)
+	err = repo.UpdateDigestSentAt(1, sentAt)
+	if err != nil {
+		t.Fatalf("failed to update digest sent at: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Digest Sent At: %v", err)
+	}
+}
+
+func TestUpdateDigestFailed(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	retryAt := time.Now().Add(5 * time.Minute)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "users" SET "digest_error_count"=$1,"digest_retry_at"=$2 WHERE id = $3 AND "users"."deleted_at" IS NULL`)).
+		WithArgs(1, retryAt, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewUserRepository(db)
+	err = repo.UpdateDigestFailed(1, 1, retryAt)
+	if err != nil {
+		t.Fatalf("failed to update digest failure: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Digest Failed: %v", err)
+	}
+}
+
 func TestDeleteUser(t *testing.T) {
 	db, mock, err := mock.NewDBMock()
 	if err != nil {
@@ -182,8 +307,8 @@ func TestDeleteUser(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "users" WHERE "users"."id" = $1`)).
-		WithArgs(1).
+		`UPDATE "users" SET "deleted_at"=$1 WHERE "users"."id" = $2 AND "users"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -197,3 +322,38 @@ func TestDeleteUser(t *testing.T) {
 		t.Errorf("Test Find User: %v", err)
 	}
 }
+
+func TestPurgeDeletedUsers(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	before := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "bookmarks" WHERE user_id IN (SELECT "id" FROM "users" WHERE deleted_at < $1)`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "comments" WHERE user_id IN (SELECT "id" FROM "users" WHERE deleted_at < $1)`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE comments SET depth = tree.depth FROM tree WHERE comments.id = tree.id`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "users" WHERE deleted_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewUserRepository(db)
+	if err := repo.PurgeDeletedUsers(before); err != nil {
+		t.Fatalf("failed to purge deleted users: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Purge Deleted Users: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+func checkVersion(db *gorm.DB, result *gorm.DB, model interface{}, id uint) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return domain.ErrVersionConflict
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IWorkspaceRepository interface {
+	CreateWorkspace(workspace *domain.Workspace, ownerID int) error
+	GetWorkspace(id int) (*domain.Workspace, error)
+	ListWorkspacesByUserID(userID, pageSize int, pageToken string) (*[]domain.WorkspaceMembership, string, error)
+	DeleteWorkspace(id int) error
+	GetWorkspaceMember(workspaceID, userID int) (*domain.WorkspaceMember, error)
+	ListWorkspaceMembers(workspaceID, pageSize int, pageToken string) (*[]domain.WorkspaceMember, string, error)
+	UpdateWorkspaceMember(member *domain.WorkspaceMember) error
+	DeleteWorkspaceMember(workspaceID, userID int) error
+	CountWorkspaceOwners(workspaceID int) (int, error)
+	CreateWorkspaceInvitation(invitation *domain.WorkspaceInvitation) error
+	GetWorkspaceInvitationByToken(token string) (*domain.WorkspaceInvitation, error)
+	AcceptWorkspaceInvitation(invitation *domain.WorkspaceInvitation, userID int) error
+	DeleteWorkspaceInvitation(id int) error
+}
+
+type workspaceRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkspaceRepository(db *gorm.DB) IWorkspaceRepository {
+	return &workspaceRepository{db}
+}
+
+func (repo *workspaceRepository) CreateWorkspace(workspace *domain.Workspace, ownerID int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(workspace).Error; err != nil {
+			return err
+		}
+		member := domain.WorkspaceMember{WorkspaceID: workspace.ID, UserID: uint(ownerID), Role: domain.WorkspaceRoleOwner}
+		return tx.Create(&member).Error
+	})
+}
+
+func (repo *workspaceRepository) GetWorkspace(id int) (*domain.Workspace, error) {
+	workspace := &domain.Workspace{}
+	err := repo.db.First(workspace, id).Error
+	return workspace, err
+}
+
+func (repo *workspaceRepository) ListWorkspacesByUserID(userID, pageSize int, pageToken string) (*[]domain.WorkspaceMembership, string, error) {
+	workspaces := &[]domain.WorkspaceMembership{}
+	db := repo.db.Model(&domain.Workspace{}).
+		Select("workspaces.*, workspace_members.role").
+		Joins("JOIN workspace_members ON workspace_members.workspace_id = workspaces.id").
+		Where("workspace_members.user_id = ?", userID)
+	query, err := keysetPage(db, pageToken, pageSize, "workspaces", false)
+	if err != nil {
+		return workspaces, "", err
+	}
+	if err := query.Scan(workspaces).Error; err != nil {
+		return workspaces, "", err
+	}
+	return workspaces, trimPage(workspaces, pageSize, workspaceMembershipCursor), nil
+}
+
+func (repo *workspaceRepository) DeleteWorkspace(id int) error {
+	err := repo.db.Delete(&domain.Workspace{}, id).Error
+	return err
+}
+
+func (repo *workspaceRepository) GetWorkspaceMember(workspaceID, userID int) (*domain.WorkspaceMember, error) {
+	member := &domain.WorkspaceMember{}
+	err := repo.db.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).First(member).Error
+	return member, err
+}
+
+func (repo *workspaceRepository) ListWorkspaceMembers(workspaceID, pageSize int, pageToken string) (*[]domain.WorkspaceMember, string, error) {
+	members := &[]domain.WorkspaceMember{}
+	query, err := keysetPageBy(repo.db.Where("workspace_id = ?", workspaceID), pageToken, pageSize, "workspace_members", "user_id", false)
+	if err != nil {
+		return members, "", err
+	}
+	if err := query.Find(members).Error; err != nil {
+		return members, "", err
+	}
+	return members, trimPage(members, pageSize, workspaceMemberCursor), nil
+}
+
+func (repo *workspaceRepository) UpdateWorkspaceMember(member *domain.WorkspaceMember) error {
+	result := repo.db.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", member.WorkspaceID, member.UserID).
+		Update("role", member.Role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (repo *workspaceRepository) DeleteWorkspaceMember(workspaceID, userID int) error {
+	result := repo.db.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).Delete(&domain.WorkspaceMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (repo *workspaceRepository) CountWorkspaceOwners(workspaceID int) (int, error) {
+	var count int64
+	err := repo.db.Model(&domain.WorkspaceMember{}).Where("workspace_id = ? AND role = ?", workspaceID, domain.WorkspaceRoleOwner).Count(&count).Error
+	return int(count), err
+}
+
+func (repo *workspaceRepository) CreateWorkspaceInvitation(invitation *domain.WorkspaceInvitation) error {
+	err := repo.db.Create(invitation).Error
+	return err
+}
+
+func (repo *workspaceRepository) GetWorkspaceInvitationByToken(token string) (*domain.WorkspaceInvitation, error) {
+	invitation := &domain.WorkspaceInvitation{}
+	err := repo.db.Where("token = ?", token).First(invitation).Error
+	return invitation, err
+}
+
+func (repo *workspaceRepository) AcceptWorkspaceInvitation(invitation *domain.WorkspaceInvitation, userID int) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(invitation).Where("accepted_at IS NULL").Update("accepted_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		member := domain.WorkspaceMember{WorkspaceID: invitation.WorkspaceID, UserID: uint(userID), Role: invitation.Role}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&member).Error
+	})
+}
+
+func (repo *workspaceRepository) DeleteWorkspaceInvitation(id int) error {
+	return repo.db.Delete(&domain.WorkspaceInvitation{}, id).Error
+}
+
+func workspaceMembershipCursor(workspace domain.WorkspaceMembership) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: workspace.CreatedAt, ID: workspace.ID}
+}
+
+func workspaceMemberCursor(member domain.WorkspaceMember) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: member.CreatedAt, ID: member.UserID}
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateWorkspace(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "workspaces" ("name","created_at","updated_at") VALUES ($1,$2,$3) RETURNING "id"`)).
+		WithArgs("team", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO "workspace_members" ("workspace_id","user_id","role","created_at") VALUES ($1,$2,$3,$4)`)).
+		WithArgs(1, 1, "owner", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewWorkspaceRepository(db)
+	workspace := &domain.Workspace{Name: "team"}
+	err = repo.CreateWorkspace(workspace, 1)
+	if err != nil {
+		t.Fatalf("failed to create workspace: %s", err)
+	}
+	if workspace.ID != 1 {
+		t.Errorf("unexpected workspace: %v", workspace)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Create Workspace: %v", err)
+	}
+}
+
+func TestListWorkspacesByUserID(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT workspaces.*, workspace_members.role FROM "workspaces" JOIN workspace_members ON workspace_members.workspace_id = workspaces.id WHERE workspace_members.user_id = $1 ORDER BY workspaces.created_at asc, workspaces.id asc LIMIT $2`)).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "role"}).AddRow(1, "team", "owner").AddRow(2, "other", "viewer"))
+
+	repo := NewWorkspaceRepository(db)
+	workspaces, nextPageToken, err := repo.ListWorkspacesByUserID(1, 2, "")
+	if err != nil {
+		t.Fatalf("failed to list workspaces: %s", err)
+	}
+	if len(*workspaces) != 2 || (*workspaces)[0].Role != "owner" || (*workspaces)[1].Name != "other" {
+		t.Errorf("unexpected workspaces: %v", workspaces)
+	}
+	if nextPageToken != "" {
+		t.Errorf("unexpected next page token: %s", nextPageToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Workspaces By User ID: %v", err)
+	}
+}
+
+func TestListWorkspaceMembers(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	createdAt := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "workspace_members" WHERE workspace_id = $1 ORDER BY workspace_members.created_at asc, workspace_members.user_id asc LIMIT $2`)).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"workspace_id", "user_id", "role", "created_at"}).
+			AddRow(1, 1, "owner", createdAt).
+			AddRow(1, 2, "editor", createdAt).
+			AddRow(1, 3, "viewer", createdAt))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "workspace_members" WHERE workspace_id = $1 AND (workspace_members.created_at, workspace_members.user_id) > ($2, $3) ORDER BY workspace_members.created_at asc, workspace_members.user_id asc LIMIT $4`)).
+		WithArgs(1, sqlmock.AnyArg(), 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"workspace_id", "user_id", "role", "created_at"}).AddRow(1, 3, "viewer", createdAt))
+
+	repo := NewWorkspaceRepository(db)
+	members, nextPageToken, err := repo.ListWorkspaceMembers(1, 2, "")
+	if err != nil {
+		t.Fatalf("failed to list workspace members: %s", err)
+	}
+	if len(*members) != 2 || nextPageToken == "" {
+		t.Errorf("unexpected workspace members: %v, %s", members, nextPageToken)
+	}
+	members, nextPageToken, err = repo.ListWorkspaceMembers(1, 2, nextPageToken)
+	if err != nil {
+		t.Fatalf("failed to list workspace members: %s", err)
+	}
+	if len(*members) != 1 || nextPageToken != "" {
+		t.Errorf("unexpected workspace members: %v, %s", members, nextPageToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test List Workspace Members: %v", err)
+	}
+}
+
+func TestUpdateWorkspaceMember(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "workspace_members" SET "role"=$1 WHERE workspace_id = $2 AND user_id = $3`)).
+		WithArgs("editor", 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewWorkspaceRepository(db)
+	err = repo.UpdateWorkspaceMember(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: "editor"})
+	if err != gorm.ErrRecordNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Update Workspace Member: %v", err)
+	}
+}
+
+func TestCountWorkspaceOwners(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT count(*) FROM "workspace_members" WHERE workspace_id = $1 AND role = $2`)).
+		WithArgs(1, "owner").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	repo := NewWorkspaceRepository(db)
+	count, err := repo.CountWorkspaceOwners(1)
+	if err != nil {
+		t.Fatalf("failed to count workspace owners: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count: %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Count Workspace Owners: %v", err)
+	}
+}
+
+func TestAcceptWorkspaceInvitation(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "workspace_invitations" SET "accepted_at"=$1 WHERE accepted_at IS NULL AND "id" = $2`)).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO "workspace_members" ("workspace_id","user_id","role","created_at") VALUES ($1,$2,$3,$4) ON CONFLICT DO NOTHING`)).
+		WithArgs(1, 2, "editor", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := NewWorkspaceRepository(db)
+	invitation := &domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "user@example.com", Role: "editor", ExpiresAt: time.Now().Add(time.Hour)}
+	err = repo.AcceptWorkspaceInvitation(invitation, 2)
+	if err != nil {
+		t.Fatalf("failed to accept workspace invitation: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Accept Workspace Invitation: %v", err)
+	}
+}
+
+func TestAcceptWorkspaceInvitationAlreadyAccepted(t *testing.T) {
+	db, mock, err := mock.NewDBMock()
+	if err != nil {
+		t.Errorf("Failed to initialize mock DB: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "workspace_invitations" SET "accepted_at"=$1 WHERE accepted_at IS NULL AND "id" = $2`)).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := NewWorkspaceRepository(db)
+	invitation := &domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Role: "editor"}
+	err = repo.AcceptWorkspaceInvitation(invitation, 2)
+	if err != gorm.ErrRecordNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Test Accept Workspace Invitation Already Accepted: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+)
+
+const dueArticleLinkLimit = 50
+
+type IArticleLinkUsecase interface {
+	CheckDueArticleLinks() error
+	ListBrokenArticleLinks(userID, pageSize int, pageToken string) ([]domain.ArticleLink, string, error)
+}
+
+type articleLinkUsecase struct {
+	repo            repository.IArticleLinkRepository
+	userRepo        repository.IUserRepository
+	checker         *linkcheck.Checker
+	checkInterval   time.Duration
+	retryInterval   time.Duration
+	brokenThreshold int
+}
+
+func NewArticleLinkUsecase(repo repository.IArticleLinkRepository, userRepo repository.IUserRepository, checker *linkcheck.Checker, checkInterval, retryInterval time.Duration, brokenThreshold int) IArticleLinkUsecase {
+	return &articleLinkUsecase{repo, userRepo, checker, checkInterval, retryInterval, brokenThreshold}
+}
+
+func (usecase *articleLinkUsecase) CheckDueArticleLinks() error {
+	links, err := usecase.repo.ListDueArticleLinks(time.Now(), dueArticleLinkLimit)
+	if err != nil {
+		return err
+	}
+	for _, link := range *links {
+		usecase.checkArticleLink(&link)
+		if err := usecase.repo.UpdateArticleLinkCheckState(&link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (usecase *articleLinkUsecase) ListBrokenArticleLinks(userID, pageSize int, pageToken string) ([]domain.ArticleLink, string, error) {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return []domain.ArticleLink{}, "", err
+	}
+	links, nextPageToken, err := usecase.repo.ListBrokenArticleLinks(pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.ArticleLink{}, "", err
+	}
+	return *links, nextPageToken, nil
+}
+
+func (usecase *articleLinkUsecase) checkArticleLink(link *domain.ArticleLink) {
+	now := time.Now()
+	link.LastCheckedAt = &now
+	link.StatusCode = 0
+	link.RedirectUrl = ""
+
+	result, err := usecase.checker.Check(link.Url)
+	if err == nil {
+		link.StatusCode = result.StatusCode
+		if result.FinalUrl != link.Url {
+			link.RedirectUrl = result.FinalUrl
+		}
+		if result.StatusCode >= 400 && result.StatusCode != http.StatusTooManyRequests {
+			err = fmt.Errorf("unexpected status: %d", result.StatusCode)
+		}
+	}
+	if err != nil {
+		link.FailureCount++
+		link.LastError = err.Error()
+		link.Broken = link.FailureCount >= usecase.brokenThreshold
+		link.NextCheckAt = now.Add(usecase.retryInterval)
+		return
+	}
+
+	link.FailureCount = 0
+	link.LastError = ""
+	link.Broken = false
+	link.NextCheckAt = now.Add(usecase.checkInterval)
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/linkcheck"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDueArticleLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name        string
+		articleLink domain.ArticleLink
+		check       func(t *testing.T, link *domain.ArticleLink)
+	}{
+		{
+			name:        "OK",
+			articleLink: domain.ArticleLink{ID: 1, Url: server.URL + "/ok", FailureCount: 2, LastError: "unexpected status: 500"},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, http.StatusOK, link.StatusCode)
+				assert.Equal(t, "", link.RedirectUrl)
+				assert.Equal(t, 0, link.FailureCount)
+				assert.Equal(t, "", link.LastError)
+				assert.False(t, link.Broken)
+				assert.NotNil(t, link.LastCheckedAt)
+				assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), link.NextCheckAt, time.Minute)
+			},
+		},
+		{
+			name:        "Redirect",
+			articleLink: domain.ArticleLink{ID: 1, Url: server.URL + "/moved"},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, http.StatusOK, link.StatusCode)
+				assert.Equal(t, server.URL+"/ok", link.RedirectUrl)
+				assert.False(t, link.Broken)
+			},
+		},
+		{
+			name:        "HeadNotAllowed",
+			articleLink: domain.ArticleLink{ID: 1, Url: server.URL + "/no-head"},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, http.StatusOK, link.StatusCode)
+				assert.Equal(t, 0, link.FailureCount)
+			},
+		},
+		{
+			name:        "Failure",
+			articleLink: domain.ArticleLink{ID: 1, Url: server.URL + "/gone"},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, http.StatusNotFound, link.StatusCode)
+				assert.Equal(t, 1, link.FailureCount)
+				assert.Contains(t, link.LastError, "404")
+				assert.False(t, link.Broken)
+				assert.WithinDuration(t, time.Now().Add(6*time.Hour), link.NextCheckAt, time.Minute)
+			},
+		},
+		{
+			name:        "Broken",
+			articleLink: domain.ArticleLink{ID: 1, Url: server.URL + "/gone", FailureCount: 2},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, 3, link.FailureCount)
+				assert.True(t, link.Broken)
+			},
+		},
+		{
+			name:        "DisallowedScheme",
+			articleLink: domain.ArticleLink{ID: 1, Url: "file:///etc/passwd"},
+			check: func(t *testing.T, link *domain.ArticleLink) {
+				assert.Equal(t, 0, link.StatusCode)
+				assert.Equal(t, 1, link.FailureCount)
+				assert.Contains(t, link.LastError, "disallowed scheme")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleLinkRepository(mockCtrl)
+			gomock.InOrder(
+				repo.EXPECT().ListDueArticleLinks(gomock.Any(), dueArticleLinkLimit).Return(&[]domain.ArticleLink{tc.articleLink}, nil),
+				repo.EXPECT().UpdateArticleLinkCheckState(gomock.Any()).DoAndReturn(func(link *domain.ArticleLink) error {
+					tc.check(t, link)
+					return nil
+				}),
+			)
+
+			usecase := NewArticleLinkUsecase(repo, mock.NewMockIUserRepository(mockCtrl), linkcheck.NewChecker(&http.Client{Timeout: time.Second}), 7*24*time.Hour, 6*time.Hour, 3)
+			err := usecase.CheckDueArticleLinks()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckDueArticleLinksPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIArticleLinkRepository(mockCtrl)
+	repo.EXPECT().ListDueArticleLinks(gomock.Any(), dueArticleLinkLimit).Return(&[]domain.ArticleLink{{ID: 1, Url: server.URL}}, nil)
+	repo.EXPECT().UpdateArticleLinkCheckState(gomock.Any()).DoAndReturn(func(link *domain.ArticleLink) error {
+		assert.Equal(t, 0, link.StatusCode)
+		assert.Equal(t, 1, link.FailureCount)
+		assert.Contains(t, link.LastError, "disallowed address")
+		return nil
+	})
+
+	usecase := NewArticleLinkUsecase(repo, mock.NewMockIUserRepository(mockCtrl), linkcheck.NewChecker(safehttp.NewClient(time.Second)), 7*24*time.Hour, 6*time.Hour, 3)
+	err := usecase.CheckDueArticleLinks()
+	assert.NoError(t, err)
+}
+
+func TestListBrokenArticleLinks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, links []domain.ArticleLink, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().ListBrokenArticleLinks(20, "").Return(&[]domain.ArticleLink{{ID: 1, ArticleID: 2, Broken: true}}, "", nil)
+			},
+			checkResponse: func(t *testing.T, links []domain.ArticleLink, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, links, 1)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			buildStubs: func(repo *mock.MockIArticleLinkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1}, nil)
+				repo.EXPECT().ListBrokenArticleLinks(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, links []domain.ArticleLink, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleLinkRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			usecase := NewArticleLinkUsecase(repo, userRepo, linkcheck.NewChecker(&http.Client{Timeout: time.Second}), 7*24*time.Hour, 6*time.Hour, 3)
+			links, _, err := usecase.ListBrokenArticleLinks(1, 0, "")
+			tc.checkResponse(t, links, err)
+		})
+	}
+}
@@ -1,29 +1,66 @@
 package usecase
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/htmltext"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/loak155/techbranch-backend/pkg/urlnorm"
 )
 
 type IArticleUsecase interface {
 	CreateArticle(article domain.Article) (domain.Article, error)
 	GetArticle(id int) (domain.Article, error)
-	ListArticles(offset, limit int) ([]domain.Article, error)
-	UpdateArticle(article domain.Article) (domain.Article, error)
-	DeleteArticle(id int) error
+	ListArticles(orderBy string, pageSize int, pageToken string) ([]domain.Article, string, error)
+	UpdateArticle(userID int, article domain.Article, fields []string) (domain.Article, error)
+	DeleteArticle(userID, id int) error
 	GetArticleCount() (int, error)
-	GetBookmarkedArticles(userID int) ([]domain.Article, error)
+	GetBookmarkedArticles(userID int, readState string, pageSize int, pageToken string) ([]domain.Article, string, error)
+	ListArticlesByTag(tag string, pageSize int, pageToken string) ([]domain.Article, string, error)
+	SearchArticles(query domain.ArticleSearchQuery) ([]domain.ArticleSearchResult, string, error)
+	ListRelatedArticles(articleID, pageSize int) ([]domain.Article, error)
+	RefreshArticleScores() error
+	BackfillNormalizedUrls() error
+	ExistsArticleByUrl(articleUrl string) (bool, error)
+	GetArticleByUrl(articleUrl string) (domain.Article, error)
+	RestoreArticle(userID, id int) (domain.Article, error)
+	ListArticleRevisions(articleID, pageSize int, pageToken string) ([]domain.ArticleRevision, string, error)
+	RevertArticle(userID, articleID, revision, version int) (domain.Article, error)
+	DiffArticleRevisions(articleID, fromRevision, toRevision int) ([]domain.ArticleFieldChange, error)
+}
+
+const (
+	trendingGravity            = 1.8
+	normalizedUrlBackfillBatch = 500
+)
+
+var articleUpdateColumns = map[string][]string{
+	"title":       {"title"},
+	"url":         {"url", "normalized_url", "site"},
+	"image":       {"image"},
+	"description": {"description"},
+	"body":        {"body"},
+	"tags":        {"tags"},
 }
 
+var articleContentColumns = []string{"title", "url", "normalized_url", "site", "image", "description", "body", "tags"}
+
 type articleUsecase struct {
-	repo repository.IArticleRepository
+	repo         repository.IArticleRepository
+	userRepo     repository.IUserRepository
+	revisionRepo repository.IArticleRevisionRepository
 }
 
-func NewArticleUsecase(repo repository.IArticleRepository) IArticleUsecase {
-	return &articleUsecase{repo}
+func NewArticleUsecase(repo repository.IArticleRepository, userRepo repository.IUserRepository, revisionRepo repository.IArticleRevisionRepository) IArticleUsecase {
+	return &articleUsecase{repo, userRepo, revisionRepo}
 }
 
 func (usecase *articleUsecase) CreateArticle(article domain.Article) (domain.Article, error) {
+	prepareArticle(&article)
 	if err := usecase.repo.CreateArticle(&article); err != nil {
 		return domain.Article{}, err
 	}
@@ -38,26 +75,125 @@ func (usecase *articleUsecase) GetArticle(id int) (domain.Article, error) {
 	return *article, nil
 }
 
-func (usecase *articleUsecase) ListArticles(offset, limit int) ([]domain.Article, error) {
-	articles, err := usecase.repo.ListArticles(offset, limit)
+func (usecase *articleUsecase) ListArticles(orderBy string, pageSize int, pageToken string) ([]domain.Article, string, error) {
+	if orderBy == "" {
+		orderBy = domain.ArticleOrderNewest
+	}
+	articles, nextPageToken, err := usecase.repo.ListArticles(orderBy, pagination.PageSize(pageSize), pageToken)
 	if err != nil {
-		return []domain.Article{}, err
+		return []domain.Article{}, "", err
 	}
-	return *articles, nil
+	return *articles, nextPageToken, nil
 }
 
-func (usecase *articleUsecase) UpdateArticle(article domain.Article) (domain.Article, error) {
-	if err := usecase.repo.UpdateArticle(&article); err != nil {
+func (usecase *articleUsecase) UpdateArticle(userID int, article domain.Article, fields []string) (domain.Article, error) {
+	columns := []string{}
+	for _, field := range fields {
+		fieldColumns, ok := articleUpdateColumns[field]
+		if !ok {
+			return domain.Article{}, fmt.Errorf("%w: unknown article field: %s", domain.ErrInvalidArgument, field)
+		}
+		columns = append(columns, fieldColumns...)
+	}
+	if err := usecase.authorizeArticleEdit(userID, int(article.ID)); err != nil {
+		return domain.Article{}, err
+	}
+	prepareArticle(&article)
+	if err := usecase.repo.UpdateArticle(&article, columns, uint(userID)); err != nil {
 		return domain.Article{}, err
 	}
 	return article, nil
 }
 
-func (usecase *articleUsecase) DeleteArticle(id int) error {
+func (usecase *articleUsecase) DeleteArticle(userID, id int) error {
+	if err := usecase.authorizeArticleEdit(userID, id); err != nil {
+		return err
+	}
 	err := usecase.repo.DeleteArticle(id)
 	return err
 }
 
+func (usecase *articleUsecase) authorizeArticleEdit(userID, articleID int) error {
+	article, err := usecase.repo.GetArticle(articleID)
+	if err != nil {
+		return err
+	}
+	if article.SubmittedByUserID != nil && int(*article.SubmittedByUserID) == userID {
+		return nil
+	}
+	return requireModerator(usecase.userRepo, userID)
+}
+
+func (usecase *articleUsecase) RestoreArticle(userID, id int) (domain.Article, error) {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return domain.Article{}, err
+	}
+	if err := usecase.repo.RestoreArticle(id); err != nil {
+		return domain.Article{}, err
+	}
+	return usecase.GetArticle(id)
+}
+
+func (usecase *articleUsecase) ListArticleRevisions(articleID, pageSize int, pageToken string) ([]domain.ArticleRevision, string, error) {
+	revisions, nextPageToken, err := usecase.revisionRepo.ListArticleRevisions(articleID, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.ArticleRevision{}, "", err
+	}
+	return *revisions, nextPageToken, nil
+}
+
+func (usecase *articleUsecase) RevertArticle(userID, articleID, revision, version int) (domain.Article, error) {
+	if err := usecase.authorizeArticleEdit(userID, articleID); err != nil {
+		return domain.Article{}, err
+	}
+	target, err := usecase.revisionRepo.GetArticleRevision(articleID, revision)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	article := domain.Article{
+		ID:          uint(articleID),
+		Title:       target.Title,
+		Url:         target.Url,
+		Image:       target.Image,
+		Description: target.Description,
+		Body:        target.Body,
+		Tags:        target.Tags,
+		Version:     version,
+	}
+	prepareArticle(&article)
+	if err := usecase.repo.UpdateArticle(&article, articleContentColumns, uint(userID)); err != nil {
+		return domain.Article{}, err
+	}
+	return article, nil
+}
+
+func (usecase *articleUsecase) DiffArticleRevisions(articleID, fromRevision, toRevision int) ([]domain.ArticleFieldChange, error) {
+	from, err := usecase.revisionRepo.GetArticleRevision(articleID, fromRevision)
+	if err != nil {
+		return []domain.ArticleFieldChange{}, err
+	}
+	to, err := usecase.revisionRepo.GetArticleRevision(articleID, toRevision)
+	if err != nil {
+		return []domain.ArticleFieldChange{}, err
+	}
+
+	fields := []domain.ArticleFieldChange{
+		{Field: "title", From: from.Title, To: to.Title},
+		{Field: "url", From: from.Url, To: to.Url},
+		{Field: "image", From: from.Image, To: to.Image},
+		{Field: "description", From: from.Description, To: to.Description},
+		{Field: "body", From: from.Body, To: to.Body},
+		{Field: "tags", From: strings.Join(from.Tags, ","), To: strings.Join(to.Tags, ",")},
+	}
+	changes := []domain.ArticleFieldChange{}
+	for _, field := range fields {
+		if field.From != field.To {
+			changes = append(changes, field)
+		}
+	}
+	return changes, nil
+}
+
 func (usecase *articleUsecase) GetArticleCount() (int, error) {
 	count, err := usecase.repo.GetArticleCount()
 	if err != nil {
@@ -66,10 +202,99 @@ func (usecase *articleUsecase) GetArticleCount() (int, error) {
 	return count, nil
 }
 
-func (usecase *articleUsecase) GetBookmarkedArticles(userID int) ([]domain.Article, error) {
-	articles, err := usecase.repo.GetBookmarkedArticles(userID)
+func (usecase *articleUsecase) GetBookmarkedArticles(userID int, readState string, pageSize int, pageToken string) ([]domain.Article, string, error) {
+	articles, nextPageToken, err := usecase.repo.GetBookmarkedArticles(userID, readState, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.Article{}, "", err
+	}
+	return *articles, nextPageToken, nil
+}
+
+func (usecase *articleUsecase) ListArticlesByTag(tag string, pageSize int, pageToken string) ([]domain.Article, string, error) {
+	articles, nextPageToken, err := usecase.repo.ListArticlesByTag(tag, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.Article{}, "", err
+	}
+	return *articles, nextPageToken, nil
+}
+
+func (usecase *articleUsecase) SearchArticles(query domain.ArticleSearchQuery) ([]domain.ArticleSearchResult, string, error) {
+	query.PageSize = pagination.PageSize(query.PageSize)
+	results, nextPageToken, err := usecase.repo.SearchArticles(query)
+	if err != nil {
+		return []domain.ArticleSearchResult{}, "", err
+	}
+	return *results, nextPageToken, nil
+}
+
+func (usecase *articleUsecase) ListRelatedArticles(articleID, pageSize int) ([]domain.Article, error) {
+	if _, err := usecase.repo.GetArticle(articleID); err != nil {
+		return []domain.Article{}, err
+	}
+	articles, err := usecase.repo.ListRelatedArticles(articleID, pagination.PageSize(pageSize))
 	if err != nil {
 		return []domain.Article{}, err
 	}
 	return *articles, nil
 }
+
+func (usecase *articleUsecase) RefreshArticleScores() error {
+	return usecase.repo.RefreshArticleScores(trendingGravity)
+}
+
+func (usecase *articleUsecase) BackfillNormalizedUrls() error {
+	afterID := 0
+	for {
+		articles, err := usecase.repo.ListArticleUrls(afterID, normalizedUrlBackfillBatch)
+		if err != nil {
+			return err
+		}
+		for _, article := range *articles {
+			afterID = int(article.ID)
+			normalizedUrl, err := urlnorm.Normalize(article.Url)
+			if err != nil || normalizedUrl == article.NormalizedUrl {
+				continue
+			}
+			if err := usecase.repo.UpdateArticleNormalizedUrl(afterID, normalizedUrl); err != nil {
+				return err
+			}
+		}
+		if len(*articles) < normalizedUrlBackfillBatch {
+			return nil
+		}
+	}
+}
+
+func (usecase *articleUsecase) ExistsArticleByUrl(articleUrl string) (bool, error) {
+	normalizedUrl, err := urlnorm.Normalize(articleUrl)
+	if err != nil {
+		return false, err
+	}
+	return usecase.repo.ExistsArticleByNormalizedUrl(normalizedUrl)
+}
+
+func (usecase *articleUsecase) GetArticleByUrl(articleUrl string) (domain.Article, error) {
+	normalizedUrl, err := urlnorm.Normalize(articleUrl)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	article, err := usecase.repo.GetArticleByNormalizedUrl(normalizedUrl)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	return *article, nil
+}
+
+func prepareArticle(article *domain.Article) {
+	if article.Url != "" {
+		if u, err := url.Parse(article.Url); err == nil {
+			article.Site = u.Hostname()
+		}
+		if normalizedUrl, err := urlnorm.Normalize(article.Url); err == nil {
+			article.NormalizedUrl = normalizedUrl
+		}
+	}
+	if article.Body != "" {
+		article.Body = htmltext.Extract(article.Body)
+	}
+}
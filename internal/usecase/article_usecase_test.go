@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -25,7 +26,7 @@ func TestCreateArticle(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIArticleRepository)
+		buildStubs    func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository)
 		checkResponse func(t *testing.T, resArticle domain.Article, err error)
 	}{
 		{
@@ -33,7 +34,7 @@ func TestCreateArticle(t *testing.T) {
 			args: args{
 				article: reqArticle,
 			},
-			buildStubs: func(repo *mock.MockIArticleRepository) {
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
 				repo.EXPECT().CreateArticle(gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
@@ -50,7 +51,7 @@ func TestCreateArticle(t *testing.T) {
 			args: args{
 				article: domain.Article{},
 			},
-			buildStubs: func(repo *mock.MockIArticleRepository) {
+			buildStubs: func(repo *mock.MockIArticleRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
 				repo.EXPECT().CreateArticle(gomock.Any()).Return(gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
@@ -65,9 +66,10 @@ func TestCreateArticle(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIArticleRepository(mockCtrl)
-			tc.buildStubs(repo)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, revisionRepo)
 
-			usecase := NewArticleUsecase(repo)
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), revisionRepo)
 			resUser, err := usecase.CreateArticle(tc.args.article)
 			tc.checkResponse(t, resUser, err)
 		})
@@ -134,7 +136,7 @@ func TestGetArticle(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewArticleUsecase(repo)
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
 			resArticle, err := usecase.GetArticle(tc.args.id)
 			tc.checkResponse(t, resArticle, err)
 		})
@@ -143,8 +145,9 @@ func TestGetArticle(t *testing.T) {
 
 func TestListArticles(t *testing.T) {
 	type args struct {
-		offset int
-		limit  int
+		orderBy   string
+		pageSize  int
+		pageToken string
 	}
 
 	repoResArticles := []domain.Article{
@@ -175,11 +178,11 @@ func TestListArticles(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				offset: 0,
-				limit:  10,
+				pageSize:  10,
+				pageToken: "",
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any()).Return(&repoResArticles, nil)
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&repoResArticles, "", nil)
 			},
 			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
 				assert.NoError(t, err)
@@ -193,14 +196,39 @@ func TestListArticles(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "DefaultOrder",
+			args: args{
+				pageSize: 10,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListArticles(domain.ArticleOrderNewest, 10, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Trending",
+			args: args{
+				orderBy:  domain.ArticleOrderTrending,
+				pageSize: 10,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListArticles(domain.ArticleOrderTrending, 10, "").Return(&repoResArticles, "", nil)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
+				assert.NoError(t, err)
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				offset: 0,
-				limit:  10,
+				pageSize:  10,
+				pageToken: "",
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any()).Return(&[]domain.Article{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Article{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
 				assert.Error(t, err)
@@ -216,8 +244,8 @@ func TestListArticles(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewArticleUsecase(repo)
-			resArticles, err := usecase.ListArticles(tc.args.offset, tc.args.limit)
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			resArticles, _, err := usecase.ListArticles(tc.args.orderBy, tc.args.pageSize, tc.args.pageToken)
 			tc.checkResponse(t, resArticles, err)
 		})
 	}
@@ -225,6 +253,7 @@ func TestListArticles(t *testing.T) {
 
 func TestUpdateArticle(t *testing.T) {
 	type args struct {
+		userID  int
 		article domain.Article
 	}
 
@@ -235,19 +264,24 @@ func TestUpdateArticle(t *testing.T) {
 		Image: "http://example.com/image",
 	}
 
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIArticleRepository)
+		buildStubs    func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository)
 		checkResponse func(t *testing.T, resArticle domain.Article, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
+				userID:  1,
 				article: reqArticle,
 			},
-			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().UpdateArticle(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), []string{"title", "url", "normalized_url", "site", "image"}, uint(1)).Return(nil)
 			},
 			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
 				assert.NoError(t, err)
@@ -256,13 +290,45 @@ func TestUpdateArticle(t *testing.T) {
 				assert.Equal(t, reqArticle.Image, resArticle.Image)
 			},
 		},
+		{
+			name: "Moderator",
+			args: args{
+				userID:  2,
+				article: reqArticle,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				userID:  2,
+				article: reqArticle,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
 		{
 			name: "InvalidData",
 			args: args{
+				userID:  1,
 				article: reqArticle,
 			},
-			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().UpdateArticle(gomock.Any()).Return(gorm.ErrInvalidData)
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Return(gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
 				assert.Error(t, err)
@@ -276,45 +342,355 @@ func TestUpdateArticle(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIArticleRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo, revisionRepo)
 
-			usecase := NewArticleUsecase(repo)
-			res, err := usecase.UpdateArticle(tc.args.article)
+			usecase := NewArticleUsecase(repo, userRepo, revisionRepo)
+			res, err := usecase.UpdateArticle(tc.args.userID, tc.args.article, []string{"title", "url", "image"})
 			tc.checkResponse(t, res, err)
 		})
 	}
 }
 
+func TestUpdateArticleUnknownField(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIArticleRepository(mockCtrl)
+	repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+	_, err := usecase.UpdateArticle(1, domain.Article{ID: 1}, []string{"bookmark_count"})
+	assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+}
+
 func TestDeleteArticle(t *testing.T) {
 	type args struct {
-		id int
+		userID int
+		id     int
 	}
 
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIArticleRepository)
+		buildStubs    func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				id: 1,
+				userID: 1,
+				id:     1,
 			},
-			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().DeleteArticle(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				repo.EXPECT().DeleteArticle(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{ID: 1}, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteArticle(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				id: 1,
+				userID: 1,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			usecase := NewArticleUsecase(repo, userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl))
+			err := usecase.DeleteArticle(tc.args.userID, tc.args.id)
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestRestoreArticle(t *testing.T) {
+	type args struct {
+		userID int
+		id     int
+	}
+
+	repoResArticle := domain.Article{ID: 1, Title: "test_title"}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, resArticle domain.Article, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().RestoreArticle(1).Return(nil)
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResArticle.Title, resArticle.Title)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().RestoreArticle(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().RestoreArticle(1).Return(gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			usecase := NewArticleUsecase(repo, userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl))
+			res, err := usecase.RestoreArticle(tc.args.userID, tc.args.id)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestSearchArticles(t *testing.T) {
+	type args struct {
+		query domain.ArticleSearchQuery
+	}
+
+	repoResResults := []domain.ArticleSearchResult{
+		{
+			Article: domain.Article{
+				ID:        1,
+				Title:     "test_Article1",
+				Url:       "https://example1.com",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+			Rank:      0.5,
+			Highlight: "<mark>test</mark>_Article1",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, resResults []domain.ArticleSearchResult, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				query: domain.ArticleSearchQuery{Query: "test", PageSize: 10},
 			},
 			buildStubs: func(repo *mock.MockIArticleRepository) {
-				repo.EXPECT().DeleteArticle(gomock.Any()).Return(gorm.ErrRecordNotFound)
+				repo.EXPECT().SearchArticles(domain.ArticleSearchQuery{Query: "test", PageSize: 10}).Return(&repoResResults, "", nil)
+			},
+			checkResponse: func(t *testing.T, resResults []domain.ArticleSearchResult, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResResults, resResults)
+			},
+		},
+		{
+			name: "DefaultPageSize",
+			args: args{
+				query: domain.ArticleSearchQuery{Query: "test"},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().SearchArticles(domain.ArticleSearchQuery{Query: "test", PageSize: pagination.DefaultPageSize}).Return(&repoResResults, "", nil)
+			},
+			checkResponse: func(t *testing.T, resResults []domain.ArticleSearchResult, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "MaxPageSize",
+			args: args{
+				query: domain.ArticleSearchQuery{Query: "test", PageSize: 1000},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().SearchArticles(domain.ArticleSearchQuery{Query: "test", PageSize: pagination.MaxPageSize}).Return(&repoResResults, "", nil)
+			},
+			checkResponse: func(t *testing.T, resResults []domain.ArticleSearchResult, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidData",
+			args: args{
+				query: domain.ArticleSearchQuery{Query: "test"},
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().SearchArticles(gomock.Any()).Return(&[]domain.ArticleSearchResult{}, "", gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, resResults []domain.ArticleSearchResult, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			resResults, _, err := usecase.SearchArticles(tc.args.query)
+			tc.checkResponse(t, resResults, err)
+		})
+	}
+}
+
+func TestListRelatedArticles(t *testing.T) {
+	type args struct {
+		articleID int
+		pageSize  int
+	}
+
+	repoResArticles := []domain.Article{{ID: 2}, {ID: 3}}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, resArticles []domain.Article, err error)
+	}{
+		{
+			name: "OK",
+			args: args{articleID: 1},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{ID: 1}, nil)
+				repo.EXPECT().ListRelatedArticles(1, pagination.DefaultPageSize).Return(&repoResArticles, nil)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResArticles, resArticles)
+			},
+		},
+		{
+			name: "NotFound",
+			args: args{articleID: 1},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListRelatedArticles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "InvalidData",
+			args: args{articleID: 1, pageSize: 5},
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetArticle(1).Return(&domain.Article{ID: 1}, nil)
+				repo.EXPECT().ListRelatedArticles(1, 5).Return(&[]domain.Article{}, gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			resArticles, err := usecase.ListRelatedArticles(tc.args.articleID, tc.args.pageSize)
+			tc.checkResponse(t, resArticles, err)
+		})
+	}
+}
+
+func TestRefreshArticleScores(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().RefreshArticleScores(trendingGravity).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidData",
+			buildStubs: func(repo *mock.MockIArticleRepository) {
+				repo.EXPECT().RefreshArticleScores(gomock.Any()).Return(gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.Error(t, err)
@@ -330,9 +706,164 @@ func TestDeleteArticle(t *testing.T) {
 			repo := mock.NewMockIArticleRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewArticleUsecase(repo)
-			err := usecase.DeleteArticle(tc.args.id)
+			usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+			err := usecase.RefreshArticleScores()
 			tc.checkResponse(t, err)
 		})
 	}
 }
+
+func TestBackfillNormalizedUrls(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	batch := []domain.Article{}
+	for i := 1; i <= normalizedUrlBackfillBatch; i++ {
+		batch = append(batch, domain.Article{ID: uint(i), Url: "https://example.com/ok", NormalizedUrl: "https://example.com/ok"})
+	}
+	batch[0] = domain.Article{ID: 1, Url: "HTTPS://Example.com:443/posts/?utm_source=rss&b=2&a=1#top", NormalizedUrl: "HTTPS://Example.com:443/posts/?utm_source=rss&b=2&a=1"}
+	batch[1] = domain.Article{ID: 2, Url: "not a url", NormalizedUrl: "not a url"}
+
+	repo := mock.NewMockIArticleRepository(mockCtrl)
+	gomock.InOrder(
+		repo.EXPECT().ListArticleUrls(0, normalizedUrlBackfillBatch).Return(&batch, nil),
+		repo.EXPECT().UpdateArticleNormalizedUrl(1, "https://example.com/posts?a=1&b=2").Return(nil),
+		repo.EXPECT().ListArticleUrls(normalizedUrlBackfillBatch, normalizedUrlBackfillBatch).Return(&[]domain.Article{{ID: 501, Url: "http://Example.com/", NormalizedUrl: "http://Example.com"}}, nil),
+		repo.EXPECT().UpdateArticleNormalizedUrl(501, "http://example.com").Return(nil),
+	)
+
+	usecase := NewArticleUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl))
+	err := usecase.BackfillNormalizedUrls()
+	assert.NoError(t, err)
+}
+
+func TestRevertArticle(t *testing.T) {
+	type args struct {
+		userID    int
+		articleID int
+		revision  int
+		version   int
+	}
+
+	submittedByUserID := uint(1)
+	repoResArticle := domain.Article{ID: 1, SubmittedByUserID: &submittedByUserID}
+	repoResRevision := domain.ArticleRevision{ArticleID: 1, Revision: 1, Title: "old_title", Url: "https://example.com/old"}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository)
+		checkResponse func(t *testing.T, resArticle domain.Article, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				userID:    1,
+				articleID: 1,
+				revision:  1,
+				version:   3,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&repoResRevision, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), uint(1)).DoAndReturn(func(article *domain.Article, columns []string, editorUserID uint) error {
+					assert.Equal(t, repoResRevision.Title, article.Title)
+					assert.Equal(t, "example.com", article.Site)
+					assert.Equal(t, 3, article.Version)
+					assert.Contains(t, columns, "body")
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResRevision.Title, resArticle.Title)
+			},
+		},
+		{
+			name: "VersionConflict",
+			args: args{
+				userID:    1,
+				articleID: 1,
+				revision:  1,
+				version:   2,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&repoResRevision, nil)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), uint(1)).Return(domain.ErrVersionConflict)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, domain.ErrVersionConflict)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				userID:    2,
+				articleID: 1,
+				revision:  1,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				revisionRepo.EXPECT().GetArticleRevision(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "RevisionNotFound",
+			args: args{
+				userID:    1,
+				articleID: 1,
+				revision:  5,
+				version:   3,
+			},
+			buildStubs: func(repo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, revisionRepo *mock.MockIArticleRevisionRepository) {
+				repo.EXPECT().GetArticle(1).Return(&repoResArticle, nil)
+				revisionRepo.EXPECT().GetArticleRevision(1, 5).Return(&domain.ArticleRevision{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().UpdateArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticle domain.Article, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIArticleRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo, revisionRepo)
+
+			usecase := NewArticleUsecase(repo, userRepo, revisionRepo)
+			res, err := usecase.RevertArticle(tc.args.userID, tc.args.articleID, tc.args.revision, tc.args.version)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestDiffArticleRevisions(t *testing.T) {
+	from := domain.ArticleRevision{ArticleID: 1, Revision: 1, Title: "old_title", Url: "https://example.com", Tags: []string{"go"}}
+	to := domain.ArticleRevision{ArticleID: 1, Revision: 2, Title: "new_title", Url: "https://example.com", Tags: []string{"go", "grpc"}}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+	revisionRepo.EXPECT().GetArticleRevision(1, 1).Return(&from, nil)
+	revisionRepo.EXPECT().GetArticleRevision(1, 2).Return(&to, nil)
+
+	usecase := NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), mock.NewMockIUserRepository(mockCtrl), revisionRepo)
+	changes, err := usecase.DiffArticleRevisions(1, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ArticleFieldChange{
+		{Field: "title", From: "old_title", To: "new_title"},
+		{Field: "tags", From: "go", To: "go,grpc"},
+	}, changes)
+}
@@ -22,13 +22,14 @@ type IAuthUsecase interface {
 	Signin(email, password string) (accessToken, refreshToken string, accessTokenExpiresIn, refreshTokenExpiresIn int, err error)
 	Signout(userID int) error
 	RefreshToken(refreshToken string) (accessToken string, accessTokenExpiresIn int, err error)
-	GetSigninUser(userID int) (domain.User, error)
+	GetSigninUser(userID int) (user domain.User, unreadBookmarkCount int, err error)
 	GetGoogleLoginURL() string
 	GoogleLoginCallback(state, code string) (accessToken, refreshToken string, accessTokenExpiresIn, refreshTokenExpiresIn int, err error)
 }
 
 type authUsecase struct {
 	repo                     repository.IUserRepository
+	bookmarkRepo             repository.IBookmarkRepository
 	jwtAccessTokenManager    jwt.JwtManager
 	jwtRefreshTokenManager   jwt.JwtManager
 	redisAccessTokenManager  redis.RedisManager
@@ -38,8 +39,8 @@ type authUsecase struct {
 	presignupMailManager     mail.PresignupMailManager
 }
 
-func NewAuthUsecase(repo repository.IUserRepository, jwtAccessTokenManager jwt.JwtManager, jwtRefreshTokenManager jwt.JwtManager, redisAccessTokenManager redis.RedisManager, redisRefreshTokenManager redis.RedisManager, googleManager oauth.GoogleManager, presignupRedisManager redis.RedisManager, presignupMailManager mail.PresignupMailManager) IAuthUsecase {
-	return &authUsecase{repo, jwtAccessTokenManager, jwtRefreshTokenManager, redisAccessTokenManager, redisRefreshTokenManager, googleManager, presignupRedisManager, presignupMailManager}
+func NewAuthUsecase(repo repository.IUserRepository, bookmarkRepo repository.IBookmarkRepository, jwtAccessTokenManager jwt.JwtManager, jwtRefreshTokenManager jwt.JwtManager, redisAccessTokenManager redis.RedisManager, redisRefreshTokenManager redis.RedisManager, googleManager oauth.GoogleManager, presignupRedisManager redis.RedisManager, presignupMailManager mail.PresignupMailManager) IAuthUsecase {
+	return &authUsecase{repo, bookmarkRepo, jwtAccessTokenManager, jwtRefreshTokenManager, redisAccessTokenManager, redisRefreshTokenManager, googleManager, presignupRedisManager, presignupMailManager}
 }
 
 func (usecase *authUsecase) PreSignup(user domain.User) error {
@@ -153,12 +154,16 @@ func (usecase *authUsecase) RefreshToken(refreshToken string) (accessToken strin
 	return accessToken, accessTokenExpiresIn, nil
 }
 
-func (usecase *authUsecase) GetSigninUser(userID int) (domain.User, error) {
-	user, err := usecase.repo.GetUser(userID)
+func (usecase *authUsecase) GetSigninUser(userID int) (user domain.User, unreadBookmarkCount int, err error) {
+	u, err := usecase.repo.GetUser(userID)
 	if err != nil {
-		return domain.User{}, err
+		return domain.User{}, 0, err
 	}
-	return *user, nil
+	unreadBookmarkCount, err = usecase.bookmarkRepo.GetUnreadBookmarkCount(userID)
+	if err != nil {
+		return domain.User{}, 0, err
+	}
+	return *u, unreadBookmarkCount, nil
 }
 
 func (usecase *authUsecase) GetGoogleLoginURL() string {
@@ -189,7 +194,7 @@ func (usecase *authUsecase) GoogleLoginCallback(state, code string) (accessToken
 		// if exist and google id is empty, update google id
 	} else if user.GoogleID == "" {
 		user.GoogleID = userInfo.ID
-		if err := usecase.repo.UpdateUser(user); err != nil {
+		if err := usecase.repo.UpdateUser(user, []string{"google_id"}); err != nil {
 			return "", "", 0, 0, fmt.Errorf("failed to update user: %v", err)
 		}
 	}
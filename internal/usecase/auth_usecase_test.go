@@ -96,7 +96,7 @@ func TestPreSignup(t *testing.T) {
 			if err != nil {
 				t.Fatalf("failed to create presignup mail manager: %v", err)
 			}
-			usecase := NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 			err = usecase.PreSignup(tc.args.user)
 			tc.checkResponse(t, err)
 		})
@@ -198,7 +198,7 @@ func TestSignin(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 			accessToken, refreshToken, accessTokenExpiresIn, refreshTokenExpiresIn, err := usecase.Signin(tc.args.email, tc.args.password)
 			tc.checkResponse(t, accessToken, refreshToken, accessTokenExpiresIn, refreshTokenExpiresIn, err)
 		})
@@ -244,7 +244,7 @@ func TestSignout(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 			err := usecase.Signout(tc.args.userID)
 			tc.checkResponse(t, err)
 		})
@@ -296,7 +296,7 @@ func TestRefreshToken(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			usecase := NewAuthUsecase(repo, nil, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
 			accessToken, accessTokenExpiresIn, err := usecase.RefreshToken(tc.args.refreshToken)
 			tc.checkResponse(t, accessToken, accessTokenExpiresIn, err)
 		})
@@ -320,19 +320,34 @@ func TestGetSigninUser(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIUserRepository)
-		checkResponse func(t *testing.T, user domain.User, err error)
+		buildStubs    func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, user domain.User, unreadBookmarkCount int, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
 				userID: 1,
 			},
-			buildStubs: func(repo *mock.MockIUserRepository) {
+			buildStubs: func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository) {
 				repo.EXPECT().GetUser(gomock.Any()).Return(&repoResUser, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(3, nil)
 			},
-			checkResponse: func(t *testing.T, user domain.User, err error) {
+			checkResponse: func(t *testing.T, user domain.User, unreadBookmarkCount int, err error) {
 				assert.NoError(t, err)
+				assert.Equal(t, 3, unreadBookmarkCount)
+			},
+		},
+		{
+			name: "FailedToCountUnreadBookmarks",
+			args: args{
+				userID: 1,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetUser(gomock.Any()).Return(&repoResUser, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(0, gorm.ErrInvalidDB)
+			},
+			checkResponse: func(t *testing.T, user domain.User, unreadBookmarkCount int, err error) {
+				assert.Error(t, err)
 			},
 		},
 	}
@@ -343,7 +358,8 @@ func TestGetSigninUser(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIUserRepository(mockCtrl)
-			tc.buildStubs(repo)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo, bookmarkRepo)
 
 			jwtAccessTokenManager := jwt.NewJwtManager("issuer", "secret", time.Duration(time.Hour*1))
 			jwtRefreshTokenManager := jwt.NewJwtManager("issuer", "secret", time.Duration(time.Hour*24*30))
@@ -352,9 +368,9 @@ func TestGetSigninUser(t *testing.T) {
 			googleManager := oauth.NewGoogleManager("state", "clientID", "clientSecret", "redirectURL")
 			preSignupRedisManager := mock.NewRedisMock(t, 2, time.Duration(time.Hour*1))
 			preSignupMailManager, _ := mail.NewPresignupMailManager("localhost", 2525, "test@example.com", "", "Test Prsignup", "../../pkg/mail/presignup.tmpl", "http://localhost:8080/v1/signup?token=")
-			usecase := NewAuthUsecase(repo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
-			user, err := usecase.GetSigninUser(tc.args.userID)
-			tc.checkResponse(t, user, err)
+			usecase := NewAuthUsecase(repo, bookmarkRepo, *jwtAccessTokenManager, *jwtRefreshTokenManager, *redisAccessTokenManager, *redisRefreshTokenManager, *googleManager, *preSignupRedisManager, *preSignupMailManager)
+			user, unreadBookmarkCount, err := usecase.GetSigninUser(tc.args.userID)
+			tc.checkResponse(t, user, unreadBookmarkCount, err)
 		})
 	}
 }
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/bookmarkfile"
+	"github.com/loak155/techbranch-backend/pkg/urlnorm"
+	"github.com/loak155/techbranch-backend/pkg/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	bookmarkImportBatchSize = 50
+	maxCollectionNameLen    = 100
+)
+
+type IBookmarkImportUsecase interface {
+	ImportBookmarks(userID int, format string, data []byte) (domain.BookmarkImport, error)
+	GetBookmarkImport(userID, id int) (domain.BookmarkImport, error)
+	ProcessPendingBookmarkImports() error
+}
+
+type bookmarkImportUsecase struct {
+	repo           repository.IBookmarkImportRepository
+	bookmarkRepo   repository.IBookmarkRepository
+	collectionRepo repository.ICollectionRepository
+	articleUsecase IArticleUsecase
+	syncLimit      int
+	staleTimeout   time.Duration
+}
+
+func NewBookmarkImportUsecase(repo repository.IBookmarkImportRepository, bookmarkRepo repository.IBookmarkRepository, collectionRepo repository.ICollectionRepository, articleUsecase IArticleUsecase, syncLimit int, staleTimeout time.Duration) IBookmarkImportUsecase {
+	return &bookmarkImportUsecase{repo, bookmarkRepo, collectionRepo, articleUsecase, syncLimit, staleTimeout}
+}
+
+func (usecase *bookmarkImportUsecase) ImportBookmarks(userID int, format string, data []byte) (domain.BookmarkImport, error) {
+	items, err := bookmarkfile.Parse(format, data)
+	if err != nil {
+		return domain.BookmarkImport{}, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+	if len(items) == 0 {
+		return domain.BookmarkImport{}, fmt.Errorf("%w: no bookmarks found", domain.ErrInvalidArgument)
+	}
+
+	bookmarkImport := domain.BookmarkImport{
+		UserID:     uint(userID),
+		Format:     format,
+		Status:     domain.BookmarkImportStatusPending,
+		Data:       string(data),
+		TotalCount: len(items),
+	}
+	if len(items) > usecase.syncLimit {
+		if err := usecase.repo.CreateBookmarkImport(&bookmarkImport); err != nil {
+			return domain.BookmarkImport{}, err
+		}
+		return bookmarkImport, nil
+	}
+
+	now := time.Now()
+	bookmarkImport.Status = domain.BookmarkImportStatusRunning
+	bookmarkImport.Data = ""
+	bookmarkImport.StartedAt = &now
+	if err := usecase.repo.CreateBookmarkImport(&bookmarkImport); err != nil {
+		return domain.BookmarkImport{}, err
+	}
+	if err := usecase.runBookmarkImport(&bookmarkImport, items); err != nil {
+		if err := usecase.failBookmarkImport(&bookmarkImport, err); err != nil {
+			return domain.BookmarkImport{}, err
+		}
+		return domain.BookmarkImport{}, err
+	}
+	return bookmarkImport, nil
+}
+
+func (usecase *bookmarkImportUsecase) GetBookmarkImport(userID, id int) (domain.BookmarkImport, error) {
+	bookmarkImport, err := usecase.repo.GetBookmarkImport(id)
+	if err != nil {
+		return domain.BookmarkImport{}, err
+	}
+	if int(bookmarkImport.UserID) != userID {
+		return domain.BookmarkImport{}, domain.ErrPermissionDenied
+	}
+	return *bookmarkImport, nil
+}
+
+func (usecase *bookmarkImportUsecase) ProcessPendingBookmarkImports() error {
+	for {
+		bookmarkImport, err := usecase.repo.ClaimPendingBookmarkImport(time.Now().Add(-usecase.staleTimeout))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		items, err := bookmarkfile.Parse(bookmarkImport.Format, []byte(bookmarkImport.Data))
+		if err == nil && len(items) < bookmarkImport.TotalCount {
+			err = errors.New("import was interrupted")
+		}
+		if err == nil {
+			err = usecase.runBookmarkImport(bookmarkImport, items)
+		}
+		if err != nil {
+			if err := usecase.failBookmarkImport(bookmarkImport, err); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (usecase *bookmarkImportUsecase) failBookmarkImport(bookmarkImport *domain.BookmarkImport, cause error) error {
+	now := time.Now()
+	bookmarkImport.Status = domain.BookmarkImportStatusFailed
+	bookmarkImport.Data = ""
+	bookmarkImport.LastError = cause.Error()
+	bookmarkImport.FinishedAt = &now
+	return usecase.repo.UpdateBookmarkImport(bookmarkImport, nil)
+}
+
+func (usecase *bookmarkImportUsecase) runBookmarkImport(bookmarkImport *domain.BookmarkImport, items []bookmarkfile.Item) error {
+	collections := map[string]*uint{}
+	importErrors := []domain.BookmarkImportError{}
+	for i := bookmarkImport.ProcessedCount; i < len(items); i++ {
+		item := items[i]
+		created, err := usecase.importItem(int(bookmarkImport.UserID), item, collections)
+		switch {
+		case err != nil:
+			importError := domain.BookmarkImportError{ImportID: bookmarkImport.ID, ItemIndex: i, Url: item.Url, Title: item.Title, Reason: err.Error()}
+			importErrors = append(importErrors, importError)
+			bookmarkImport.Errors = append(bookmarkImport.Errors, importError)
+			bookmarkImport.FailedCount++
+		case created:
+			bookmarkImport.CreatedCount++
+		default:
+			bookmarkImport.ExistingCount++
+		}
+		bookmarkImport.ProcessedCount++
+
+		if bookmarkImport.ProcessedCount%bookmarkImportBatchSize == 0 && bookmarkImport.ProcessedCount < len(items) {
+			if err := usecase.repo.UpdateBookmarkImport(bookmarkImport, importErrors); err != nil {
+				return err
+			}
+			importErrors = []domain.BookmarkImportError{}
+		}
+	}
+
+	now := time.Now()
+	bookmarkImport.Status = domain.BookmarkImportStatusCompleted
+	bookmarkImport.Data = ""
+	bookmarkImport.FinishedAt = &now
+	return usecase.repo.UpdateBookmarkImport(bookmarkImport, importErrors)
+}
+
+func (usecase *bookmarkImportUsecase) importItem(userID int, item bookmarkfile.Item, collections map[string]*uint) (bool, error) {
+	normalizedUrl, err := urlnorm.Normalize(item.Url)
+	if err != nil {
+		return false, errors.New("invalid url")
+	}
+
+	article, err := usecase.articleUsecase.GetArticleByUrl(normalizedUrl)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		title := item.Title
+		if title == "" {
+			title = normalizedUrl
+		}
+		submittedByUserID := uint(userID)
+		article, err = usecase.articleUsecase.CreateArticle(domain.Article{Title: title, Url: normalizedUrl, SubmittedByUserID: &submittedByUserID})
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create article: %v", err)
+	}
+
+	collectionID, err := usecase.collectionID(userID, item.Folder, collections)
+	if err != nil {
+		return false, fmt.Errorf("failed to create collection: %v", err)
+	}
+
+	bookmark := domain.Bookmark{
+		UserID:       uint(userID),
+		ArticleID:    article.ID,
+		CollectionID: collectionID,
+		Note:         item.Note,
+		Tags:         feedItemTags(item.Tags),
+		CreatedAt:    item.AddedAt,
+	}
+	if item.Read {
+		readAt := time.Now()
+		bookmark.ReadAt = &readAt
+	}
+	prepareBookmark(&bookmark)
+	err = usecase.bookmarkRepo.CreateBookmark(&bookmark)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create bookmark: %v", err)
+	}
+	return true, nil
+}
+
+func (usecase *bookmarkImportUsecase) collectionID(userID int, folder string, collections map[string]*uint) (*uint, error) {
+	if runes := []rune(folder); len(runes) > maxCollectionNameLen {
+		folder = string(runes[:maxCollectionNameLen])
+	}
+	if folder == "" {
+		return nil, nil
+	}
+	if id, ok := collections[folder]; ok {
+		return id, nil
+	}
+
+	collection, err := usecase.collectionRepo.GetCollectionByUserIDAndName(userID, folder)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		collection = &domain.Collection{UserID: uint(userID), Name: folder, Visibility: domain.CollectionVisibilityPrivate, ShareToken: uuid.NewUUID()}
+		err = usecase.collectionRepo.CreateCollection(collection)
+	}
+	if err != nil {
+		return nil, err
+	}
+	collections[folder] = &collection.ID
+	return &collection.ID, nil
+}
@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+const testBookmarkHTML = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3>Tech</H3>
+    <DL><p>
+        <DT><A HREF="https://example.com/posts/1?utm_source=x" ADD_DATE="1700000000" TAGS="go,web">First Post</A>
+        <DD>must read
+        <DT><A HREF="https://example.com/posts/2">Second Post</A>
+    </DL><p>
+    <DT><A HREF="mailto:someone@example.com">Mail</A>
+</DL><p>`
+
+func TestImportBookmarks(t *testing.T) {
+	testCases := []struct {
+		name          string
+		format        string
+		data          string
+		syncLimit     int
+		buildStubs    func(repo *mock.MockIBookmarkImportRepository, articleRepo *mock.MockIArticleRepository, bookmarkRepo *mock.MockIBookmarkRepository, collectionRepo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, bookmarkImport domain.BookmarkImport, err error)
+	}{
+		{
+			name:      "OK",
+			format:    "netscape",
+			data:      testBookmarkHTML,
+			syncLimit: 100,
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository, articleRepo *mock.MockIArticleRepository, bookmarkRepo *mock.MockIBookmarkRepository, collectionRepo *mock.MockICollectionRepository) {
+				repo.EXPECT().CreateBookmarkImport(gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport) error {
+					assert.Equal(t, domain.BookmarkImportStatusRunning, bookmarkImport.Status)
+					assert.Empty(t, bookmarkImport.Data)
+					bookmarkImport.ID = 1
+					return nil
+				})
+				articleRepo.EXPECT().GetArticleByNormalizedUrl("https://example.com/posts/1").Return(&domain.Article{}, gorm.ErrRecordNotFound)
+				articleRepo.EXPECT().CreateArticle(gomock.Any()).DoAndReturn(func(article *domain.Article) error {
+					assert.Equal(t, "First Post", article.Title)
+					assert.Equal(t, uint(1), *article.SubmittedByUserID)
+					article.ID = 10
+					return nil
+				})
+				articleRepo.EXPECT().GetArticleByNormalizedUrl("https://example.com/posts/2").Return(&domain.Article{ID: 20}, nil)
+				collectionRepo.EXPECT().GetCollectionByUserIDAndName(1, "Tech").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				collectionRepo.EXPECT().CreateCollection(gomock.Any()).DoAndReturn(func(collection *domain.Collection) error {
+					collection.ID = 5
+					return nil
+				})
+				gomock.InOrder(
+					bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).DoAndReturn(func(bookmark *domain.Bookmark) error {
+						assert.Equal(t, uint(10), bookmark.ArticleID)
+						assert.Equal(t, uint(5), *bookmark.CollectionID)
+						assert.Equal(t, "must read", bookmark.Note)
+						assert.Equal(t, []string{"go", "web"}, []string(bookmark.Tags))
+						assert.Equal(t, int64(1700000000), bookmark.CreatedAt.Unix())
+						return nil
+					}),
+					bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists),
+				)
+				repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+					assert.Equal(t, []domain.BookmarkImportError{{ImportID: 1, ItemIndex: 2, Url: "mailto:someone@example.com", Title: "Mail", Reason: "invalid url"}}, importErrors)
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, bookmarkImport domain.BookmarkImport, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.BookmarkImportStatusCompleted, bookmarkImport.Status)
+				assert.Equal(t, 3, bookmarkImport.TotalCount)
+				assert.Equal(t, 3, bookmarkImport.ProcessedCount)
+				assert.Equal(t, 1, bookmarkImport.CreatedCount)
+				assert.Equal(t, 1, bookmarkImport.ExistingCount)
+				assert.Equal(t, 1, bookmarkImport.FailedCount)
+				assert.Len(t, bookmarkImport.Errors, 1)
+				assert.NotNil(t, bookmarkImport.FinishedAt)
+			},
+		},
+		{
+			name:      "Async",
+			format:    "pocket",
+			data:      "title,url,time_added,tags,status\nFirst,https://example.com/1,1700000000,go|web,unread\nSecond,https://example.com/2,1700000000,,archive\n",
+			syncLimit: 1,
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository, articleRepo *mock.MockIArticleRepository, bookmarkRepo *mock.MockIBookmarkRepository, collectionRepo *mock.MockICollectionRepository) {
+				repo.EXPECT().CreateBookmarkImport(gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport) error {
+					assert.Equal(t, domain.BookmarkImportStatusPending, bookmarkImport.Status)
+					assert.NotEmpty(t, bookmarkImport.Data)
+					return nil
+				})
+				bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).Times(0)
+				repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, bookmarkImport domain.BookmarkImport, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.BookmarkImportStatusPending, bookmarkImport.Status)
+				assert.Equal(t, 2, bookmarkImport.TotalCount)
+			},
+		},
+		{
+			name:      "SyncFailed",
+			format:    "netscape",
+			data:      testBookmarkHTML,
+			syncLimit: 100,
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository, articleRepo *mock.MockIArticleRepository, bookmarkRepo *mock.MockIBookmarkRepository, collectionRepo *mock.MockICollectionRepository) {
+				repo.EXPECT().CreateBookmarkImport(gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport) error {
+					bookmarkImport.ID = 1
+					return nil
+				})
+				articleRepo.EXPECT().GetArticleByNormalizedUrl(gomock.Any()).Return(&domain.Article{ID: 10}, nil).Times(2)
+				collectionRepo.EXPECT().GetCollectionByUserIDAndName(1, "Tech").Return(&domain.Collection{ID: 5}, nil)
+				bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).Return(nil).Times(2)
+				gomock.InOrder(
+					repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).Return(errors.New("connection reset")),
+					repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+						assert.Equal(t, domain.BookmarkImportStatusFailed, bookmarkImport.Status)
+						assert.Equal(t, "connection reset", bookmarkImport.LastError)
+						assert.NotNil(t, bookmarkImport.FinishedAt)
+						return nil
+					}),
+				)
+			},
+			checkResponse: func(t *testing.T, bookmarkImport domain.BookmarkImport, err error) {
+				assert.EqualError(t, err, "connection reset")
+			},
+		},
+		{
+			name:      "InvalidArgument",
+			format:    "raindrop",
+			data:      "title,url\n",
+			syncLimit: 100,
+			buildStubs: func(repo *mock.MockIBookmarkImportRepository, articleRepo *mock.MockIArticleRepository, bookmarkRepo *mock.MockIBookmarkRepository, collectionRepo *mock.MockICollectionRepository) {
+				repo.EXPECT().CreateBookmarkImport(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, bookmarkImport domain.BookmarkImport, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			collectionRepo := mock.NewMockICollectionRepository(mockCtrl)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, articleRepo, bookmarkRepo, collectionRepo)
+
+			usecase := NewBookmarkImportUsecase(repo, bookmarkRepo, collectionRepo, NewArticleUsecase(articleRepo, mock.NewMockIUserRepository(mockCtrl), revisionRepo), tc.syncLimit, time.Hour)
+			bookmarkImport, err := usecase.ImportBookmarks(1, tc.format, []byte(tc.data))
+			tc.checkResponse(t, bookmarkImport, err)
+		})
+	}
+}
+
+func TestGetBookmarkImport(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+	repo.EXPECT().GetBookmarkImport(1).Return(&domain.BookmarkImport{ID: 1, UserID: 1}, nil).Times(2)
+
+	usecase := NewBookmarkImportUsecase(repo, nil, nil, nil, 100, time.Hour)
+	bookmarkImport, err := usecase.GetBookmarkImport(1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), bookmarkImport.ID)
+
+	_, err = usecase.GetBookmarkImport(2, 1)
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestProcessPendingBookmarkImports(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+	articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+	bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+	gomock.InOrder(
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).Return(&domain.BookmarkImport{
+			ID:     1,
+			UserID: 1,
+			Format: "raindrop",
+			Status: domain.BookmarkImportStatusRunning,
+			Data:   "id,title,note,excerpt,url,folder,tags,created\n1,First,,,https://example.com/1,,go,2023-11-14T22:13:20Z\n",
+		}, nil),
+		repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+			assert.Equal(t, domain.BookmarkImportStatusCompleted, bookmarkImport.Status)
+			assert.Equal(t, 1, bookmarkImport.ExistingCount)
+			assert.Empty(t, bookmarkImport.Data)
+			assert.Empty(t, importErrors)
+			return nil
+		}),
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).Return(&domain.BookmarkImport{}, gorm.ErrRecordNotFound),
+	)
+	articleRepo.EXPECT().GetArticleByNormalizedUrl("https://example.com/1").Return(&domain.Article{ID: 1}, nil)
+	bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists)
+
+	usecase := NewBookmarkImportUsecase(repo, bookmarkRepo, nil, NewArticleUsecase(articleRepo, nil, nil), 100, time.Hour)
+	err := usecase.ProcessPendingBookmarkImports()
+	assert.NoError(t, err)
+}
+
+func TestProcessPendingBookmarkImportsFailed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+	gomock.InOrder(
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).Return(&domain.BookmarkImport{ID: 1, UserID: 1, Format: "raindrop", Status: domain.BookmarkImportStatusRunning}, nil),
+		repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+			assert.Equal(t, domain.BookmarkImportStatusFailed, bookmarkImport.Status)
+			assert.Equal(t, "missing csv header", bookmarkImport.LastError)
+			assert.NotNil(t, bookmarkImport.FinishedAt)
+			return nil
+		}),
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).Return(&domain.BookmarkImport{}, errors.New("connection refused")),
+	)
+
+	usecase := NewBookmarkImportUsecase(repo, nil, nil, nil, 100, time.Hour)
+	err := usecase.ProcessPendingBookmarkImports()
+	assert.Error(t, err)
+}
+
+func TestProcessPendingBookmarkImportsResumesStale(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkImportRepository(mockCtrl)
+	articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+	bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+	gomock.InOrder(
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).DoAndReturn(func(staleBefore time.Time) (*domain.BookmarkImport, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), staleBefore, time.Minute)
+			return &domain.BookmarkImport{
+				ID:             1,
+				UserID:         1,
+				Format:         "raindrop",
+				Status:         domain.BookmarkImportStatusRunning,
+				Data:           "id,title,note,excerpt,url,folder,tags,created\n1,First,,,https://example.com/1,,,\n2,Second,,,https://example.com/2,,,\n",
+				TotalCount:     2,
+				ProcessedCount: 1,
+				CreatedCount:   1,
+			}, nil
+		}),
+		repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+			assert.Equal(t, domain.BookmarkImportStatusCompleted, bookmarkImport.Status)
+			assert.Equal(t, 2, bookmarkImport.ProcessedCount)
+			assert.Equal(t, 2, bookmarkImport.CreatedCount)
+			return nil
+		}),
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).DoAndReturn(func(staleBefore time.Time) (*domain.BookmarkImport, error) {
+			return &domain.BookmarkImport{ID: 2, UserID: 1, Format: "netscape", Status: domain.BookmarkImportStatusRunning, TotalCount: 2, ProcessedCount: 1}, nil
+		}),
+		repo.EXPECT().UpdateBookmarkImport(gomock.Any(), gomock.Any()).DoAndReturn(func(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+			assert.Equal(t, domain.BookmarkImportStatusFailed, bookmarkImport.Status)
+			assert.Equal(t, "import was interrupted", bookmarkImport.LastError)
+			return nil
+		}),
+		repo.EXPECT().ClaimPendingBookmarkImport(gomock.Any()).Return(&domain.BookmarkImport{}, gorm.ErrRecordNotFound),
+	)
+	articleRepo.EXPECT().GetArticleByNormalizedUrl("https://example.com/2").Return(&domain.Article{ID: 2}, nil)
+	bookmarkRepo.EXPECT().CreateBookmark(gomock.Any()).Return(nil)
+
+	usecase := NewBookmarkImportUsecase(repo, bookmarkRepo, nil, NewArticleUsecase(articleRepo, nil, nil), 100, time.Hour)
+	err := usecase.ProcessPendingBookmarkImports()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/mail"
+)
+
+const (
+	dueBookmarkReminderLimit     = 100
+	bookmarkDigestRecipientLimit = 100
+	deliveryRetryInterval        = 5 * time.Minute
+	maxDeliveryRetryInterval     = 24 * time.Hour
+)
+
+type IBookmarkReminderUsecase interface {
+	SendDueBookmarkReminders() error
+	SendBookmarkDigests() error
+}
+
+type bookmarkReminderUsecase struct {
+	bookmarkRepo                repository.IBookmarkRepository
+	userRepo                    repository.IUserRepository
+	bookmarkReminderMailManager mail.BookmarkReminderMailManager
+	bookmarkDigestMailManager   mail.BookmarkDigestMailManager
+	digestInterval              time.Duration
+	digestLimit                 int
+}
+
+func NewBookmarkReminderUsecase(bookmarkRepo repository.IBookmarkRepository, userRepo repository.IUserRepository, bookmarkReminderMailManager mail.BookmarkReminderMailManager, bookmarkDigestMailManager mail.BookmarkDigestMailManager, digestInterval time.Duration, digestLimit int) IBookmarkReminderUsecase {
+	return &bookmarkReminderUsecase{bookmarkRepo, userRepo, bookmarkReminderMailManager, bookmarkDigestMailManager, digestInterval, digestLimit}
+}
+
+func (usecase *bookmarkReminderUsecase) SendDueBookmarkReminders() error {
+	now := time.Now()
+	reminders, err := usecase.bookmarkRepo.ListDueBookmarkReminders(now, dueBookmarkReminderLimit)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, reminder := range *reminders {
+		if err := usecase.sendBookmarkReminder(reminder); err != nil {
+			errorCount := reminder.ReminderErrorCount + 1
+			err = errors.Join(err, usecase.bookmarkRepo.MarkBookmarkReminderFailed(int(reminder.ID), errorCount, now.Add(deliveryRetryBackoff(errorCount))))
+			errs = append(errs, fmt.Errorf("bookmark %d: %w", reminder.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (usecase *bookmarkReminderUsecase) sendBookmarkReminder(reminder domain.BookmarkReminder) error {
+	if err := usecase.bookmarkReminderMailManager.SendBookmarkReminderMail([]string{reminder.Email}, reminder.Username, reminder.Title, reminder.Url, reminder.Note); err != nil {
+		return err
+	}
+	return usecase.bookmarkRepo.MarkBookmarkReminded(int(reminder.ID), time.Now())
+}
+
+func (usecase *bookmarkReminderUsecase) SendBookmarkDigests() error {
+	now := time.Now()
+	users, err := usecase.userRepo.ListDigestRecipients(now, now.Add(-usecase.digestInterval), bookmarkDigestRecipientLimit)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, user := range *users {
+		if err := usecase.sendBookmarkDigest(user); err != nil {
+			errorCount := user.DigestErrorCount + 1
+			err = errors.Join(err, usecase.userRepo.UpdateDigestFailed(int(user.ID), errorCount, now.Add(deliveryRetryBackoff(errorCount))))
+			errs = append(errs, fmt.Errorf("user %d: %w", user.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (usecase *bookmarkReminderUsecase) sendBookmarkDigest(user domain.User) error {
+	bookmarks, err := usecase.bookmarkRepo.ListBookmarkDigestItems(int(user.ID), usecase.digestLimit)
+	if err != nil {
+		return err
+	}
+	unreadCount, err := usecase.bookmarkRepo.GetUnreadBookmarkCount(int(user.ID))
+	if err != nil {
+		return err
+	}
+	if len(*bookmarks) > 0 {
+		items := make([]mail.BookmarkDigestItem, 0, len(*bookmarks))
+		for _, bookmark := range *bookmarks {
+			items = append(items, mail.BookmarkDigestItem{Title: bookmark.Title, URL: bookmark.Url})
+		}
+		if err := usecase.bookmarkDigestMailManager.SendBookmarkDigestMail([]string{user.Email}, user.Username, unreadCount, items); err != nil {
+			return err
+		}
+	}
+	return usecase.userRepo.UpdateDigestSentAt(int(user.ID), time.Now())
+}
+
+func deliveryRetryBackoff(errorCount int) time.Duration {
+	backoff := deliveryRetryInterval
+	for i := 1; i < errorCount && backoff < maxDeliveryRetryInterval; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDeliveryRetryInterval {
+		backoff = maxDeliveryRetryInterval
+	}
+	return backoff
+}
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/mail"
+	smtpmock "github.com/mocktools/go-smtp-mock/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestBookmarkReminderUsecase(t *testing.T, bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository, port int) IBookmarkReminderUsecase {
+	bookmarkReminderMailManager, err := mail.NewBookmarkReminderMailManager("localhost", port, "test@example.com", "", "Test Bookmark Reminder", "../../pkg/mail/bookmark_reminder.tmpl")
+	if err != nil {
+		t.Fatalf("failed to create bookmark reminder mail manager: %v", err)
+	}
+	bookmarkDigestMailManager, err := mail.NewBookmarkDigestMailManager("localhost", port, "test@example.com", "", "Test Bookmark Digest", "../../pkg/mail/bookmark_digest.tmpl", "http://localhost:8080/settings")
+	if err != nil {
+		t.Fatalf("failed to create bookmark digest mail manager: %v", err)
+	}
+	return NewBookmarkReminderUsecase(bookmarkRepo, userRepo, *bookmarkReminderMailManager, *bookmarkDigestMailManager, 7*24*time.Hour, 10)
+}
+
+func TestSendDueBookmarkReminders(t *testing.T) {
+	remindAt := time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(bookmarkRepo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository) {
+				bookmarkRepo.EXPECT().ListDueBookmarkReminders(gomock.Any(), dueBookmarkReminderLimit).Return(&[]domain.BookmarkReminder{
+					{Bookmark: domain.Bookmark{ID: 1, UserID: 1, RemindAt: &remindAt}, Title: "title", Url: "https://example.com/1", Username: "test_username", Email: "user1@example.com"},
+					{Bookmark: domain.Bookmark{ID: 2, UserID: 2, RemindAt: &remindAt, Note: "note"}, Title: "<b>title</b>", Url: "https://example.com/2", Username: "test_username2", Email: "user2@example.com"},
+				}, nil)
+				bookmarkRepo.EXPECT().MarkBookmarkReminded(1, gomock.Any()).Return(nil)
+				bookmarkRepo.EXPECT().MarkBookmarkReminded(2, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "SendFailed",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository) {
+				bookmarkRepo.EXPECT().ListDueBookmarkReminders(gomock.Any(), dueBookmarkReminderLimit).Return(&[]domain.BookmarkReminder{
+					{Bookmark: domain.Bookmark{ID: 1, UserID: 1, RemindAt: &remindAt}, Title: "title", Url: "https://example.com/1", Username: "test_username", Email: "blocked@example.com"},
+					{Bookmark: domain.Bookmark{ID: 2, UserID: 2, RemindAt: &remindAt}, Title: "title", Url: "https://example.com/2", Username: "test_username2", Email: "user2@example.com"},
+				}, nil)
+				bookmarkRepo.EXPECT().MarkBookmarkReminded(1, gomock.Any()).Times(0)
+				bookmarkRepo.EXPECT().MarkBookmarkReminderFailed(1, 1, gomock.Any()).DoAndReturn(func(id, errorCount int, retryAt time.Time) error {
+					assert.WithinDuration(t, time.Now().Add(deliveryRetryInterval), retryAt, time.Minute)
+					return nil
+				})
+				bookmarkRepo.EXPECT().MarkBookmarkReminded(2, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Empty",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository) {
+				bookmarkRepo.EXPECT().ListDueBookmarkReminders(gomock.Any(), dueBookmarkReminderLimit).Return(&[]domain.BookmarkReminder{}, nil)
+				bookmarkRepo.EXPECT().MarkBookmarkReminded(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository) {
+				bookmarkRepo.EXPECT().ListDueBookmarkReminders(gomock.Any(), dueBookmarkReminderLimit).Return(&[]domain.BookmarkReminder{}, gorm.ErrInvalidDB)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{BlacklistedRcpttoEmails: []string{"blocked@example.com"}})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer testSMTPServer.Stop()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(bookmarkRepo)
+
+			usecase := newTestBookmarkReminderUsecase(t, bookmarkRepo, mock.NewMockIUserRepository(mockCtrl), testSMTPServer.PortNumber())
+			err := usecase.SendDueBookmarkReminders()
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestSendBookmarkDigests(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().ListDigestRecipients(gomock.Any(), gomock.Any(), bookmarkDigestRecipientLimit).DoAndReturn(func(now, sentBefore time.Time, limit int) (*[]domain.User, error) {
+					assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), sentBefore, time.Minute)
+					return &[]domain.User{{ID: 1, Username: "test_username", Email: "test@example.com", DigestEnabled: true}}, nil
+				})
+				bookmarkRepo.EXPECT().ListBookmarkDigestItems(1, 10).Return(&[]domain.BookmarkDigestItem{
+					{Bookmark: domain.Bookmark{ID: 2}, Title: "second", Url: "https://example.com/2"},
+					{Bookmark: domain.Bookmark{ID: 1}, Title: "first", Url: "https://example.com/1"},
+				}, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(12, nil)
+				userRepo.EXPECT().UpdateDigestSentAt(1, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "SendFailed",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().ListDigestRecipients(gomock.Any(), gomock.Any(), bookmarkDigestRecipientLimit).Return(&[]domain.User{
+					{ID: 1, Username: "blocked", Email: "blocked@example.com", DigestEnabled: true},
+					{ID: 2, Username: "test_username", Email: "test@example.com", DigestEnabled: true},
+				}, nil)
+				bookmarkRepo.EXPECT().ListBookmarkDigestItems(1, 10).Return(&[]domain.BookmarkDigestItem{{Bookmark: domain.Bookmark{ID: 1}, Title: "first", Url: "https://example.com/1"}}, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(1, nil)
+				bookmarkRepo.EXPECT().ListBookmarkDigestItems(2, 10).Return(&[]domain.BookmarkDigestItem{{Bookmark: domain.Bookmark{ID: 2}, Title: "second", Url: "https://example.com/2"}}, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(2).Return(1, nil)
+				userRepo.EXPECT().UpdateDigestSentAt(1, gomock.Any()).Times(0)
+				userRepo.EXPECT().UpdateDigestFailed(1, 1, gomock.Any()).Return(nil)
+				userRepo.EXPECT().UpdateDigestSentAt(2, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "NoItems",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().ListDigestRecipients(gomock.Any(), gomock.Any(), bookmarkDigestRecipientLimit).Return(&[]domain.User{{ID: 1, Email: "test@example.com", DigestEnabled: true}}, nil)
+				bookmarkRepo.EXPECT().ListBookmarkDigestItems(1, 10).Return(&[]domain.BookmarkDigestItem{}, nil)
+				bookmarkRepo.EXPECT().GetUnreadBookmarkCount(1).Return(0, nil)
+				userRepo.EXPECT().UpdateDigestSentAt(1, gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(bookmarkRepo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().ListDigestRecipients(gomock.Any(), gomock.Any(), bookmarkDigestRecipientLimit).Return(&[]domain.User{}, gorm.ErrInvalidDB)
+				userRepo.EXPECT().UpdateDigestSentAt(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{BlacklistedRcpttoEmails: []string{"blocked@example.com"}})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer testSMTPServer.Stop()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(bookmarkRepo, userRepo)
+
+			usecase := newTestBookmarkReminderUsecase(t, bookmarkRepo, userRepo, testSMTPServer.PortNumber())
+			err := usecase.SendBookmarkDigests()
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestSendDueBookmarkRemindersSkipsFailedReminders(t *testing.T) {
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{BlacklistedRcpttoEmails: []string{"blocked@example.com"}})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer testSMTPServer.Stop()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	remindAt := time.Now().Add(-time.Minute)
+	reminders := []domain.BookmarkReminder{}
+	for i := 1; i <= dueBookmarkReminderLimit+1; i++ {
+		email := "blocked@example.com"
+		if i == dueBookmarkReminderLimit+1 {
+			email = "user@example.com"
+		}
+		reminders = append(reminders, domain.BookmarkReminder{Bookmark: domain.Bookmark{ID: uint(i), UserID: uint(i), RemindAt: &remindAt}, Title: "title", Url: "https://example.com", Username: "test_username", Email: email})
+	}
+
+	bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+	bookmarkRepo.EXPECT().ListDueBookmarkReminders(gomock.Any(), dueBookmarkReminderLimit).DoAndReturn(func(now time.Time, limit int) (*[]domain.BookmarkReminder, error) {
+		due := []domain.BookmarkReminder{}
+		for _, reminder := range reminders {
+			if reminder.RemindedAt == nil && (reminder.ReminderRetryAt == nil || !reminder.ReminderRetryAt.After(now)) && len(due) < limit {
+				due = append(due, reminder)
+			}
+		}
+		return &due, nil
+	}).Times(2)
+	bookmarkRepo.EXPECT().MarkBookmarkReminderFailed(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(id, errorCount int, retryAt time.Time) error {
+		reminders[id-1].ReminderErrorCount = errorCount
+		reminders[id-1].ReminderRetryAt = &retryAt
+		return nil
+	}).Times(dueBookmarkReminderLimit)
+	bookmarkRepo.EXPECT().MarkBookmarkReminded(dueBookmarkReminderLimit+1, gomock.Any()).DoAndReturn(func(id int, remindedAt time.Time) error {
+		reminders[id-1].RemindedAt = &remindedAt
+		return nil
+	})
+
+	usecase := newTestBookmarkReminderUsecase(t, bookmarkRepo, mock.NewMockIUserRepository(mockCtrl), testSMTPServer.PortNumber())
+	assert.Error(t, usecase.SendDueBookmarkReminders())
+	assert.NoError(t, usecase.SendDueBookmarkReminders())
+	assert.NotNil(t, reminders[dueBookmarkReminderLimit].RemindedAt)
+}
+
+func TestSendBookmarkDigestsSkipsFailedRecipients(t *testing.T) {
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{BlacklistedRcpttoEmails: []string{"blocked@example.com"}})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer testSMTPServer.Stop()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	users := []domain.User{}
+	for i := 1; i <= bookmarkDigestRecipientLimit+1; i++ {
+		email := "blocked@example.com"
+		if i == bookmarkDigestRecipientLimit+1 {
+			email = "test@example.com"
+		}
+		users = append(users, domain.User{ID: uint(i), Username: "test_username", Email: email, DigestEnabled: true})
+	}
+
+	bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+	userRepo := mock.NewMockIUserRepository(mockCtrl)
+	userRepo.EXPECT().ListDigestRecipients(gomock.Any(), gomock.Any(), bookmarkDigestRecipientLimit).DoAndReturn(func(now, sentBefore time.Time, limit int) (*[]domain.User, error) {
+		due := []domain.User{}
+		for _, user := range users {
+			if user.DigestSentAt == nil && (user.DigestRetryAt == nil || !user.DigestRetryAt.After(now)) && len(due) < limit {
+				due = append(due, user)
+			}
+		}
+		return &due, nil
+	}).Times(2)
+	bookmarkRepo.EXPECT().ListBookmarkDigestItems(gomock.Any(), 10).Return(&[]domain.BookmarkDigestItem{{Bookmark: domain.Bookmark{ID: 1}, Title: "first", Url: "https://example.com/1"}}, nil).AnyTimes()
+	bookmarkRepo.EXPECT().GetUnreadBookmarkCount(gomock.Any()).Return(1, nil).AnyTimes()
+	userRepo.EXPECT().UpdateDigestFailed(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(id, errorCount int, retryAt time.Time) error {
+		users[id-1].DigestErrorCount = errorCount
+		users[id-1].DigestRetryAt = &retryAt
+		return nil
+	}).Times(bookmarkDigestRecipientLimit)
+	userRepo.EXPECT().UpdateDigestSentAt(bookmarkDigestRecipientLimit+1, gomock.Any()).DoAndReturn(func(id int, sentAt time.Time) error {
+		users[id-1].DigestSentAt = &sentAt
+		return nil
+	})
+
+	usecase := newTestBookmarkReminderUsecase(t, bookmarkRepo, userRepo, testSMTPServer.PortNumber())
+	assert.Error(t, usecase.SendBookmarkDigests())
+	assert.NoError(t, usecase.SendBookmarkDigests())
+	assert.NotNil(t, users[bookmarkDigestRecipientLimit].DigestSentAt)
+}
@@ -1,30 +1,61 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/bookmarkfile"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 )
 
 type IBookmarkUsecase interface {
-	CreateBookmark(bookmark domain.Bookmark) (domain.Bookmark, error)
+	CreateBookmark(bookmark domain.Bookmark, strict bool) (domain.Bookmark, error)
 	GetBookmarkCountByArticleID(articleID int) (int, error)
-	ListBookmarksByUserID(userID int) ([]domain.Bookmark, error)
-	ListBookmarksByArticleID(articleID int) ([]domain.Bookmark, error)
+	ListBookmarksByUserID(userID int, query domain.BookmarkListQuery) ([]domain.Bookmark, string, error)
+	ListWorkspaceBookmarks(userID int, query domain.BookmarkListQuery) ([]domain.Bookmark, string, error)
+	ListBookmarksByArticleID(articleID, pageSize int, pageToken string) ([]domain.Bookmark, string, error)
+	UpdateBookmark(userID int, bookmark domain.Bookmark, fields []string) (domain.Bookmark, error)
+	SearchBookmarks(query domain.BookmarkSearchQuery) ([]domain.BookmarkSearchResult, string, error)
+	MarkBookmarksRead(userID int, articleIDs []int) (int, error)
+	MarkBookmarksUnread(userID int, articleIDs []int) (int, error)
+	ExportBookmarks(userID int, format string) ([]byte, error)
 	DeleteBookmarkByUserIDAndArticleID(userID, articleID int) error
 	DeleteBookmarkByUserID(userID int) error
 	DeleteBookmarkByArticleID(articleID int) error
 }
 
+var bookmarkUpdateColumns = map[string]string{
+	"note":       "note",
+	"highlights": "highlights",
+	"tags":       "tags",
+	"progress":   "progress",
+	"remind_at":  "remind_at",
+}
+
 type bookmarkUsecase struct {
-	repo repository.IBookmarkRepository
+	repo          repository.IBookmarkRepository
+	userRepo      repository.IUserRepository
+	workspaceRepo repository.IWorkspaceRepository
 }
 
-func NewBookmarkUsecase(repo repository.IBookmarkRepository) IBookmarkUsecase {
-	return &bookmarkUsecase{repo}
+func NewBookmarkUsecase(repo repository.IBookmarkRepository, userRepo repository.IUserRepository, workspaceRepo repository.IWorkspaceRepository) IBookmarkUsecase {
+	return &bookmarkUsecase{repo, userRepo, workspaceRepo}
 }
 
-func (usecase *bookmarkUsecase) CreateBookmark(bookmark domain.Bookmark) (domain.Bookmark, error) {
-	if err := usecase.repo.CreateBookmark(&bookmark); err != nil {
+func (usecase *bookmarkUsecase) CreateBookmark(bookmark domain.Bookmark, strict bool) (domain.Bookmark, error) {
+	prepareBookmark(&bookmark)
+	err := usecase.repo.CreateBookmark(&bookmark)
+	if errors.Is(err, domain.ErrAlreadyExists) && !strict {
+		existing, err := usecase.repo.GetBookmarkByUserIDAndArticleID(int(bookmark.UserID), int(bookmark.ArticleID))
+		if err != nil {
+			return domain.Bookmark{}, err
+		}
+		return *existing, nil
+	}
+	if err != nil {
 		return domain.Bookmark{}, err
 	}
 	return bookmark, nil
@@ -38,20 +69,45 @@ func (usecase *bookmarkUsecase) GetBookmarkCountByArticleID(articleID int) (int,
 	return count, nil
 }
 
-func (usecase *bookmarkUsecase) ListBookmarksByUserID(userID int) ([]domain.Bookmark, error) {
-	bookmarks, err := usecase.repo.ListBookmarksByUserID(userID)
+func (usecase *bookmarkUsecase) ListBookmarksByUserID(userID int, query domain.BookmarkListQuery) ([]domain.Bookmark, string, error) {
+	if query.UserID != userID {
+		user, err := usecase.userRepo.GetUser(query.UserID)
+		if err != nil {
+			return []domain.Bookmark{}, "", err
+		}
+		if !user.BookmarksPublic {
+			return []domain.Bookmark{}, "", domain.ErrPermissionDenied
+		}
+		if query.CollectionID != 0 || query.Tag != "" || query.ReadState != "" {
+			return []domain.Bookmark{}, "", fmt.Errorf("%w: collection, tag and read state filters are only available for your own bookmarks", domain.ErrInvalidArgument)
+		}
+	}
+	query.PageSize = pagination.PageSize(query.PageSize)
+	bookmarks, nextPageToken, err := usecase.repo.ListBookmarksByUserID(query)
+	if err != nil {
+		return []domain.Bookmark{}, "", err
+	}
+	return *bookmarks, nextPageToken, nil
+}
+
+func (usecase *bookmarkUsecase) ListWorkspaceBookmarks(userID int, query domain.BookmarkListQuery) ([]domain.Bookmark, string, error) {
+	if _, err := requireWorkspaceRole(usecase.workspaceRepo, query.WorkspaceID, userID, domain.WorkspaceRoleViewer); err != nil {
+		return []domain.Bookmark{}, "", err
+	}
+	query.PageSize = pagination.PageSize(query.PageSize)
+	bookmarks, nextPageToken, err := usecase.repo.ListBookmarksByWorkspaceID(query)
 	if err != nil {
-		return []domain.Bookmark{}, err
+		return []domain.Bookmark{}, "", err
 	}
-	return *bookmarks, nil
+	return *bookmarks, nextPageToken, nil
 }
 
-func (usecase *bookmarkUsecase) ListBookmarksByArticleID(articleID int) ([]domain.Bookmark, error) {
-	bookmarks, err := usecase.repo.ListBookmarksByArticleID(articleID)
+func (usecase *bookmarkUsecase) ListBookmarksByArticleID(articleID, pageSize int, pageToken string) ([]domain.Bookmark, string, error) {
+	bookmarks, nextPageToken, err := usecase.repo.ListBookmarksByArticleID(articleID, pagination.PageSize(pageSize), pageToken)
 	if err != nil {
-		return []domain.Bookmark{}, err
+		return []domain.Bookmark{}, "", err
 	}
-	return *bookmarks, nil
+	return *bookmarks, nextPageToken, nil
 }
 
 func (usecase *bookmarkUsecase) DeleteBookmarkByUserIDAndArticleID(userID, articleID int) error {
@@ -68,3 +124,82 @@ func (usecase *bookmarkUsecase) DeleteBookmarkByArticleID(articleID int) error {
 	err := usecase.repo.DeleteBookmarkByArticleID(articleID)
 	return err
 }
+
+func (usecase *bookmarkUsecase) UpdateBookmark(userID int, bookmark domain.Bookmark, fields []string) (domain.Bookmark, error) {
+	columns := []string{}
+	for _, field := range fields {
+		column, ok := bookmarkUpdateColumns[field]
+		if !ok {
+			return domain.Bookmark{}, fmt.Errorf("%w: unknown bookmark field: %s", domain.ErrInvalidArgument, field)
+		}
+		if column == "remind_at" {
+			if bookmark.RemindAt != nil && bookmark.RemindAt.Before(time.Now()) {
+				return domain.Bookmark{}, fmt.Errorf("%w: remind_at must be in the future", domain.ErrInvalidArgument)
+			}
+			bookmark.RemindedAt = nil
+			bookmark.ReminderErrorCount = 0
+			bookmark.ReminderRetryAt = nil
+			columns = append(columns, "reminded_at", "reminder_error_count", "reminder_retry_at")
+		}
+		columns = append(columns, column)
+	}
+	current, err := usecase.repo.GetBookmark(int(bookmark.ID))
+	if err != nil {
+		return domain.Bookmark{}, err
+	}
+	if int(current.UserID) != userID {
+		return domain.Bookmark{}, domain.ErrPermissionDenied
+	}
+	prepareBookmark(&bookmark)
+	if err := usecase.repo.UpdateBookmark(&bookmark, columns); err != nil {
+		return domain.Bookmark{}, err
+	}
+	return bookmark, nil
+}
+
+func (usecase *bookmarkUsecase) SearchBookmarks(query domain.BookmarkSearchQuery) ([]domain.BookmarkSearchResult, string, error) {
+	query.PageSize = pagination.PageSize(query.PageSize)
+	results, nextPageToken, err := usecase.repo.SearchBookmarks(query)
+	if err != nil {
+		return []domain.BookmarkSearchResult{}, "", err
+	}
+	return *results, nextPageToken, nil
+}
+
+func (usecase *bookmarkUsecase) MarkBookmarksRead(userID int, articleIDs []int) (int, error) {
+	return usecase.repo.SetBookmarksRead(userID, articleIDs, true)
+}
+
+func (usecase *bookmarkUsecase) MarkBookmarksUnread(userID int, articleIDs []int) (int, error) {
+	return usecase.repo.SetBookmarksRead(userID, articleIDs, false)
+}
+
+func (usecase *bookmarkUsecase) ExportBookmarks(userID int, format string) ([]byte, error) {
+	exportItems, err := usecase.repo.ListBookmarkExportItems(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []bookmarkfile.Item{}
+	for _, exportItem := range *exportItems {
+		items = append(items, bookmarkfile.Item{
+			Url:     exportItem.Url,
+			Title:   exportItem.Title,
+			Folder:  exportItem.CollectionName,
+			Note:    exportItem.Note,
+			Tags:    exportItem.Tags,
+			Read:    exportItem.ReadAt != nil,
+			AddedAt: exportItem.CreatedAt,
+		})
+	}
+	return bookmarkfile.Marshal(format, items)
+}
+
+func prepareBookmark(bookmark *domain.Bookmark) {
+	if bookmark.Highlights == nil {
+		bookmark.Highlights = []string{}
+	}
+	if bookmark.Tags == nil {
+		bookmark.Tags = []string{}
+	}
+}
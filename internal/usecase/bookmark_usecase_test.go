@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -14,6 +15,7 @@ import (
 func TestCreateBookmark(t *testing.T) {
 	type args struct {
 		bookmark domain.Bookmark
+		strict   bool
 	}
 
 	reqBookmark := domain.Bookmark{
@@ -55,6 +57,35 @@ func TestCreateBookmark(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "Duplicate",
+			args: args{
+				bookmark: reqBookmark,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists)
+				repo.EXPECT().GetBookmarkByUserIDAndArticleID(1, 1).Return(&domain.Bookmark{ID: 3, UserID: 1, ArticleID: 1, Note: "note"}, nil)
+			},
+			checkResponse: func(t *testing.T, resBookmark domain.Bookmark, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(3), resBookmark.ID)
+				assert.Equal(t, "note", resBookmark.Note)
+			},
+		},
+		{
+			name: "DuplicateStrict",
+			args: args{
+				bookmark: reqBookmark,
+				strict:   true,
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().CreateBookmark(gomock.Any()).Return(domain.ErrAlreadyExists)
+				repo.EXPECT().GetBookmarkByUserIDAndArticleID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resBookmark domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -65,8 +96,8 @@ func TestCreateBookmark(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
-			resUser, err := usecase.CreateBookmark(tc.args.bookmark)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
+			resUser, err := usecase.CreateBookmark(tc.args.bookmark, tc.args.strict)
 			tc.checkResponse(t, resUser, err)
 		})
 	}
@@ -121,7 +152,7 @@ func TestGetBookmarkCountByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
 			resBookmarkCount, err := usecase.GetBookmarkCountByArticleID(tc.args.articleID)
 			tc.checkResponse(t, resBookmarkCount, err)
 		})
@@ -131,6 +162,7 @@ func TestGetBookmarkCountByArticleID(t *testing.T) {
 func TestListBookmarksByUserID(t *testing.T) {
 	type args struct {
 		userID int
+		query  domain.BookmarkListQuery
 	}
 
 	repoResBookmarks := &[]domain.Bookmark{
@@ -153,16 +185,17 @@ func TestListBookmarksByUserID(t *testing.T) {
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		buildStubs    func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, resBookmark []domain.Bookmark, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
 				userID: 1,
+				query:  domain.BookmarkListQuery{UserID: 1},
 			},
-			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(repoResBookmarks, nil)
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(repoResBookmarks, "", nil)
 			},
 			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
 				assert.NoError(t, err)
@@ -175,11 +208,54 @@ func TestListBookmarksByUserID(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "PublicOtherUser",
+			args: args{
+				userID: 2,
+				query:  domain.BookmarkListQuery{UserID: 1},
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, BookmarksPublic: true}, nil)
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(repoResBookmarks, "", nil)
+			},
+			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, resBookmarks, len(*repoResBookmarks))
+			},
+		},
+		{
+			name: "PrivateOtherUser",
+			args: args{
+				userID: 2,
+				query:  domain.BookmarkListQuery{UserID: 1},
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1}, nil)
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "OtherUserFilter",
+			args: args{
+				userID: 2,
+				query:  domain.BookmarkListQuery{UserID: 1, Tag: "go"},
+			},
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, BookmarksPublic: true}, nil)
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{},
-			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{}, gorm.ErrRecordNotFound)
+			buildStubs: func(repo *mock.MockIBookmarkRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().ListBookmarksByUserID(gomock.Any()).Return(&[]domain.Bookmark{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
 				assert.Error(t, err)
@@ -193,10 +269,12 @@ func TestListBookmarksByUserID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := NewBookmarkUsecase(repo)
-			resBookmarks, err := usecase.ListBookmarksByUserID(tc.args.userID)
+			tc.args.query.PageSize = 10
+			usecase := NewBookmarkUsecase(repo, userRepo, nil)
+			resBookmarks, _, err := usecase.ListBookmarksByUserID(tc.args.userID, tc.args.query)
 			tc.checkResponse(t, resBookmarks, err)
 		})
 	}
@@ -236,7 +314,7 @@ func TestListBookmarksByArticleID(t *testing.T) {
 				articleID: 1,
 			},
 			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByArticleID(gomock.Any()).Return(repoResBookmarks, nil)
+				repo.EXPECT().ListBookmarksByArticleID(gomock.Any(), gomock.Any(), gomock.Any()).Return(repoResBookmarks, "", nil)
 			},
 			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
 				assert.NoError(t, err)
@@ -253,7 +331,7 @@ func TestListBookmarksByArticleID(t *testing.T) {
 			name: "NotFound",
 			args: args{},
 			buildStubs: func(repo *mock.MockIBookmarkRepository) {
-				repo.EXPECT().ListBookmarksByArticleID(gomock.Any()).Return(&[]domain.Bookmark{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListBookmarksByArticleID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Bookmark{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resBookmarks []domain.Bookmark, err error) {
 				assert.Error(t, err)
@@ -269,8 +347,8 @@ func TestListBookmarksByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
-			resBookmarks, err := usecase.ListBookmarksByArticleID(tc.args.articleID)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
+			resBookmarks, _, err := usecase.ListBookmarksByArticleID(tc.args.articleID, 10, "")
 			tc.checkResponse(t, resBookmarks, err)
 		})
 	}
@@ -324,7 +402,7 @@ func TestDeleteBookmarkByUserIDAndArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
 			err := usecase.DeleteBookmarkByUserIDAndArticleID(tc.args.userID, tc.args.articleID)
 			tc.checkResponse(t, err)
 		})
@@ -376,7 +454,7 @@ func TestDeleteBookmarkByUserID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
 			err := usecase.DeleteBookmarkByUserID(tc.args.userID)
 			tc.checkResponse(t, err)
 		})
@@ -428,9 +506,224 @@ func TestDeleteBookmarkByArticleID(t *testing.T) {
 			repo := mock.NewMockIBookmarkRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewBookmarkUsecase(repo)
+			usecase := NewBookmarkUsecase(repo, nil, nil)
 			err := usecase.DeleteBookmarkByArticleID(tc.args.articleID)
 			tc.checkResponse(t, err)
 		})
 	}
 }
+
+func TestUpdateBookmark(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        int
+		fields        []string
+		buildStubs    func(repo *mock.MockIBookmarkRepository)
+		checkResponse func(t *testing.T, res domain.Bookmark, err error)
+	}{
+		{
+			name:   "OK",
+			userID: 1,
+			fields: []string{"note", "highlights"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 1, ArticleID: 1}, nil)
+				repo.EXPECT().UpdateBookmark(&domain.Bookmark{ID: 1, Note: "# note", Highlights: []string{}, Tags: []string{}}, []string{"note", "highlights"}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res domain.Bookmark, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "# note", res.Note)
+			},
+		},
+		{
+			name:   "UnknownField",
+			userID: 1,
+			fields: []string{"article_id"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(gomock.Any()).Times(0)
+				repo.EXPECT().UpdateBookmark(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name:   "NotFound",
+			userID: 1,
+			fields: []string{"note"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().UpdateBookmark(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:   "PermissionDenied",
+			userID: 2,
+			fields: []string{"note"},
+			buildStubs: func(repo *mock.MockIBookmarkRepository) {
+				repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 1, ArticleID: 1}, nil)
+				repo.EXPECT().UpdateBookmark(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Bookmark, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewBookmarkUsecase(repo, nil, nil)
+			res, err := usecase.UpdateBookmark(tc.userID, domain.Bookmark{ID: 1, Note: "# note"}, tc.fields)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUpdateBookmarkRemindAt(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	remindAt := time.Now().Add(time.Hour)
+	pastRemindAt := time.Now().Add(-time.Hour)
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	repo.EXPECT().GetBookmark(1).Return(&domain.Bookmark{ID: 1, UserID: 1, ArticleID: 1}, nil)
+	repo.EXPECT().UpdateBookmark(gomock.Any(), []string{"reminded_at", "reminder_error_count", "reminder_retry_at", "remind_at"}).DoAndReturn(func(bookmark *domain.Bookmark, columns []string) error {
+		assert.Equal(t, &remindAt, bookmark.RemindAt)
+		assert.Nil(t, bookmark.RemindedAt)
+		return nil
+	})
+
+	usecase := NewBookmarkUsecase(repo, nil, nil)
+	res, err := usecase.UpdateBookmark(1, domain.Bookmark{ID: 1, RemindAt: &remindAt}, []string{"remind_at"})
+	assert.NoError(t, err)
+	assert.Equal(t, &remindAt, res.RemindAt)
+	_, err = usecase.UpdateBookmark(1, domain.Bookmark{ID: 1, RemindAt: &pastRemindAt}, []string{"remind_at"})
+	assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+}
+
+func TestSearchBookmarks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	repo.EXPECT().SearchBookmarks(domain.BookmarkSearchQuery{UserID: 1, Query: "golang", PageSize: 20}).
+		Return(&[]domain.BookmarkSearchResult{{Bookmark: domain.Bookmark{ID: 1, UserID: 1}, Rank: 0.5, Snippet: "<mark>golang</mark>"}}, "", nil)
+
+	usecase := NewBookmarkUsecase(repo, nil, nil)
+	results, nextPageToken, err := usecase.SearchBookmarks(domain.BookmarkSearchQuery{UserID: 1, Query: "golang"})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "<mark>golang</mark>", results[0].Snippet)
+	assert.Empty(t, nextPageToken)
+}
+
+func TestListWorkspaceBookmarks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 3).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+	repo.EXPECT().ListBookmarksByWorkspaceID(domain.BookmarkListQuery{WorkspaceID: 2, PageSize: pagination.DefaultPageSize}).Return(&[]domain.Bookmark{{ID: 1}, {ID: 2}}, "", nil)
+
+	usecase := NewBookmarkUsecase(repo, nil, workspaceRepo)
+	res, _, err := usecase.ListWorkspaceBookmarks(1, domain.BookmarkListQuery{WorkspaceID: 2})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	_, _, err = usecase.ListWorkspaceBookmarks(3, domain.BookmarkListQuery{WorkspaceID: 2})
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestMarkBookmarksRead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIBookmarkRepository(mockCtrl)
+	repo.EXPECT().SetBookmarksRead(1, []int{2, 3}, true).Return(2, nil)
+	repo.EXPECT().SetBookmarksRead(1, []int{2}, false).Return(1, nil)
+
+	usecase := NewBookmarkUsecase(repo, nil, nil)
+	count, err := usecase.MarkBookmarksRead(1, []int{2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = usecase.MarkBookmarksUnread(1, []int{2})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestExportBookmarks(t *testing.T) {
+	exportItems := &[]domain.BookmarkExportItem{
+		{
+			Bookmark:       domain.Bookmark{ID: 1, Note: "must read", Tags: []string{"go", "web"}, CreatedAt: time.Unix(1700000000, 0)},
+			Title:          "First [Post]",
+			Url:            "https://example.com/1",
+			CollectionName: "Tech",
+		},
+		{
+			Bookmark: domain.Bookmark{ID: 2, Tags: []string{}},
+			Title:    "Second Post",
+			Url:      "https://example.com/2",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		format        string
+		checkResponse func(t *testing.T, data []byte, err error)
+	}{
+		{
+			name:   "Netscape",
+			format: "netscape",
+			checkResponse: func(t *testing.T, data []byte, err error) {
+				assert.NoError(t, err)
+				assert.Contains(t, string(data), "<!DOCTYPE NETSCAPE-Bookmark-file-1>")
+				assert.Contains(t, string(data), `<DT><H3>Tech</H3>`)
+				assert.Contains(t, string(data), `<DT><A HREF="https://example.com/1" ADD_DATE="1700000000" TAGS="go,web">First [Post]</A>`)
+				assert.Contains(t, string(data), "<DD>must read")
+			},
+		},
+		{
+			name:   "CSV",
+			format: "csv",
+			checkResponse: func(t *testing.T, data []byte, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "title,note,url,folder,tags,created,read\n"+
+					"First [Post],must read,https://example.com/1,Tech,\"go,web\",2023-11-14T22:13:20Z,false\n"+
+					"Second Post,,https://example.com/2,,,,false\n", string(data))
+			},
+		},
+		{
+			name:   "Markdown",
+			format: "markdown",
+			checkResponse: func(t *testing.T, data []byte, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "# Bookmarks\n\n"+
+					"## Tech\n\n- [First \\[Post\\]](https://example.com/1) `go` `web`\n  > must read\n\n"+
+					"## Uncategorized\n\n- [Second Post](https://example.com/2)\n", string(data))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIBookmarkRepository(mockCtrl)
+			repo.EXPECT().ListBookmarkExportItems(1).Return(exportItems, nil)
+
+			usecase := NewBookmarkUsecase(repo, nil, nil)
+			data, err := usecase.ExportBookmarks(1, tc.format)
+			tc.checkResponse(t, data, err)
+		})
+	}
+}
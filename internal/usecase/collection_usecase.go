@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/loak155/techbranch-backend/pkg/uuid"
+	"gorm.io/gorm"
+)
+
+type ICollectionUsecase interface {
+	CreateCollection(collection domain.Collection) (domain.Collection, error)
+	GetCollection(userID, id int) (domain.Collection, error)
+	ListCollections(userID, workspaceID, pageSize int, pageToken string) ([]domain.Collection, string, error)
+	UpdateCollection(userID int, collection domain.Collection) (domain.Collection, error)
+	UpdateCollectionVisibility(userID, id int, visibility string) (domain.Collection, error)
+	GetSharedCollection(shareToken string, pageSize int, pageToken string) (domain.Collection, []domain.SharedCollectionItem, string, error)
+	DeleteCollection(userID, id int) error
+	ReorderCollections(userID int, collectionIDs []int) error
+	ReorderCollectionBookmarks(userID, collectionID int, bookmarkIDs []int) error
+	MoveBookmarks(userID int, bookmarkIDs []int, collectionID int) error
+}
+
+type collectionUsecase struct {
+	repo          repository.ICollectionRepository
+	bookmarkRepo  repository.IBookmarkRepository
+	articleRepo   repository.IArticleRepository
+	workspaceRepo repository.IWorkspaceRepository
+}
+
+func NewCollectionUsecase(repo repository.ICollectionRepository, bookmarkRepo repository.IBookmarkRepository, articleRepo repository.IArticleRepository, workspaceRepo repository.IWorkspaceRepository) ICollectionUsecase {
+	return &collectionUsecase{repo, bookmarkRepo, articleRepo, workspaceRepo}
+}
+
+func (usecase *collectionUsecase) CreateCollection(collection domain.Collection) (domain.Collection, error) {
+	if collection.WorkspaceID != nil {
+		if _, err := requireWorkspaceRole(usecase.workspaceRepo, int(*collection.WorkspaceID), int(collection.UserID), domain.WorkspaceRoleEditor); err != nil {
+			return domain.Collection{}, err
+		}
+	}
+	if err := usecase.checkCollectionName(collection, collection.Name); err != nil {
+		return domain.Collection{}, err
+	}
+	if collection.Visibility == "" {
+		collection.Visibility = domain.CollectionVisibilityPrivate
+	}
+	collection.ShareToken = uuid.NewUUID()
+	if err := usecase.repo.CreateCollection(&collection); err != nil {
+		return domain.Collection{}, err
+	}
+	return collection, nil
+}
+
+func (usecase *collectionUsecase) GetCollection(userID, id int) (domain.Collection, error) {
+	return usecase.authorizeCollection(userID, id, domain.WorkspaceRoleViewer)
+}
+
+func (usecase *collectionUsecase) ListCollections(userID, workspaceID, pageSize int, pageToken string) ([]domain.Collection, string, error) {
+	if workspaceID != 0 {
+		if _, err := requireWorkspaceRole(usecase.workspaceRepo, workspaceID, userID, domain.WorkspaceRoleViewer); err != nil {
+			return []domain.Collection{}, "", err
+		}
+		collections, nextPageToken, err := usecase.repo.ListCollectionsByWorkspaceID(workspaceID, pagination.PageSize(pageSize), pageToken)
+		if err != nil {
+			return []domain.Collection{}, "", err
+		}
+		return *collections, nextPageToken, nil
+	}
+	collections, nextPageToken, err := usecase.repo.ListCollectionsByUserID(userID, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.Collection{}, "", err
+	}
+	return *collections, nextPageToken, nil
+}
+
+func (usecase *collectionUsecase) UpdateCollection(userID int, collection domain.Collection) (domain.Collection, error) {
+	current, err := usecase.authorizeCollection(userID, int(collection.ID), domain.WorkspaceRoleEditor)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	if err := usecase.checkCollectionName(current, collection.Name); err != nil {
+		return domain.Collection{}, err
+	}
+	current.Name = collection.Name
+	current.Description = collection.Description
+	if err := usecase.repo.UpdateCollection(&current); err != nil {
+		return domain.Collection{}, err
+	}
+	return current, nil
+}
+
+func (usecase *collectionUsecase) UpdateCollectionVisibility(userID, id int, visibility string) (domain.Collection, error) {
+	collection, err := usecase.authorizeCollection(userID, id, domain.WorkspaceRoleEditor)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	collection.Visibility = visibility
+	if err := usecase.repo.UpdateCollectionVisibility(&collection); err != nil {
+		return domain.Collection{}, err
+	}
+	return collection, nil
+}
+
+func (usecase *collectionUsecase) GetSharedCollection(shareToken string, pageSize int, pageToken string) (domain.Collection, []domain.SharedCollectionItem, string, error) {
+	collection, err := usecase.repo.GetCollectionByShareToken(shareToken)
+	if err != nil {
+		return domain.Collection{}, []domain.SharedCollectionItem{}, "", err
+	}
+	if collection.Visibility == domain.CollectionVisibilityPrivate {
+		return domain.Collection{}, []domain.SharedCollectionItem{}, "", gorm.ErrRecordNotFound
+	}
+
+	query := domain.BookmarkListQuery{
+		UserID:       int(collection.UserID),
+		CollectionID: int(collection.ID),
+		PageSize:     pagination.PageSize(pageSize),
+		PageToken:    pageToken,
+	}
+	listBookmarks := usecase.bookmarkRepo.ListBookmarksByUserID
+	if collection.WorkspaceID != nil {
+		query.WorkspaceID = int(*collection.WorkspaceID)
+		listBookmarks = usecase.bookmarkRepo.ListBookmarksByWorkspaceID
+	}
+	bookmarks, nextPageToken, err := listBookmarks(query)
+	if err != nil {
+		return domain.Collection{}, []domain.SharedCollectionItem{}, "", err
+	}
+	articleIDs := []int{}
+	for _, bookmark := range *bookmarks {
+		articleIDs = append(articleIDs, int(bookmark.ArticleID))
+	}
+	articles, err := usecase.articleRepo.ListArticlesByIDs(articleIDs)
+	if err != nil {
+		return domain.Collection{}, []domain.SharedCollectionItem{}, "", err
+	}
+	articleByID := map[uint]domain.Article{}
+	for _, article := range *articles {
+		articleByID[article.ID] = article
+	}
+
+	items := []domain.SharedCollectionItem{}
+	for _, bookmark := range *bookmarks {
+		if article, ok := articleByID[bookmark.ArticleID]; ok {
+			items = append(items, domain.SharedCollectionItem{Article: article, AddedAt: bookmark.CreatedAt})
+		}
+	}
+	return *collection, items, nextPageToken, nil
+}
+
+func (usecase *collectionUsecase) DeleteCollection(userID, id int) error {
+	if _, err := usecase.authorizeCollection(userID, id, domain.WorkspaceRoleEditor); err != nil {
+		return err
+	}
+	return usecase.repo.DeleteCollection(id)
+}
+
+func (usecase *collectionUsecase) ReorderCollections(userID int, collectionIDs []int) error {
+	return usecase.repo.ReorderCollections(userID, collectionIDs)
+}
+
+func (usecase *collectionUsecase) ReorderCollectionBookmarks(userID, collectionID int, bookmarkIDs []int) error {
+	if _, err := usecase.authorizeCollection(userID, collectionID, domain.WorkspaceRoleEditor); err != nil {
+		return err
+	}
+	return usecase.repo.ReorderCollectionBookmarks(collectionID, bookmarkIDs)
+}
+
+func (usecase *collectionUsecase) MoveBookmarks(userID int, bookmarkIDs []int, collectionID int) error {
+	if collectionID == 0 {
+		return usecase.repo.MoveBookmarks(userID, bookmarkIDs, nil)
+	}
+	collection, err := usecase.authorizeCollection(userID, collectionID, domain.WorkspaceRoleEditor)
+	if err != nil {
+		return err
+	}
+	return usecase.repo.MoveBookmarks(userID, bookmarkIDs, &collection.ID)
+}
+
+func (usecase *collectionUsecase) authorizeCollection(userID, id int, role string) (domain.Collection, error) {
+	collection, err := usecase.repo.GetCollection(id)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	if collection.WorkspaceID != nil {
+		if _, err := requireWorkspaceRole(usecase.workspaceRepo, int(*collection.WorkspaceID), userID, role); err != nil {
+			return domain.Collection{}, err
+		}
+		return *collection, nil
+	}
+	if int(collection.UserID) != userID {
+		return domain.Collection{}, domain.ErrPermissionDenied
+	}
+	return *collection, nil
+}
+
+func (usecase *collectionUsecase) checkCollectionName(current domain.Collection, name string) error {
+	var collection *domain.Collection
+	var err error
+	if current.WorkspaceID != nil {
+		collection, err = usecase.repo.GetCollectionByWorkspaceIDAndName(int(*current.WorkspaceID), name)
+	} else {
+		collection, err = usecase.repo.GetCollectionByUserIDAndName(int(current.UserID), name)
+	}
+	if err == nil && collection.ID != current.ID {
+		return domain.ErrAlreadyExists
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
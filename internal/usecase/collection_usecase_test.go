@@ -0,0 +1,496 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res domain.Collection, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "reading").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateCollection(gomock.Any()).DoAndReturn(func(collection *domain.Collection) error {
+					collection.ID = 1
+					collection.Position = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), res.ID)
+				assert.Equal(t, "reading", res.Name)
+				assert.Equal(t, domain.CollectionVisibilityPrivate, res.Visibility)
+				assert.NotEmpty(t, res.ShareToken)
+			},
+		},
+		{
+			name: "AlreadyExists",
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "reading").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading"}, nil)
+				repo.EXPECT().CreateCollection(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, nil)
+			res, err := usecase.CreateCollection(domain.Collection{UserID: 1, Name: "reading"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListCollections(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockICollectionRepository(mockCtrl)
+	repo.EXPECT().ListCollectionsByUserID(1, pagination.DefaultPageSize, "").Return(&[]domain.Collection{{ID: 1}, {ID: 2}}, "next", nil)
+
+	usecase := NewCollectionUsecase(repo, nil, nil, nil)
+	res, nextPageToken, err := usecase.ListCollections(1, 0, 0, "")
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "next", nextPageToken)
+}
+
+func TestCreateWorkspaceCollection(t *testing.T) {
+	workspaceID := uint(2)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICollectionRepository, workspaceRepo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res domain.Collection, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICollectionRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().GetCollectionByWorkspaceIDAndName(2, "reading").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateCollection(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &workspaceID, res.WorkspaceID)
+			},
+		},
+		{
+			name: "Viewer",
+			buildStubs: func(repo *mock.MockICollectionRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().CreateCollection(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "NotMember",
+			buildStubs: func(repo *mock.MockICollectionRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateCollection(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo, workspaceRepo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, workspaceRepo)
+			res, err := usecase.CreateCollection(domain.Collection{UserID: 1, WorkspaceID: &workspaceID, Name: "reading"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListWorkspaceCollections(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockICollectionRepository(mockCtrl)
+	workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 3).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+	repo.EXPECT().ListCollectionsByWorkspaceID(2, pagination.DefaultPageSize, "").Return(&[]domain.Collection{{ID: 1}}, "", nil)
+
+	usecase := NewCollectionUsecase(repo, nil, nil, workspaceRepo)
+	res, _, err := usecase.ListCollections(1, 2, 0, "")
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	_, _, err = usecase.ListCollections(3, 2, 0, "")
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestGetWorkspaceCollection(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	workspaceID := uint(2)
+	repo := mock.NewMockICollectionRepository(mockCtrl)
+	workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 3, WorkspaceID: &workspaceID}, nil).Times(2)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil).Times(2)
+
+	usecase := NewCollectionUsecase(repo, nil, nil, workspaceRepo)
+	res, err := usecase.GetCollection(1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), res.ID)
+	err = usecase.DeleteCollection(1, 1)
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestUpdateCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        int
+		buildStubs    func(repo *mock.MockICollectionRepository)
+		checkResponse func(t *testing.T, res domain.Collection, err error)
+	}{
+		{
+			name:   "OK",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading", Position: 2}, nil)
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "renamed").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().UpdateCollection(&domain.Collection{ID: 1, UserID: 1, Name: "renamed", Description: "description", Position: 2}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "renamed", res.Name)
+				assert.Equal(t, 2, res.Position)
+			},
+		},
+		{
+			name:   "SameName",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "renamed"}, nil)
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "renamed").Return(&domain.Collection{ID: 1, UserID: 1, Name: "renamed"}, nil)
+				repo.EXPECT().UpdateCollection(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "description", res.Description)
+			},
+		},
+		{
+			name:   "AlreadyExists",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading"}, nil)
+				repo.EXPECT().GetCollectionByUserIDAndName(1, "renamed").Return(&domain.Collection{ID: 2, UserID: 1, Name: "renamed"}, nil)
+				repo.EXPECT().UpdateCollection(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+			},
+		},
+		{
+			name:   "PermissionDenied",
+			userID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading"}, nil)
+				repo.EXPECT().UpdateCollection(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.Collection, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, nil)
+			res, err := usecase.UpdateCollection(tc.userID, domain.Collection{ID: 1, Name: "renamed", Description: "description"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUpdateCollectionVisibility(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockICollectionRepository(mockCtrl)
+	repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1, Name: "reading", Visibility: "private", ShareToken: "token"}, nil).Times(2)
+	repo.EXPECT().UpdateCollectionVisibility(&domain.Collection{ID: 1, UserID: 1, Name: "reading", Visibility: "public", ShareToken: "token"}).Return(nil)
+
+	usecase := NewCollectionUsecase(repo, nil, nil, nil)
+	res, err := usecase.UpdateCollectionVisibility(1, 1, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, "public", res.Visibility)
+
+	_, err = usecase.UpdateCollectionVisibility(2, 1, "public")
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestGetSharedCollection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, collection domain.Collection, items []domain.SharedCollectionItem, nextPageToken string, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading", Visibility: "unlisted"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(domain.BookmarkListQuery{UserID: 1, CollectionID: 2, PageSize: pagination.DefaultPageSize}).
+					Return(&[]domain.Bookmark{{ID: 1, ArticleID: 4}, {ID: 2, ArticleID: 3}}, "next", nil)
+				articleRepo.EXPECT().ListArticlesByIDs([]int{4, 3}).Return(&[]domain.Article{{ID: 3, Title: "third"}, {ID: 4, Title: "fourth"}}, nil)
+			},
+			checkResponse: func(t *testing.T, collection domain.Collection, items []domain.SharedCollectionItem, nextPageToken string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "reading", collection.Name)
+				assert.Len(t, items, 2)
+				assert.Equal(t, "fourth", items[0].Article.Title)
+				assert.Equal(t, "third", items[1].Article.Title)
+				assert.Equal(t, "next", nextPageToken)
+			},
+		},
+		{
+			name: "Private",
+			buildStubs: func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{ID: 2, UserID: 1, Name: "reading", Visibility: "private"}, nil)
+				bookmarkRepo.EXPECT().ListBookmarksByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, collection domain.Collection, items []domain.SharedCollectionItem, nextPageToken string, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockICollectionRepository, bookmarkRepo *mock.MockIBookmarkRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().GetCollectionByShareToken("token").Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, collection domain.Collection, items []domain.SharedCollectionItem, nextPageToken string, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo, bookmarkRepo, articleRepo)
+
+			usecase := NewCollectionUsecase(repo, bookmarkRepo, articleRepo, nil)
+			collection, items, nextPageToken, err := usecase.GetSharedCollection("token", 0, "")
+			tc.checkResponse(t, collection, items, nextPageToken, err)
+		})
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     int
+		buildStubs func(repo *mock.MockICollectionRepository)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:   "OK",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1}, nil)
+				repo.EXPECT().DeleteCollection(1).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "NotFound",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().DeleteCollection(gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:   "PermissionDenied",
+			userID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1}, nil)
+				repo.EXPECT().DeleteCollection(gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, nil)
+			err := usecase.DeleteCollection(tc.userID, 1)
+			tc.checkError(t, err)
+		})
+	}
+}
+
+func TestReorderCollectionBookmarks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     int
+		buildStubs func(repo *mock.MockICollectionRepository)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:   "OK",
+			userID: 1,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1}, nil)
+				repo.EXPECT().ReorderCollectionBookmarks(1, []int{5, 4}).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "PermissionDenied",
+			userID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(1).Return(&domain.Collection{ID: 1, UserID: 1}, nil)
+				repo.EXPECT().ReorderCollectionBookmarks(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, nil)
+			err := usecase.ReorderCollectionBookmarks(tc.userID, 1, []int{5, 4})
+			tc.checkError(t, err)
+		})
+	}
+}
+
+func TestMoveBookmarks(t *testing.T) {
+	collectionID := uint(2)
+
+	testCases := []struct {
+		name         string
+		collectionID int
+		buildStubs   func(repo *mock.MockICollectionRepository)
+		checkError   func(t *testing.T, err error)
+	}{
+		{
+			name:         "OK",
+			collectionID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(2).Return(&domain.Collection{ID: 2, UserID: 1}, nil)
+				repo.EXPECT().MoveBookmarks(1, []int{4, 5}, &collectionID).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "RemoveFromCollection",
+			collectionID: 0,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(gomock.Any()).Times(0)
+				repo.EXPECT().MoveBookmarks(1, []int{4, 5}, nil).Return(nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "PermissionDenied",
+			collectionID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(2).Return(&domain.Collection{ID: 2, UserID: 3}, nil)
+				repo.EXPECT().MoveBookmarks(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name:         "BookmarkNotFound",
+			collectionID: 2,
+			buildStubs: func(repo *mock.MockICollectionRepository) {
+				repo.EXPECT().GetCollection(2).Return(&domain.Collection{ID: 2, UserID: 1}, nil)
+				repo.EXPECT().MoveBookmarks(1, []int{4, 5}, &collectionID).Return(gorm.ErrRecordNotFound)
+			},
+			checkError: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICollectionRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCollectionUsecase(repo, nil, nil, nil)
+			err := usecase.MoveBookmarks(1, []int{4, 5}, tc.collectionID)
+			tc.checkError(t, err)
+		})
+	}
+}
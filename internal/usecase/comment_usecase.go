@@ -1,67 +1,155 @@
 package usecase
 
 import (
+	"fmt"
+
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 )
 
 type ICommentUsecase interface {
 	CreateComment(comment domain.Comment) (domain.Comment, error)
-	ListCommentsByUserID(userID int) ([]domain.Comment, error)
-	ListCommentsByArticleID(articleID int) ([]domain.Comment, error)
-	DeleteComment(id int) error
-	DeleteCommentByUserIDAndArticleID(userID, articleID int) error
-	DeleteCommentByUserID(userID int) error
-	DeleteCommentByArticleID(articleID int) error
+	ListCommentsByUserID(userID, pageSize int, pageToken string) ([]domain.Comment, string, error)
+	ListCommentsByArticleID(articleID, parentCommentID, pageSize int, pageToken string) ([]domain.Comment, string, error)
+	ListWorkspaceComments(userID, workspaceID, articleID, parentCommentID, pageSize int, pageToken string) ([]domain.Comment, string, error)
+	DeleteComment(userID, id int) error
+	DeleteCommentByUserIDAndArticleID(actingUserID, userID, articleID int) error
+	DeleteCommentByUserID(actingUserID, userID int) error
+	DeleteCommentByArticleID(userID, articleID int) error
+	RestoreComment(userID, id int) (domain.Comment, error)
 }
 
 type commentUsecase struct {
-	repo repository.ICommentRepository
+	repo          repository.ICommentRepository
+	userRepo      repository.IUserRepository
+	workspaceRepo repository.IWorkspaceRepository
+	maxDepth      int
 }
 
-func NewCommentUsecase(repo repository.ICommentRepository) ICommentUsecase {
-	return &commentUsecase{repo}
+func NewCommentUsecase(repo repository.ICommentRepository, userRepo repository.IUserRepository, workspaceRepo repository.IWorkspaceRepository, maxDepth int) ICommentUsecase {
+	return &commentUsecase{repo, userRepo, workspaceRepo, maxDepth}
 }
 
 func (usecase *commentUsecase) CreateComment(comment domain.Comment) (domain.Comment, error) {
+	if comment.WorkspaceID != nil {
+		if _, err := requireWorkspaceRole(usecase.workspaceRepo, int(*comment.WorkspaceID), int(comment.UserID), domain.WorkspaceRoleEditor); err != nil {
+			return domain.Comment{}, err
+		}
+	}
+	if comment.ParentCommentID != nil {
+		parent, err := usecase.repo.GetComment(int(*comment.ParentCommentID))
+		if err != nil {
+			return domain.Comment{}, err
+		}
+		if parent.ArticleID != comment.ArticleID || !sameWorkspace(parent.WorkspaceID, comment.WorkspaceID) {
+			return domain.Comment{}, fmt.Errorf("%w: parent comment belongs to another thread", domain.ErrInvalidArgument)
+		}
+		if parent.Depth+1 > usecase.maxDepth {
+			return domain.Comment{}, fmt.Errorf("%w: replies cannot be nested more than %d levels", domain.ErrInvalidArgument, usecase.maxDepth)
+		}
+		comment.Depth = parent.Depth + 1
+	}
 	if err := usecase.repo.CreateComment(&comment); err != nil {
 		return domain.Comment{}, err
 	}
 	return comment, nil
 }
 
-func (usecase *commentUsecase) ListCommentsByUserID(userID int) ([]domain.Comment, error) {
-	comments, err := usecase.repo.ListCommentsByUserID(userID)
+func (usecase *commentUsecase) ListCommentsByUserID(userID, pageSize int, pageToken string) ([]domain.Comment, string, error) {
+	comments, nextPageToken, err := usecase.repo.ListCommentsByUserID(userID, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.Comment{}, "", err
+	}
+	return *comments, nextPageToken, nil
+}
+
+func (usecase *commentUsecase) ListCommentsByArticleID(articleID, parentCommentID, pageSize int, pageToken string) ([]domain.Comment, string, error) {
+	comments, nextPageToken, err := usecase.repo.ListCommentsByArticleID(articleID, parentCommentID, pagination.PageSize(pageSize), pageToken)
 	if err != nil {
-		return []domain.Comment{}, err
+		return []domain.Comment{}, "", err
 	}
-	return *comments, nil
+	return *comments, nextPageToken, nil
 }
 
-func (usecase *commentUsecase) ListCommentsByArticleID(articleID int) ([]domain.Comment, error) {
-	comments, err := usecase.repo.ListCommentsByArticleID(articleID)
+func (usecase *commentUsecase) ListWorkspaceComments(userID, workspaceID, articleID, parentCommentID, pageSize int, pageToken string) ([]domain.Comment, string, error) {
+	if _, err := requireWorkspaceRole(usecase.workspaceRepo, workspaceID, userID, domain.WorkspaceRoleViewer); err != nil {
+		return []domain.Comment{}, "", err
+	}
+	comments, nextPageToken, err := usecase.repo.ListCommentsByWorkspaceIDAndArticleID(workspaceID, articleID, parentCommentID, pagination.PageSize(pageSize), pageToken)
 	if err != nil {
-		return []domain.Comment{}, err
+		return []domain.Comment{}, "", err
 	}
-	return *comments, nil
+	return *comments, nextPageToken, nil
 }
 
-func (usecase *commentUsecase) DeleteComment(id int) error {
-	err := usecase.repo.DeleteComment(id)
+func (usecase *commentUsecase) DeleteComment(userID, id int) error {
+	comment, err := usecase.repo.GetComment(id)
+	if err != nil {
+		return err
+	}
+	if int(comment.UserID) != userID {
+		if comment.WorkspaceID != nil {
+			_, err = requireWorkspaceRole(usecase.workspaceRepo, int(*comment.WorkspaceID), userID, domain.WorkspaceRoleEditor)
+		} else {
+			err = requireModerator(usecase.userRepo, userID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	err = usecase.repo.DeleteComment(id)
 	return err
 }
 
-func (usecase *commentUsecase) DeleteCommentByUserIDAndArticleID(userID, articleID int) error {
+func (usecase *commentUsecase) DeleteCommentByUserIDAndArticleID(actingUserID, userID, articleID int) error {
+	if err := usecase.authorizeCommentsOf(actingUserID, userID); err != nil {
+		return err
+	}
 	err := usecase.repo.DeleteCommentByUserIDAndArticleID(userID, articleID)
 	return err
 }
 
-func (usecase *commentUsecase) DeleteCommentByUserID(userID int) error {
+func (usecase *commentUsecase) DeleteCommentByUserID(actingUserID, userID int) error {
+	if err := usecase.authorizeCommentsOf(actingUserID, userID); err != nil {
+		return err
+	}
 	err := usecase.repo.DeleteCommentByUserID(userID)
 	return err
 }
 
-func (usecase *commentUsecase) DeleteCommentByArticleID(articleID int) error {
+func (usecase *commentUsecase) DeleteCommentByArticleID(userID, articleID int) error {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return err
+	}
 	err := usecase.repo.DeleteCommentByArticleID(articleID)
 	return err
 }
+
+func (usecase *commentUsecase) authorizeCommentsOf(actingUserID, userID int) error {
+	if actingUserID == userID {
+		return nil
+	}
+	return requireModerator(usecase.userRepo, actingUserID)
+}
+
+func (usecase *commentUsecase) RestoreComment(userID, id int) (domain.Comment, error) {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return domain.Comment{}, err
+	}
+	if err := usecase.repo.RestoreComment(id); err != nil {
+		return domain.Comment{}, err
+	}
+	comment, err := usecase.repo.GetComment(id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	return *comment, nil
+}
+
+func sameWorkspace(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
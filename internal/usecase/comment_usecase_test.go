@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -66,13 +67,156 @@ func TestCreateComment(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewCommentUsecase(repo)
+			usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
 			resUser, err := usecase.CreateComment(tc.args.comment)
 			tc.checkResponse(t, resUser, err)
 		})
 	}
 }
 
+func TestCreateWorkspaceComment(t *testing.T) {
+	workspaceID := uint(2)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICommentRepository, workspaceRepo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, resComment domain.Comment, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICommentRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &workspaceID, resComment.WorkspaceID)
+			},
+		},
+		{
+			name: "Viewer",
+			buildStubs: func(repo *mock.MockICommentRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICommentRepository(mockCtrl)
+			workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo, workspaceRepo)
+
+			usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), workspaceRepo, 5)
+			res, err := usecase.CreateComment(domain.Comment{UserID: 1, ArticleID: 1, WorkspaceID: &workspaceID, Content: "test_content"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestCreateCommentReply(t *testing.T) {
+	parentCommentID := uint(1)
+	workspaceID := uint(2)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockICommentRepository)
+		checkResponse func(t *testing.T, resComment domain.Comment, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, ArticleID: 1, Depth: 1}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &parentCommentID, resComment.ParentCommentID)
+				assert.Equal(t, 2, resComment.Depth)
+			},
+		},
+		{
+			name: "TooDeep",
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, ArticleID: 1, Depth: 5}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "OtherArticle",
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, ArticleID: 2}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "OtherWorkspace",
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{ID: 1, ArticleID: 1, WorkspaceID: &workspaceID}, nil)
+				repo.EXPECT().CreateComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "ParentNotFound",
+			buildStubs: func(repo *mock.MockICommentRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resComment domain.Comment, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockICommentRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			res, err := usecase.CreateComment(domain.Comment{UserID: 1, ArticleID: 1, ParentCommentID: &parentCommentID, Content: "test_content"})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestListWorkspaceComments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockICommentRepository(mockCtrl)
+	workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 1).Return(&domain.WorkspaceMember{WorkspaceID: 2, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+	workspaceRepo.EXPECT().GetWorkspaceMember(2, 3).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+	repo.EXPECT().ListCommentsByWorkspaceIDAndArticleID(2, 1, 0, pagination.DefaultPageSize, "").Return(&[]domain.Comment{{ID: 1}}, "", nil)
+
+	usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), workspaceRepo, 5)
+	res, _, err := usecase.ListWorkspaceComments(1, 2, 1, 0, 0, "")
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	_, _, err = usecase.ListWorkspaceComments(3, 2, 1, 0, 0, "")
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
 func TestListCommentsByUserID(t *testing.T) {
 	type args struct {
 		userID int
@@ -109,7 +253,7 @@ func TestListCommentsByUserID(t *testing.T) {
 				userID: 1,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByUserID(gomock.Any()).Return(repoResComments, nil)
+				repo.EXPECT().ListCommentsByUserID(gomock.Any(), gomock.Any(), gomock.Any()).Return(repoResComments, "", nil)
 			},
 			checkResponse: func(t *testing.T, resComments []domain.Comment, err error) {
 				assert.NoError(t, err)
@@ -126,7 +270,7 @@ func TestListCommentsByUserID(t *testing.T) {
 			name: "NotFound",
 			args: args{},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByUserID(gomock.Any()).Return(&[]domain.Comment{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListCommentsByUserID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Comment{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resComments []domain.Comment, err error) {
 				assert.Error(t, err)
@@ -142,8 +286,8 @@ func TestListCommentsByUserID(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewCommentUsecase(repo)
-			resComments, err := usecase.ListCommentsByUserID(tc.args.userID)
+			usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			resComments, _, err := usecase.ListCommentsByUserID(tc.args.userID, 10, "")
 			tc.checkResponse(t, resComments, err)
 		})
 	}
@@ -185,7 +329,7 @@ func TestListCommentsByArticleID(t *testing.T) {
 				articleID: 1,
 			},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByArticleID(gomock.Any()).Return(repoResComments, nil)
+				repo.EXPECT().ListCommentsByArticleID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(repoResComments, "", nil)
 			},
 			checkResponse: func(t *testing.T, resComments []domain.Comment, err error) {
 				assert.NoError(t, err)
@@ -202,7 +346,7 @@ func TestListCommentsByArticleID(t *testing.T) {
 			name: "NotFound",
 			args: args{},
 			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().ListCommentsByArticleID(gomock.Any()).Return(&[]domain.Comment{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListCommentsByArticleID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&[]domain.Comment{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resComments []domain.Comment, err error) {
 				assert.Error(t, err)
@@ -218,8 +362,8 @@ func TestListCommentsByArticleID(t *testing.T) {
 			repo := mock.NewMockICommentRepository(mockCtrl)
 			tc.buildStubs(repo)
 
-			usecase := NewCommentUsecase(repo)
-			resComments, err := usecase.ListCommentsByArticleID(tc.args.articleID)
+			usecase := NewCommentUsecase(repo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			resComments, _, err := usecase.ListCommentsByArticleID(tc.args.articleID, 0, 10, "")
 			tc.checkResponse(t, resComments, err)
 		})
 	}
@@ -227,37 +371,106 @@ func TestListCommentsByArticleID(t *testing.T) {
 
 func TestDeleteComment(t *testing.T) {
 	type args struct {
-		id int
+		userID int
+		id     int
 	}
 
+	workspaceID := uint(1)
+	repoResComment := domain.Comment{ID: 1, UserID: 1, ArticleID: 1}
+	repoResWorkspaceComment := domain.Comment{ID: 2, UserID: 1, ArticleID: 1, WorkspaceID: &workspaceID}
+
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository)
 		checkResponse func(t *testing.T, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				id: 1,
+				userID: 1,
+				id:     1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteComment(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(1).Return(&repoResComment, nil)
+				repo.EXPECT().DeleteComment(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Moderator",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(1).Return(&repoResComment, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().DeleteComment(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				userID: 2,
+				id:     1,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(1).Return(&repoResComment, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "WorkspaceEditor",
+			args: args{
+				userID: 2,
+				id:     2,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(2).Return(&repoResWorkspaceComment, nil)
+				workspaceRepo.EXPECT().GetWorkspaceMember(1, 2).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().DeleteComment(2).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "WorkspaceViewer",
+			args: args{
+				userID: 2,
+				id:     2,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(2).Return(&repoResWorkspaceComment, nil)
+				workspaceRepo.EXPECT().GetWorkspaceMember(1, 2).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().DeleteComment(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				id: 1,
+				userID: 1,
+				id:     1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteComment(gomock.Any()).Return(gorm.ErrRecordNotFound)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository, workspaceRepo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetComment(1).Return(&domain.Comment{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().DeleteComment(gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, err error) {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 			},
 		},
 	}
@@ -268,10 +481,12 @@ func TestDeleteComment(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			workspaceRepo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo, workspaceRepo)
 
-			usecase := NewCommentUsecase(repo)
-			err := usecase.DeleteComment(tc.args.id)
+			usecase := NewCommentUsecase(repo, userRepo, workspaceRepo, 5)
+			err := usecase.DeleteComment(tc.args.userID, tc.args.id)
 			tc.checkResponse(t, err)
 		})
 	}
@@ -279,36 +494,54 @@ func TestDeleteComment(t *testing.T) {
 
 func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 	type args struct {
-		userID    int
-		articleID int
+		actingUserID int
+		userID       int
+		articleID    int
 	}
 
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				userID:    1,
-				articleID: 1,
+				actingUserID: 1,
+				userID:       1,
+				articleID:    1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteCommentByUserIDAndArticleID(gomock.Any(), gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().DeleteCommentByUserIDAndArticleID(1, 1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				actingUserID: 2,
+				userID:       1,
+				articleID:    1,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteCommentByUserIDAndArticleID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
 		{
 			name: "NotFound",
 			args: args{
-				userID:    1,
-				articleID: 1,
+				actingUserID: 1,
+				userID:       1,
+				articleID:    1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
 				repo.EXPECT().DeleteCommentByUserIDAndArticleID(gomock.Any(), gomock.Any()).Return(gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, err error) {
@@ -323,10 +556,11 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := NewCommentUsecase(repo)
-			err := usecase.DeleteCommentByUserIDAndArticleID(tc.args.userID, tc.args.articleID)
+			usecase := NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			err := usecase.DeleteCommentByUserIDAndArticleID(tc.args.actingUserID, tc.args.userID, tc.args.articleID)
 			tc.checkResponse(t, err)
 		})
 	}
@@ -334,37 +568,55 @@ func TestDeleteCommentByUserIDAndArticleID(t *testing.T) {
 
 func TestDeleteCommentByUserID(t *testing.T) {
 	type args struct {
-		userID int
+		actingUserID int
+		userID       int
 	}
 
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
-				userID: 1,
+				actingUserID: 1,
+				userID:       1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().DeleteCommentByUserID(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.NoError(t, err)
 			},
 		},
 		{
-			name: "NotFound",
+			name: "Moderator",
 			args: args{
-				userID: 1,
+				actingUserID: 2,
+				userID:       1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Return(gorm.ErrRecordNotFound)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().DeleteCommentByUserID(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
-				assert.Error(t, err)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			args: args{
+				actingUserID: 2,
+				userID:       1,
+			},
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteCommentByUserID(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
 			},
 		},
 	}
@@ -375,10 +627,11 @@ func TestDeleteCommentByUserID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := NewCommentUsecase(repo)
-			err := usecase.DeleteCommentByUserID(tc.args.userID)
+			usecase := NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			err := usecase.DeleteCommentByUserID(tc.args.actingUserID, tc.args.userID)
 			tc.checkResponse(t, err)
 		})
 	}
@@ -386,37 +639,42 @@ func TestDeleteCommentByUserID(t *testing.T) {
 
 func TestDeleteCommentByArticleID(t *testing.T) {
 	type args struct {
+		userID    int
 		articleID int
 	}
 
 	testCases := []struct {
 		name          string
 		args          args
-		buildStubs    func(repo *mock.MockICommentRepository)
+		buildStubs    func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository)
 		checkResponse func(t *testing.T, err error)
 	}{
 		{
 			name: "OK",
 			args: args{
+				userID:    1,
 				articleID: 1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteCommentByArticleID(gomock.Any()).Return(nil)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
+				repo.EXPECT().DeleteCommentByArticleID(1).Return(nil)
 			},
 			checkResponse: func(t *testing.T, err error) {
 				assert.NoError(t, err)
 			},
 		},
 		{
-			name: "NotFound",
+			name: "PermissionDenied",
 			args: args{
+				userID:    1,
 				articleID: 1,
 			},
-			buildStubs: func(repo *mock.MockICommentRepository) {
-				repo.EXPECT().DeleteCommentByArticleID(gomock.Any()).Return(gorm.ErrRecordNotFound)
+			buildStubs: func(repo *mock.MockICommentRepository, userRepo *mock.MockIUserRepository) {
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleUser}, nil)
+				repo.EXPECT().DeleteCommentByArticleID(gomock.Any()).Times(0)
 			},
 			checkResponse: func(t *testing.T, err error) {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
 			},
 		},
 	}
@@ -427,10 +685,11 @@ func TestDeleteCommentByArticleID(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			repo := mock.NewMockICommentRepository(mockCtrl)
-			tc.buildStubs(repo)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
 
-			usecase := NewCommentUsecase(repo)
-			err := usecase.DeleteCommentByArticleID(tc.args.articleID)
+			usecase := NewCommentUsecase(repo, userRepo, mock.NewMockIWorkspaceRepository(mockCtrl), 5)
+			err := usecase.DeleteCommentByArticleID(tc.args.userID, tc.args.articleID)
 			tc.checkResponse(t, err)
 		})
 	}
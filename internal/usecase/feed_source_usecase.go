@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/feed"
+	"github.com/loak155/techbranch-backend/pkg/htmltext"
+	"github.com/loak155/techbranch-backend/pkg/opml"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/loak155/techbranch-backend/pkg/urlnorm"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	opmlTitle          = "Techbranch feed sources"
+	dueFeedSourceLimit = 20
+	maxFeedItemTags    = 20
+	maxFeedItemTagLen  = 50
+)
+
+type IFeedSourceUsecase interface {
+	CreateFeedSource(userID int, feedSource domain.FeedSource) (domain.FeedSource, error)
+	GetFeedSource(id int) (domain.FeedSource, error)
+	ListFeedSources(pageSize int, pageToken string) ([]domain.FeedSource, string, error)
+	DeleteFeedSource(userID, id int) error
+	FetchDueFeedSources() error
+	ImportOPML(userID int, data []byte) ([]domain.FeedSource, []domain.SkippedOutline, error)
+	ExportOPML() ([]byte, error)
+}
+
+type feedSourceUsecase struct {
+	repo           repository.IFeedSourceRepository
+	userRepo       repository.IUserRepository
+	articleUsecase IArticleUsecase
+	fetcher        *feed.Fetcher
+	fetchInterval  time.Duration
+	maxBackoff     time.Duration
+}
+
+func NewFeedSourceUsecase(repo repository.IFeedSourceRepository, userRepo repository.IUserRepository, articleUsecase IArticleUsecase, fetcher *feed.Fetcher, fetchInterval, maxBackoff time.Duration) IFeedSourceUsecase {
+	return &feedSourceUsecase{repo, userRepo, articleUsecase, fetcher, fetchInterval, maxBackoff}
+}
+
+func (usecase *feedSourceUsecase) CreateFeedSource(userID int, feedSource domain.FeedSource) (domain.FeedSource, error) {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return domain.FeedSource{}, err
+	}
+	return usecase.createFeedSource(feedSource)
+}
+
+func (usecase *feedSourceUsecase) createFeedSource(feedSource domain.FeedSource) (domain.FeedSource, error) {
+	normalizedUrl, err := urlnorm.Normalize(feedSource.Url)
+	if err != nil {
+		return domain.FeedSource{}, err
+	}
+	_, err = usecase.repo.GetFeedSourceByUrl(normalizedUrl)
+	if err == nil {
+		return domain.FeedSource{}, domain.ErrAlreadyExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.FeedSource{}, err
+	}
+
+	feedSource.Url = normalizedUrl
+	feedSource.NextFetchAt = time.Now()
+	if err := usecase.repo.CreateFeedSource(&feedSource); err != nil {
+		return domain.FeedSource{}, err
+	}
+	return feedSource, nil
+}
+
+func (usecase *feedSourceUsecase) GetFeedSource(id int) (domain.FeedSource, error) {
+	feedSource, err := usecase.repo.GetFeedSource(id)
+	if err != nil {
+		return domain.FeedSource{}, err
+	}
+	return *feedSource, nil
+}
+
+func (usecase *feedSourceUsecase) ListFeedSources(pageSize int, pageToken string) ([]domain.FeedSource, string, error) {
+	feedSources, nextPageToken, err := usecase.repo.ListFeedSources(pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.FeedSource{}, "", err
+	}
+	return *feedSources, nextPageToken, nil
+}
+
+func (usecase *feedSourceUsecase) DeleteFeedSource(userID, id int) error {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return err
+	}
+	err := usecase.repo.DeleteFeedSource(id)
+	return err
+}
+
+func (usecase *feedSourceUsecase) ImportOPML(userID int, data []byte) ([]domain.FeedSource, []domain.SkippedOutline, error) {
+	if err := requireModerator(usecase.userRepo, userID); err != nil {
+		return []domain.FeedSource{}, []domain.SkippedOutline{}, err
+	}
+	outlines, err := opml.Parse(data)
+	if err != nil {
+		return []domain.FeedSource{}, []domain.SkippedOutline{}, err
+	}
+
+	created := []domain.FeedSource{}
+	skipped := []domain.SkippedOutline{}
+	for _, outline := range outlines {
+		title := outline.Title
+		if title == "" {
+			title = outline.Text
+		}
+		if outline.XmlUrl == "" {
+			skipped = append(skipped, domain.SkippedOutline{Title: title, Reason: "missing xmlUrl"})
+			continue
+		}
+		if _, err := urlnorm.Normalize(outline.XmlUrl); err != nil {
+			skipped = append(skipped, domain.SkippedOutline{Title: title, Url: outline.XmlUrl, Reason: "invalid url"})
+			continue
+		}
+
+		feedSource, err := usecase.createFeedSource(domain.FeedSource{Url: outline.XmlUrl, Title: title})
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			skipped = append(skipped, domain.SkippedOutline{Title: title, Url: outline.XmlUrl, Reason: "already exists"})
+			continue
+		}
+		if err != nil {
+			return created, skipped, err
+		}
+		created = append(created, feedSource)
+	}
+	return created, skipped, nil
+}
+
+func (usecase *feedSourceUsecase) ExportOPML() ([]byte, error) {
+	feedSources, err := usecase.repo.ListAllFeedSources()
+	if err != nil {
+		return nil, err
+	}
+
+	outlines := []opml.Outline{}
+	for _, feedSource := range *feedSources {
+		text := feedSource.Title
+		if text == "" {
+			text = feedSource.Url
+		}
+		outlines = append(outlines, opml.Outline{Text: text, Title: feedSource.Title, Type: "rss", XmlUrl: feedSource.Url})
+	}
+	return opml.Marshal(opmlTitle, outlines)
+}
+
+func (usecase *feedSourceUsecase) FetchDueFeedSources() error {
+	feedSources, err := usecase.repo.ListDueFeedSources(time.Now(), dueFeedSourceLimit)
+	if err != nil {
+		return err
+	}
+	for _, feedSource := range *feedSources {
+		usecase.fetchFeedSource(&feedSource)
+		if err := usecase.repo.UpdateFeedSourceFetchState(&feedSource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (usecase *feedSourceUsecase) fetchFeedSource(feedSource *domain.FeedSource) {
+	now := time.Now()
+	feedSource.LastFetchedAt = &now
+
+	failed := 0
+	result, err := usecase.fetcher.Fetch(feedSource.Url, feedSource.Etag, feedSource.LastModified)
+	if err == nil && !result.NotModified {
+		if feedSource.Title == "" {
+			feedSource.Title = result.Feed.Title
+		}
+		failed, err = usecase.importFeedItems(feedSource, result.Feed.Items)
+	}
+	if err != nil {
+		feedSource.ErrorCount++
+		feedSource.LastError = err.Error()
+		feedSource.NextFetchAt = now.Add(usecase.backoff(feedSource.ErrorCount))
+		return
+	}
+
+	if failed == 0 {
+		feedSource.Etag = result.Etag
+		feedSource.LastModified = result.LastModified
+	}
+	feedSource.ErrorCount = 0
+	feedSource.LastError = ""
+	feedSource.NextFetchAt = now.Add(usecase.fetchInterval)
+}
+
+func (usecase *feedSourceUsecase) importFeedItems(feedSource *domain.FeedSource, items []feed.Item) (int, error) {
+	base, err := url.Parse(feedSource.Url)
+	if err != nil {
+		return 0, err
+	}
+	failed := 0
+	for i := len(items) - 1; i >= 0; i-- {
+		if err := usecase.importFeedItem(base, items[i]); err != nil {
+			log.Warn().Err(err).Uint("feed_source_id", feedSource.ID).Str("url", items[i].Url).Msg("failed to import feed item")
+			failed++
+		}
+	}
+	return failed, nil
+}
+
+func (usecase *feedSourceUsecase) importFeedItem(base *url.URL, item feed.Item) error {
+	if item.Url == "" {
+		return nil
+	}
+	link, err := base.Parse(item.Url)
+	if err != nil {
+		return nil
+	}
+	if _, err := urlnorm.Normalize(link.String()); err != nil {
+		return nil
+	}
+	exists, err := usecase.articleUsecase.ExistsArticleByUrl(link.String())
+	if err != nil || exists {
+		return err
+	}
+
+	article := domain.Article{
+		Title:       item.Title,
+		Url:         link.String(),
+		Description: htmltext.Extract(item.Description),
+		Body:        item.Body,
+		Tags:        feedItemTags(item.Tags),
+	}
+	if article.Title == "" {
+		article.Title = article.Url
+	}
+	if image, err := base.Parse(item.Image); err == nil && item.Image != "" {
+		article.Image = image.String()
+	}
+	_, err = usecase.articleUsecase.CreateArticle(article)
+	return err
+}
+
+func (usecase *feedSourceUsecase) backoff(errorCount int) time.Duration {
+	backoff := usecase.fetchInterval
+	for i := 0; i < errorCount && backoff < usecase.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > usecase.maxBackoff {
+		backoff = usecase.maxBackoff
+	}
+	return backoff
+}
+
+func feedItemTags(tags []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || len(tag) > maxFeedItemTagLen || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+		if len(result) == maxFeedItemTags {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/feed"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Example Blog</title>
+    <item>
+      <title>Second Post</title>
+      <link>https://example.com/posts/2/?utm_source=rss</link>
+      <description>&lt;p&gt;second&lt;/p&gt;</description>
+      <category>go</category>
+    </item>
+    <item>
+      <title>First Post</title>
+      <link>/posts/1</link>
+      <description>first</description>
+    </item>
+  </channel>
+</rss>`
+
+func TestCreateFeedSource(t *testing.T) {
+	type args struct {
+		feedSource domain.FeedSource
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIFeedSourceRepository)
+		checkResponse func(t *testing.T, resFeedSource domain.FeedSource, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				feedSource: domain.FeedSource{Url: "HTTPS://Example.com/feed/"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl("https://example.com/feed").Return(&domain.FeedSource{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().CreateFeedSource(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resFeedSource domain.FeedSource, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://example.com/feed", resFeedSource.Url)
+				assert.False(t, resFeedSource.NextFetchAt.IsZero())
+			},
+		},
+		{
+			name: "AlreadyExists",
+			args: args{
+				feedSource: domain.FeedSource{Url: "https://example.com/feed"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl("https://example.com/feed").Return(&domain.FeedSource{ID: 1}, nil)
+				repo.EXPECT().CreateFeedSource(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resFeedSource domain.FeedSource, err error) {
+				assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+			},
+		},
+		{
+			name: "InvalidUrl",
+			args: args{
+				feedSource: domain.FeedSource{Url: "ftp://example.com/feed"},
+			},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().GetFeedSourceByUrl(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resFeedSource domain.FeedSource, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
+			tc.buildStubs(repo)
+
+			usecase := NewFeedSourceUsecase(repo, userRepo, NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl)), feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+			resFeedSource, err := usecase.CreateFeedSource(1, tc.args.feedSource)
+			tc.checkResponse(t, resFeedSource, err)
+		})
+	}
+}
+
+func TestFeedSourceRequiresModerator(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+	repo.EXPECT().CreateFeedSource(gomock.Any()).Times(0)
+	repo.EXPECT().DeleteFeedSource(gomock.Any()).Times(0)
+	userRepo := mock.NewMockIUserRepository(mockCtrl)
+	userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Role: domain.UserRoleUser}, nil).Times(3)
+
+	usecase := NewFeedSourceUsecase(repo, userRepo, NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl)), feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+	_, err := usecase.CreateFeedSource(2, domain.FeedSource{Url: "https://example.com/feed"})
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	err = usecase.DeleteFeedSource(2, 1)
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	_, _, err = usecase.ImportOPML(2, []byte(`<opml version="2.0"><body><outline text="Example" xmlUrl="https://example.com/feed"/></body></opml>`))
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestFetchDueFeedSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte(testRSS))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name       string
+		feedSource domain.FeedSource
+		buildStubs func(repo *mock.MockIFeedSourceRepository, articleRepo *mock.MockIArticleRepository)
+	}{
+		{
+			name:       "OK",
+			feedSource: domain.FeedSource{ID: 1, Url: server.URL + "/feed"},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository, articleRepo *mock.MockIArticleRepository) {
+				gomock.InOrder(
+					articleRepo.EXPECT().ExistsArticleByNormalizedUrl(server.URL+"/posts/1").Return(true, nil),
+					articleRepo.EXPECT().ExistsArticleByNormalizedUrl("https://example.com/posts/2").Return(false, nil),
+					articleRepo.EXPECT().CreateArticle(gomock.Any()).DoAndReturn(func(article *domain.Article) error {
+						assert.Equal(t, "Second Post", article.Title)
+						assert.Equal(t, "https://example.com/posts/2", article.NormalizedUrl)
+						assert.Equal(t, "second", article.Description)
+						assert.Equal(t, []string{"go"}, []string(article.Tags))
+						return nil
+					}),
+				)
+				repo.EXPECT().UpdateFeedSourceFetchState(gomock.Any()).DoAndReturn(func(feedSource *domain.FeedSource) error {
+					assert.Equal(t, "Example Blog", feedSource.Title)
+					assert.Equal(t, `"v1"`, feedSource.Etag)
+					assert.Equal(t, 0, feedSource.ErrorCount)
+					assert.WithinDuration(t, time.Now().Add(time.Hour), feedSource.NextFetchAt, time.Minute)
+					return nil
+				})
+			},
+		},
+		{
+			name:       "ItemFailed",
+			feedSource: domain.FeedSource{ID: 1, Url: server.URL + "/feed"},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository, articleRepo *mock.MockIArticleRepository) {
+				gomock.InOrder(
+					articleRepo.EXPECT().ExistsArticleByNormalizedUrl(server.URL+"/posts/1").Return(false, nil),
+					articleRepo.EXPECT().CreateArticle(gomock.Any()).Return(gorm.ErrInvalidDB),
+					articleRepo.EXPECT().ExistsArticleByNormalizedUrl("https://example.com/posts/2").Return(false, nil),
+					articleRepo.EXPECT().CreateArticle(gomock.Any()).Return(nil),
+				)
+				repo.EXPECT().UpdateFeedSourceFetchState(gomock.Any()).DoAndReturn(func(feedSource *domain.FeedSource) error {
+					assert.Equal(t, 0, feedSource.ErrorCount)
+					assert.Empty(t, feedSource.LastError)
+					assert.Empty(t, feedSource.Etag)
+					assert.WithinDuration(t, time.Now().Add(time.Hour), feedSource.NextFetchAt, time.Minute)
+					return nil
+				})
+			},
+		},
+		{
+			name:       "NotModified",
+			feedSource: domain.FeedSource{ID: 1, Url: server.URL + "/feed", Etag: `"v1"`},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository, articleRepo *mock.MockIArticleRepository) {
+				articleRepo.EXPECT().CreateArticle(gomock.Any()).Times(0)
+				repo.EXPECT().UpdateFeedSourceFetchState(gomock.Any()).DoAndReturn(func(feedSource *domain.FeedSource) error {
+					assert.Equal(t, `"v1"`, feedSource.Etag)
+					assert.NotNil(t, feedSource.LastFetchedAt)
+					return nil
+				})
+			},
+		},
+		{
+			name:       "Backoff",
+			feedSource: domain.FeedSource{ID: 1, Url: server.URL + "/broken", ErrorCount: 2},
+			buildStubs: func(repo *mock.MockIFeedSourceRepository, articleRepo *mock.MockIArticleRepository) {
+				articleRepo.EXPECT().CreateArticle(gomock.Any()).Times(0)
+				repo.EXPECT().UpdateFeedSourceFetchState(gomock.Any()).DoAndReturn(func(feedSource *domain.FeedSource) error {
+					assert.Equal(t, 3, feedSource.ErrorCount)
+					assert.Contains(t, feedSource.LastError, "500")
+					assert.WithinDuration(t, time.Now().Add(8*time.Hour), feedSource.NextFetchAt, time.Minute)
+					return nil
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			repo.EXPECT().ListDueFeedSources(gomock.Any(), dueFeedSourceLimit).Return(&[]domain.FeedSource{tc.feedSource}, nil)
+			revisionRepo := mock.NewMockIArticleRevisionRepository(mockCtrl)
+			tc.buildStubs(repo, articleRepo)
+
+			usecase := NewFeedSourceUsecase(repo, mock.NewMockIUserRepository(mockCtrl), NewArticleUsecase(articleRepo, mock.NewMockIUserRepository(mockCtrl), revisionRepo), feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+			err := usecase.FetchDueFeedSources()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFetchDueFeedSourcesPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testRSS))
+	}))
+	defer server.Close()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+	articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+	repo.EXPECT().ListDueFeedSources(gomock.Any(), dueFeedSourceLimit).Return(&[]domain.FeedSource{{ID: 1, Url: server.URL + "/feed"}}, nil)
+	articleRepo.EXPECT().CreateArticle(gomock.Any()).Times(0)
+	repo.EXPECT().UpdateFeedSourceFetchState(gomock.Any()).DoAndReturn(func(feedSource *domain.FeedSource) error {
+		assert.Equal(t, 1, feedSource.ErrorCount)
+		assert.Contains(t, feedSource.LastError, "disallowed address")
+		return nil
+	})
+
+	usecase := NewFeedSourceUsecase(repo, mock.NewMockIUserRepository(mockCtrl), NewArticleUsecase(articleRepo, mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl)), feed.NewFetcher(safehttp.NewClient(time.Second)), time.Hour, 24*time.Hour)
+	err := usecase.FetchDueFeedSources()
+	assert.NoError(t, err)
+}
+
+func TestImportOPML(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline text="Tech">
+      <outline text="Example" title="Example Blog" type="rss" xmlUrl="https://example.com/feed"/>
+      <outline text="Existing" type="rss" xmlUrl="https://existing.com/feed"/>
+    </outline>
+    <outline text="Broken" type="rss" xmlUrl="mailto:feed@example.com"/>
+    <outline text="No Feed" htmlUrl="https://nofeed.com"/>
+  </body>
+</opml>`)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+	repo.EXPECT().GetFeedSourceByUrl("https://example.com/feed").Return(&domain.FeedSource{}, gorm.ErrRecordNotFound)
+	repo.EXPECT().CreateFeedSource(gomock.Any()).Return(nil)
+	repo.EXPECT().GetFeedSourceByUrl("https://existing.com/feed").Return(&domain.FeedSource{ID: 1}, nil)
+	userRepo := mock.NewMockIUserRepository(mockCtrl)
+	userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Role: domain.UserRoleModerator}, nil)
+
+	usecase := NewFeedSourceUsecase(repo, userRepo, NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), userRepo, mock.NewMockIArticleRevisionRepository(mockCtrl)), feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+	created, skipped, err := usecase.ImportOPML(1, data)
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	assert.Equal(t, "Example Blog", created[0].Title)
+	assert.Equal(t, []domain.SkippedOutline{
+		{Title: "Existing", Url: "https://existing.com/feed", Reason: "already exists"},
+		{Title: "Broken", Url: "mailto:feed@example.com", Reason: "invalid url"},
+		{Title: "No Feed", Reason: "missing xmlUrl"},
+	}, skipped)
+}
+
+func TestExportOPML(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIFeedSourceRepository)
+		checkResponse func(t *testing.T, data []byte, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().ListAllFeedSources().Return(&[]domain.FeedSource{
+					{ID: 1, Url: "https://example.com/feed", Title: "Example Blog"},
+					{ID: 2, Url: "https://example2.com/feed"},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, data []byte, err error) {
+				assert.NoError(t, err)
+				assert.Contains(t, string(data), `<outline text="Example Blog" title="Example Blog" type="rss" xmlUrl="https://example.com/feed"></outline>`)
+				assert.Contains(t, string(data), `<outline text="https://example2.com/feed" type="rss" xmlUrl="https://example2.com/feed"></outline>`)
+			},
+		},
+		{
+			name: "InvalidData",
+			buildStubs: func(repo *mock.MockIFeedSourceRepository) {
+				repo.EXPECT().ListAllFeedSources().Return(&[]domain.FeedSource{}, gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, data []byte, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIFeedSourceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewFeedSourceUsecase(repo, mock.NewMockIUserRepository(mockCtrl), NewArticleUsecase(mock.NewMockIArticleRepository(mockCtrl), mock.NewMockIUserRepository(mockCtrl), mock.NewMockIArticleRevisionRepository(mockCtrl)), feed.NewFetcher(&http.Client{Timeout: time.Second}), time.Hour, 24*time.Hour)
+			data, err := usecase.ExportOPML()
+			tc.checkResponse(t, data, err)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/imageproc"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/loak155/techbranch-backend/pkg/storage"
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
+	"golang.org/x/sync/singleflight"
+)
+
+const maxSourceImageBytes = 10 << 20
+
+var thumbnailSizes = []struct {
+	name  string
+	width int
+}{
+	{"small", 160},
+	{"medium", 320},
+	{"large", 640},
+}
+
+var thumbnailFormats = []string{imageproc.FormatWebP, imageproc.FormatJPEG}
+
+type IImageUsecase interface {
+	GetThumbnailUrls(sourceUrl string) []domain.ImageThumbnail
+	GetThumbnail(path string) (*storage.Object, error)
+}
+
+type imageUsecase struct {
+	store   storage.Store
+	client  *http.Client
+	signer  *urlsign.Signer
+	baseUrl string
+	group   *singleflight.Group
+}
+
+func NewImageUsecase(store storage.Store, client *http.Client, signer *urlsign.Signer, baseUrl string) IImageUsecase {
+	return &imageUsecase{store, client, signer, strings.TrimSuffix(baseUrl, "/"), &singleflight.Group{}}
+}
+
+func (usecase *imageUsecase) GetThumbnailUrls(sourceUrl string) []domain.ImageThumbnail {
+	if sourceUrl == "" {
+		return []domain.ImageThumbnail{}
+	}
+	thumbnails := []domain.ImageThumbnail{}
+	for _, size := range thumbnailSizes {
+		thumbnails = append(thumbnails, domain.ImageThumbnail{
+			Size:    size.name,
+			Width:   size.width,
+			WebpUrl: usecase.baseUrl + "/" + usecase.thumbnailPath(sourceUrl, size.name, imageproc.FormatWebP),
+			JpegUrl: usecase.baseUrl + "/" + usecase.thumbnailPath(sourceUrl, size.name, imageproc.FormatJPEG),
+		})
+	}
+	return thumbnails
+}
+
+func (usecase *imageUsecase) GetThumbnail(path string) (*storage.Object, error) {
+	sourceUrl, size, format, err := usecase.parseThumbnailPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := thumbnailKey(sourceUrl, size, format)
+	object, err := usecase.store.Get(key)
+	if !errors.Is(err, storage.ErrNotFound) {
+		return object, err
+	}
+	_, err, _ = usecase.group.Do(sourceUrl, func() (interface{}, error) {
+		return nil, usecase.generateThumbnails(sourceUrl)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usecase.store.Get(key)
+}
+
+func (usecase *imageUsecase) thumbnailPath(sourceUrl, size, format string) string {
+	value := fmt.Sprintf("%s/%s.%s", size, base64.RawURLEncoding.EncodeToString([]byte(sourceUrl)), format)
+	return usecase.signer.Sign(value) + "/" + value
+}
+
+func (usecase *imageUsecase) parseThumbnailPath(path string) (string, string, string, error) {
+	signature, value, ok := strings.Cut(path, "/")
+	if !ok || !usecase.signer.Verify(value, signature) {
+		return "", "", "", domain.ErrInvalidSignature
+	}
+	size, name, ok := strings.Cut(value, "/")
+	if !ok || !validThumbnailSize(size) {
+		return "", "", "", storage.ErrNotFound
+	}
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", "", "", storage.ErrNotFound
+	}
+	format := name[i+1:]
+	if format != imageproc.FormatWebP && format != imageproc.FormatJPEG {
+		return "", "", "", storage.ErrNotFound
+	}
+	source, err := base64.RawURLEncoding.DecodeString(name[:i])
+	if err != nil {
+		return "", "", "", storage.ErrNotFound
+	}
+	return string(source), size, format, nil
+}
+
+func (usecase *imageUsecase) generateThumbnails(sourceUrl string) error {
+	data, err := usecase.fetchImage(sourceUrl)
+	if err != nil {
+		return err
+	}
+	img, err := imageproc.Decode(data)
+	if err != nil {
+		return err
+	}
+	for _, size := range thumbnailSizes {
+		thumbnail := imageproc.Resize(img, size.width)
+		for _, format := range thumbnailFormats {
+			buf := &bytes.Buffer{}
+			if err := imageproc.Encode(buf, thumbnail, format); err != nil {
+				return err
+			}
+			if err := usecase.store.Put(thumbnailKey(sourceUrl, size.name, format), buf, imageproc.ContentType(format)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (usecase *imageUsecase) fetchImage(sourceUrl string) ([]byte, error) {
+	u, err := url.Parse(sourceUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := safehttp.CheckScheme(u.Scheme); err != nil {
+		return nil, err
+	}
+	resp, err := usecase.client.Get(sourceUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSourceImageBytes {
+		return nil, imageproc.ErrTooLarge
+	}
+	return data, nil
+}
+
+func validThumbnailSize(name string) bool {
+	for _, size := range thumbnailSizes {
+		if size.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func thumbnailKey(sourceUrl, size, format string) string {
+	sum := sha256.Sum256([]byte(sourceUrl))
+	return fmt.Sprintf("thumbnails/%s/%s.%s", hex.EncodeToString(sum[:]), size, format)
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+	"github.com/loak155/techbranch-backend/pkg/storage"
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
+	"github.com/stretchr/testify/assert"
+	_ "golang.org/x/image/webp"
+)
+
+func testPNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestGetThumbnailUrls(t *testing.T) {
+	usecase := NewImageUsecase(nil, nil, urlsign.NewSigner("secret"), "http://localhost:8080/images/")
+
+	thumbnails := usecase.GetThumbnailUrls("https://example.com/ogp.png")
+	assert.Len(t, thumbnails, 3)
+	assert.Equal(t, "small", thumbnails[0].Size)
+	assert.Equal(t, 160, thumbnails[0].Width)
+	assert.True(t, strings.HasPrefix(thumbnails[0].WebpUrl, "http://localhost:8080/images/"))
+	assert.True(t, strings.HasSuffix(thumbnails[0].WebpUrl, ".webp"))
+	assert.True(t, strings.HasSuffix(thumbnails[0].JpegUrl, ".jpeg"))
+
+	assert.Empty(t, usecase.GetThumbnailUrls(""))
+}
+
+func TestGetThumbnail(t *testing.T) {
+	var requests int32
+	data := testPNG(t, 800, 400)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/ogp.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(data)
+		case "/text":
+			w.Write([]byte("not an image"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	store, err := storage.NewLocalStore(t.TempDir(), "http://localhost:8080/files", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	usecase := NewImageUsecase(store, &http.Client{Timeout: time.Second}, urlsign.NewSigner("secret"), "/images")
+	path := func(sourceUrl string, i int, webp bool) string {
+		thumbnail := usecase.GetThumbnailUrls(sourceUrl)[i]
+		if webp {
+			return strings.TrimPrefix(thumbnail.WebpUrl, "/images/")
+		}
+		return strings.TrimPrefix(thumbnail.JpegUrl, "/images/")
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		object, err := usecase.GetThumbnail(path(server.URL+"/ogp.png", 0, true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer object.Body.Close()
+		assert.Equal(t, "image/webp", object.ContentType)
+		img, format, err := image.Decode(object.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "webp", format)
+		assert.Equal(t, image.Rect(0, 0, 160, 80), img.Bounds())
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		before := atomic.LoadInt32(&requests)
+		object, err := usecase.GetThumbnail(path(server.URL+"/ogp.png", 2, false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer object.Body.Close()
+		assert.Equal(t, "image/jpeg", object.ContentType)
+		img, _, err := image.Decode(object.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, image.Rect(0, 0, 640, 320), img.Bounds())
+		assert.Equal(t, before, atomic.LoadInt32(&requests))
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		_, err := usecase.GetThumbnail("invalid/" + strings.SplitN(path(server.URL+"/ogp.png", 0, true), "/", 2)[1])
+		assert.ErrorIs(t, err, domain.ErrInvalidSignature)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := usecase.GetThumbnail(path(server.URL+"/missing.png", 0, true))
+		assert.ErrorContains(t, err, "404")
+	})
+
+	t.Run("NotImage", func(t *testing.T) {
+		_, err := usecase.GetThumbnail(path(server.URL+"/text", 0, true))
+		assert.Error(t, err)
+	})
+
+	t.Run("PrivateAddress", func(t *testing.T) {
+		usecase := NewImageUsecase(store, safehttp.NewClient(time.Second), urlsign.NewSigner("secret"), "/images")
+		thumbnail := usecase.GetThumbnailUrls(server.URL + "/other.png")[0]
+		_, err := usecase.GetThumbnail(strings.TrimPrefix(thumbnail.WebpUrl, "/images/"))
+		assert.ErrorIs(t, err, safehttp.ErrDisallowedAddress)
+	})
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+func requireModerator(userRepo repository.IUserRepository, userID int) error {
+	user, err := userRepo.GetUser(userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsModerator() {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func requireWorkspaceRole(workspaceRepo repository.IWorkspaceRepository, workspaceID, userID int, role string) (domain.WorkspaceMember, error) {
+	member, err := workspaceRepo.GetWorkspaceMember(workspaceID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.WorkspaceMember{}, domain.ErrPermissionDenied
+	}
+	if err != nil {
+		return domain.WorkspaceMember{}, err
+	}
+	if !member.HasRole(role) {
+		return domain.WorkspaceMember{}, domain.ErrPermissionDenied
+	}
+	return *member, nil
+}
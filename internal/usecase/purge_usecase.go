@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/repository"
+)
+
+type IPurgeUsecase interface {
+	PurgeDeleted() error
+}
+
+type purgeUsecase struct {
+	articleRepo  repository.IArticleRepository
+	userRepo     repository.IUserRepository
+	bookmarkRepo repository.IBookmarkRepository
+	commentRepo  repository.ICommentRepository
+	retention    time.Duration
+}
+
+func NewPurgeUsecase(articleRepo repository.IArticleRepository, userRepo repository.IUserRepository, bookmarkRepo repository.IBookmarkRepository, commentRepo repository.ICommentRepository, retention time.Duration) IPurgeUsecase {
+	return &purgeUsecase{articleRepo, userRepo, bookmarkRepo, commentRepo, retention}
+}
+
+func (usecase *purgeUsecase) PurgeDeleted() error {
+	before := time.Now().Add(-usecase.retention)
+	if err := usecase.bookmarkRepo.PurgeDeletedBookmarks(before); err != nil {
+		return err
+	}
+	if err := usecase.commentRepo.PurgeDeletedComments(before); err != nil {
+		return err
+	}
+	if err := usecase.articleRepo.PurgeDeletedArticles(before); err != nil {
+		return err
+	}
+	return usecase.userRepo.PurgeDeletedUsers(before)
+}
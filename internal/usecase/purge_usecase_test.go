@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository, commentRepo *mock.MockICommentRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository, commentRepo *mock.MockICommentRepository) {
+				gomock.InOrder(
+					bookmarkRepo.EXPECT().PurgeDeletedBookmarks(gomock.Any()).Return(nil),
+					commentRepo.EXPECT().PurgeDeletedComments(gomock.Any()).Return(nil),
+					articleRepo.EXPECT().PurgeDeletedArticles(gomock.Any()).Return(nil),
+					userRepo.EXPECT().PurgeDeletedUsers(gomock.Any()).DoAndReturn(func(before time.Time) error {
+						assert.WithinDuration(t, time.Now().Add(-720*time.Hour), before, time.Minute)
+						return nil
+					}),
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidData",
+			buildStubs: func(articleRepo *mock.MockIArticleRepository, userRepo *mock.MockIUserRepository, bookmarkRepo *mock.MockIBookmarkRepository, commentRepo *mock.MockICommentRepository) {
+				bookmarkRepo.EXPECT().PurgeDeletedBookmarks(gomock.Any()).Return(gorm.ErrInvalidData)
+				articleRepo.EXPECT().PurgeDeletedArticles(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			bookmarkRepo := mock.NewMockIBookmarkRepository(mockCtrl)
+			commentRepo := mock.NewMockICommentRepository(mockCtrl)
+			tc.buildStubs(articleRepo, userRepo, bookmarkRepo, commentRepo)
+
+			usecase := NewPurgeUsecase(articleRepo, userRepo, bookmarkRepo, commentRepo, 720*time.Hour)
+			err := usecase.PurgeDeleted()
+			tc.checkResponse(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+)
+
+type IRecommendationUsecase interface {
+	RefreshRecommendations() error
+	ListRecommendedArticles(userID, pageSize int, pageToken string) ([]domain.Article, string, error)
+}
+
+type recommendationUsecase struct {
+	repo         repository.IRecommendationRepository
+	articleRepo  repository.IArticleRepository
+	limitPerUser int
+}
+
+func NewRecommendationUsecase(repo repository.IRecommendationRepository, articleRepo repository.IArticleRepository, limitPerUser int) IRecommendationUsecase {
+	return &recommendationUsecase{repo, articleRepo, limitPerUser}
+}
+
+func (usecase *recommendationUsecase) RefreshRecommendations() error {
+	return usecase.repo.RefreshRecommendations(usecase.limitPerUser)
+}
+
+func (usecase *recommendationUsecase) ListRecommendedArticles(userID, pageSize int, pageToken string) ([]domain.Article, string, error) {
+	pageSize = pagination.PageSize(pageSize)
+	recommendations, nextPageToken, err := usecase.repo.ListRecommendedArticles(userID, pageSize, pageToken)
+	if err != nil {
+		return []domain.Article{}, "", err
+	}
+	if len(*recommendations) == 0 && pageToken == "" {
+		articles, _, err := usecase.articleRepo.ListArticles(domain.ArticleOrderTrending, pageSize, "")
+		if err != nil {
+			return []domain.Article{}, "", err
+		}
+		return *articles, "", nil
+	}
+	articles := make([]domain.Article, 0, len(*recommendations))
+	for _, recommendation := range *recommendations {
+		articles = append(articles, recommendation.Article)
+	}
+	return articles, nextPageToken, nil
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRefreshRecommendations(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIRecommendationRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIRecommendationRepository) {
+				repo.EXPECT().RefreshRecommendations(100).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidData",
+			buildStubs: func(repo *mock.MockIRecommendationRepository) {
+				repo.EXPECT().RefreshRecommendations(gomock.Any()).Return(gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIRecommendationRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewRecommendationUsecase(repo, mock.NewMockIArticleRepository(mockCtrl), 100)
+			err := usecase.RefreshRecommendations()
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestListRecommendedArticles(t *testing.T) {
+	type args struct {
+		userID    int
+		pageSize  int
+		pageToken string
+	}
+
+	repoResRecommendations := []domain.RecommendedArticle{
+		{Article: domain.Article{ID: 3}, Score: 0.9},
+		{Article: domain.Article{ID: 2}, Score: 0.5},
+	}
+	repoResTrending := []domain.Article{{ID: 5}, {ID: 4}}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIRecommendationRepository, articleRepo *mock.MockIArticleRepository)
+		checkResponse func(t *testing.T, resArticles []domain.Article, resNextPageToken string, err error)
+	}{
+		{
+			name: "OK",
+			args: args{userID: 1},
+			buildStubs: func(repo *mock.MockIRecommendationRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, pagination.DefaultPageSize, "").Return(&repoResRecommendations, "next", nil)
+				articleRepo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, resNextPageToken string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []domain.Article{{ID: 3}, {ID: 2}}, resArticles)
+				assert.Equal(t, "next", resNextPageToken)
+			},
+		},
+		{
+			name: "FallbackToTrending",
+			args: args{userID: 1, pageSize: 2},
+			buildStubs: func(repo *mock.MockIRecommendationRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, 2, "").Return(&[]domain.RecommendedArticle{}, "", nil)
+				articleRepo.EXPECT().ListArticles(domain.ArticleOrderTrending, 2, "").Return(&repoResTrending, "next", nil)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, resNextPageToken string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, repoResTrending, resArticles)
+				assert.Empty(t, resNextPageToken)
+			},
+		},
+		{
+			name: "LastPage",
+			args: args{userID: 1, pageToken: "token"},
+			buildStubs: func(repo *mock.MockIRecommendationRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, pagination.DefaultPageSize, "token").Return(&[]domain.RecommendedArticle{}, "", nil)
+				articleRepo.EXPECT().ListArticles(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, resNextPageToken string, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, resArticles)
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			args: args{userID: 1, pageToken: "invalid"},
+			buildStubs: func(repo *mock.MockIRecommendationRepository, articleRepo *mock.MockIArticleRepository) {
+				repo.EXPECT().ListRecommendedArticles(1, pagination.DefaultPageSize, "invalid").Return(&[]domain.RecommendedArticle{}, "", pagination.ErrInvalidPageToken)
+			},
+			checkResponse: func(t *testing.T, resArticles []domain.Article, resNextPageToken string, err error) {
+				assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIRecommendationRepository(mockCtrl)
+			articleRepo := mock.NewMockIArticleRepository(mockCtrl)
+			tc.buildStubs(repo, articleRepo)
+
+			usecase := NewRecommendationUsecase(repo, articleRepo, 100)
+			resArticles, resNextPageToken, err := usecase.ListRecommendedArticles(tc.args.userID, tc.args.pageSize, tc.args.pageToken)
+			tc.checkResponse(t, resArticles, resNextPageToken, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/loak155/techbranch-backend/internal/domain"
 	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
 	"github.com/loak155/techbranch-backend/pkg/password"
 )
 
@@ -12,8 +13,11 @@ type IUserUsecase interface {
 	CreateUser(user domain.User) (domain.User, error)
 	GetUser(id int) (domain.User, error)
 	GetUserByEmail(email string) (domain.User, error)
-	ListUsers(offset, limit int) ([]domain.User, error)
-	UpdateUser(user domain.User) (domain.User, error)
+	ListUsers(pageSize int, pageToken string) ([]domain.User, string, error)
+	UpdateUser(user domain.User, fields []string) (domain.User, error)
+	UpdateBookmarkVisibility(userID int, public bool) (domain.User, error)
+	UpdateDigestSubscription(userID int, enabled bool) (domain.User, error)
+	RestoreUser(userID, id int) (domain.User, error)
 	DeleteUser(id int) error
 }
 
@@ -53,29 +57,56 @@ func (usecase *userUsecase) GetUserByEmail(email string) (domain.User, error) {
 	return *user, nil
 }
 
-func (usecase *userUsecase) ListUsers(offset, limit int) ([]domain.User, error) {
-	users, err := usecase.repo.ListUsers(offset, limit)
+func (usecase *userUsecase) ListUsers(pageSize int, pageToken string) ([]domain.User, string, error) {
+	users, nextPageToken, err := usecase.repo.ListUsers(pagination.PageSize(pageSize), pageToken)
 	if err != nil {
-		return []domain.User{}, err
+		return []domain.User{}, "", err
 	}
-	return *users, nil
+	return *users, nextPageToken, nil
 }
 
-func (usecase *userUsecase) UpdateUser(user domain.User) (domain.User, error) {
-	updatedUser := domain.User{}
-	if user.Password == "" {
-		updatedUser = domain.User{ID: user.ID, Username: user.Username, Email: user.Email}
-	} else {
-		hashedPassword, err := password.HashPassword(user.Password)
-		if err != nil {
-			return domain.User{}, fmt.Errorf("failed to hash password: %v", err)
+func (usecase *userUsecase) UpdateUser(user domain.User, fields []string) (domain.User, error) {
+	for _, field := range fields {
+		switch field {
+		case "username", "email":
+		case "password":
+			hashedPassword, err := password.HashPassword(user.Password)
+			if err != nil {
+				return domain.User{}, fmt.Errorf("failed to hash password: %v", err)
+			}
+			user.Password = hashedPassword
+		default:
+			return domain.User{}, fmt.Errorf("unknown user field: %s", field)
 		}
-		updatedUser = domain.User{ID: user.ID, Username: user.Username, Email: user.Email, Password: hashedPassword}
 	}
-	if err := usecase.repo.UpdateUser(&updatedUser); err != nil {
+	if err := usecase.repo.UpdateUser(&user, fields); err != nil {
 		return domain.User{}, err
 	}
-	return updatedUser, nil
+	return user, nil
+}
+
+func (usecase *userUsecase) UpdateBookmarkVisibility(userID int, public bool) (domain.User, error) {
+	if err := usecase.repo.UpdateBookmarksPublic(userID, public); err != nil {
+		return domain.User{}, err
+	}
+	return usecase.GetUser(userID)
+}
+
+func (usecase *userUsecase) UpdateDigestSubscription(userID int, enabled bool) (domain.User, error) {
+	if err := usecase.repo.UpdateDigestEnabled(userID, enabled); err != nil {
+		return domain.User{}, err
+	}
+	return usecase.GetUser(userID)
+}
+
+func (usecase *userUsecase) RestoreUser(userID, id int) (domain.User, error) {
+	if err := requireModerator(usecase.repo, userID); err != nil {
+		return domain.User{}, err
+	}
+	if err := usecase.repo.RestoreUser(id); err != nil {
+		return domain.User{}, err
+	}
+	return usecase.GetUser(id)
 }
 
 func (usecase *userUsecase) DeleteUser(id int) error {
@@ -210,8 +210,8 @@ func TestGetUserByEmail(t *testing.T) {
 
 func TestListUsers(t *testing.T) {
 	type args struct {
-		offset int
-		limit  int
+		pageSize  int
+		pageToken string
 	}
 
 	repoResUsers := []domain.User{
@@ -242,11 +242,11 @@ func TestListUsers(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				offset: 0,
-				limit:  10,
+				pageSize:  10,
+				pageToken: "",
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&repoResUsers, nil)
+				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&repoResUsers, "", nil)
 			},
 			checkResponse: func(t *testing.T, resUsers []domain.User, err error) {
 				assert.NoError(t, err)
@@ -263,11 +263,11 @@ func TestListUsers(t *testing.T) {
 		{
 			name: "NotFound",
 			args: args{
-				offset: 0,
-				limit:  10,
+				pageSize:  10,
+				pageToken: "",
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&[]domain.User{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&[]domain.User{}, "", gorm.ErrRecordNotFound)
 			},
 			checkResponse: func(t *testing.T, resUsers []domain.User, err error) {
 				assert.Error(t, err)
@@ -284,7 +284,7 @@ func TestListUsers(t *testing.T) {
 			tc.buildStubs(repo)
 
 			usecase := NewUserUsecase(repo)
-			resUsers, err := usecase.ListUsers(tc.args.offset, tc.args.limit)
+			resUsers, _, err := usecase.ListUsers(tc.args.pageSize, tc.args.pageToken)
 			tc.checkResponse(t, resUsers, err)
 		})
 	}
@@ -292,7 +292,8 @@ func TestListUsers(t *testing.T) {
 
 func TestUpdateUser(t *testing.T) {
 	type args struct {
-		user domain.User
+		user   domain.User
+		fields []string
 	}
 
 	reqUser := domain.User{
@@ -311,10 +312,11 @@ func TestUpdateUser(t *testing.T) {
 		{
 			name: "OK",
 			args: args{
-				user: reqUser,
+				user:   reqUser,
+				fields: []string{"username", "email", "password"},
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			checkResponse: func(t *testing.T, resUser domain.User, err error) {
 				assert.NoError(t, err)
@@ -323,13 +325,41 @@ func TestUpdateUser(t *testing.T) {
 				assert.NoError(t, password.CheckPassword(reqUser.Password, resUser.Password))
 			},
 		},
+		{
+			name: "WithoutPassword",
+			args: args{
+				user:   reqUser,
+				fields: []string{"username"},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), []string{"username"}).Return(nil)
+			},
+			checkResponse: func(t *testing.T, resUser domain.User, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, reqUser.Password, resUser.Password)
+			},
+		},
+		{
+			name: "UnknownField",
+			args: args{
+				user:   reqUser,
+				fields: []string{"role"},
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, resUser domain.User, err error) {
+				assert.Error(t, err)
+			},
+		},
 		{
 			name: "InvalidData",
 			args: args{
-				user: reqUser,
+				user:   reqUser,
+				fields: []string{"username", "email", "password"},
 			},
 			buildStubs: func(repo *mock.MockIUserRepository) {
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(gorm.ErrInvalidData)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(gorm.ErrInvalidData)
 			},
 			checkResponse: func(t *testing.T, resUser domain.User, err error) {
 				assert.Error(t, err)
@@ -346,12 +376,92 @@ func TestUpdateUser(t *testing.T) {
 			tc.buildStubs(repo)
 
 			usecase := NewUserUsecase(repo)
-			res, err := usecase.UpdateUser(tc.args.user)
+			res, err := usecase.UpdateUser(tc.args.user, tc.args.fields)
 			tc.checkResponse(t, res, err)
 		})
 	}
 }
 
+func TestUpdateBookmarkVisibility(t *testing.T) {
+	type args struct {
+		userID int
+		public bool
+	}
+
+	repoResUser := domain.User{
+		ID:              1,
+		Username:        "test_username",
+		Email:           "test@example.com",
+		BookmarksPublic: true,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		args          args
+		buildStubs    func(repo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, resUser domain.User, err error)
+	}{
+		{
+			name: "OK",
+			args: args{
+				userID: 1,
+				public: true,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateBookmarksPublic(1, true).Return(nil)
+				repo.EXPECT().GetUser(1).Return(&repoResUser, nil)
+			},
+			checkResponse: func(t *testing.T, resUser domain.User, err error) {
+				assert.NoError(t, err)
+				assert.True(t, resUser.BookmarksPublic)
+			},
+		},
+		{
+			name: "InvalidData",
+			args: args{
+				userID: 1,
+				public: true,
+			},
+			buildStubs: func(repo *mock.MockIUserRepository) {
+				repo.EXPECT().UpdateBookmarksPublic(1, true).Return(gorm.ErrInvalidData)
+			},
+			checkResponse: func(t *testing.T, resUser domain.User, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewUserUsecase(repo)
+			res, err := usecase.UpdateBookmarkVisibility(tc.args.userID, tc.args.public)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUpdateDigestSubscription(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIUserRepository(mockCtrl)
+	repo.EXPECT().UpdateDigestEnabled(1, false).Return(nil)
+	repo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "test_username", DigestEnabled: false}, nil)
+
+	usecase := NewUserUsecase(repo)
+	res, err := usecase.UpdateDigestSubscription(1, false)
+	assert.NoError(t, err)
+	assert.False(t, res.DigestEnabled)
+}
+
 func TestDeleteUser(t *testing.T) {
 	type args struct {
 		id int
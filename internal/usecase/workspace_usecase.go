@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/internal/repository"
+	"github.com/loak155/techbranch-backend/pkg/mail"
+	"github.com/loak155/techbranch-backend/pkg/pagination"
+	"github.com/loak155/techbranch-backend/pkg/uuid"
+)
+
+type IWorkspaceUsecase interface {
+	CreateWorkspace(userID int, name string) (domain.WorkspaceMembership, error)
+	GetWorkspace(userID, id int) (domain.WorkspaceMembership, error)
+	ListWorkspaces(userID, pageSize int, pageToken string) ([]domain.WorkspaceMembership, string, error)
+	DeleteWorkspace(userID, id int) error
+	ListWorkspaceMembers(userID, workspaceID, pageSize int, pageToken string) ([]domain.WorkspaceMember, string, error)
+	UpdateWorkspaceMember(userID int, member domain.WorkspaceMember) (domain.WorkspaceMember, error)
+	RemoveWorkspaceMember(userID, workspaceID, memberUserID int) error
+	InviteWorkspaceMember(userID, workspaceID int, email, role string) (domain.WorkspaceInvitation, error)
+	AcceptWorkspaceInvitation(userID int, token string) (domain.WorkspaceMembership, error)
+}
+
+type workspaceUsecase struct {
+	repo                           repository.IWorkspaceRepository
+	userRepo                       repository.IUserRepository
+	workspaceInvitationMailManager mail.WorkspaceInvitationMailManager
+	invitationExpires              time.Duration
+}
+
+func NewWorkspaceUsecase(repo repository.IWorkspaceRepository, userRepo repository.IUserRepository, workspaceInvitationMailManager mail.WorkspaceInvitationMailManager, invitationExpires time.Duration) IWorkspaceUsecase {
+	return &workspaceUsecase{repo, userRepo, workspaceInvitationMailManager, invitationExpires}
+}
+
+func (usecase *workspaceUsecase) CreateWorkspace(userID int, name string) (domain.WorkspaceMembership, error) {
+	workspace := domain.Workspace{Name: name}
+	if err := usecase.repo.CreateWorkspace(&workspace, userID); err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	return domain.WorkspaceMembership{Workspace: workspace, Role: domain.WorkspaceRoleOwner}, nil
+}
+
+func (usecase *workspaceUsecase) GetWorkspace(userID, id int) (domain.WorkspaceMembership, error) {
+	member, err := requireWorkspaceRole(usecase.repo, id, userID, domain.WorkspaceRoleViewer)
+	if err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	workspace, err := usecase.repo.GetWorkspace(id)
+	if err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	return domain.WorkspaceMembership{Workspace: *workspace, Role: member.Role}, nil
+}
+
+func (usecase *workspaceUsecase) ListWorkspaces(userID, pageSize int, pageToken string) ([]domain.WorkspaceMembership, string, error) {
+	workspaces, nextPageToken, err := usecase.repo.ListWorkspacesByUserID(userID, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.WorkspaceMembership{}, "", err
+	}
+	return *workspaces, nextPageToken, nil
+}
+
+func (usecase *workspaceUsecase) DeleteWorkspace(userID, id int) error {
+	if _, err := requireWorkspaceRole(usecase.repo, id, userID, domain.WorkspaceRoleOwner); err != nil {
+		return err
+	}
+	return usecase.repo.DeleteWorkspace(id)
+}
+
+func (usecase *workspaceUsecase) ListWorkspaceMembers(userID, workspaceID, pageSize int, pageToken string) ([]domain.WorkspaceMember, string, error) {
+	if _, err := requireWorkspaceRole(usecase.repo, workspaceID, userID, domain.WorkspaceRoleViewer); err != nil {
+		return []domain.WorkspaceMember{}, "", err
+	}
+	members, nextPageToken, err := usecase.repo.ListWorkspaceMembers(workspaceID, pagination.PageSize(pageSize), pageToken)
+	if err != nil {
+		return []domain.WorkspaceMember{}, "", err
+	}
+	return *members, nextPageToken, nil
+}
+
+func (usecase *workspaceUsecase) UpdateWorkspaceMember(userID int, member domain.WorkspaceMember) (domain.WorkspaceMember, error) {
+	if _, err := requireWorkspaceRole(usecase.repo, int(member.WorkspaceID), userID, domain.WorkspaceRoleOwner); err != nil {
+		return domain.WorkspaceMember{}, err
+	}
+	current, err := usecase.repo.GetWorkspaceMember(int(member.WorkspaceID), int(member.UserID))
+	if err != nil {
+		return domain.WorkspaceMember{}, err
+	}
+	if current.Role == domain.WorkspaceRoleOwner && member.Role != domain.WorkspaceRoleOwner {
+		if err := usecase.checkRemainingOwner(int(member.WorkspaceID)); err != nil {
+			return domain.WorkspaceMember{}, err
+		}
+	}
+	current.Role = member.Role
+	if err := usecase.repo.UpdateWorkspaceMember(current); err != nil {
+		return domain.WorkspaceMember{}, err
+	}
+	return *current, nil
+}
+
+func (usecase *workspaceUsecase) RemoveWorkspaceMember(userID, workspaceID, memberUserID int) error {
+	role := domain.WorkspaceRoleOwner
+	if userID == memberUserID {
+		role = domain.WorkspaceRoleViewer
+	}
+	if _, err := requireWorkspaceRole(usecase.repo, workspaceID, userID, role); err != nil {
+		return err
+	}
+	member, err := usecase.repo.GetWorkspaceMember(workspaceID, memberUserID)
+	if err != nil {
+		return err
+	}
+	if member.Role == domain.WorkspaceRoleOwner {
+		if err := usecase.checkRemainingOwner(workspaceID); err != nil {
+			return err
+		}
+	}
+	return usecase.repo.DeleteWorkspaceMember(workspaceID, memberUserID)
+}
+
+func (usecase *workspaceUsecase) InviteWorkspaceMember(userID, workspaceID int, email, role string) (domain.WorkspaceInvitation, error) {
+	if _, err := requireWorkspaceRole(usecase.repo, workspaceID, userID, domain.WorkspaceRoleOwner); err != nil {
+		return domain.WorkspaceInvitation{}, err
+	}
+	workspace, err := usecase.repo.GetWorkspace(workspaceID)
+	if err != nil {
+		return domain.WorkspaceInvitation{}, err
+	}
+	inviter, err := usecase.userRepo.GetUser(userID)
+	if err != nil {
+		return domain.WorkspaceInvitation{}, err
+	}
+
+	invitedByUserID := uint(userID)
+	invitation := domain.WorkspaceInvitation{
+		WorkspaceID:     uint(workspaceID),
+		Email:           email,
+		Role:            role,
+		Token:           uuid.NewUUID(),
+		InvitedByUserID: &invitedByUserID,
+		ExpiresAt:       time.Now().Add(usecase.invitationExpires),
+	}
+	if err := usecase.repo.CreateWorkspaceInvitation(&invitation); err != nil {
+		return domain.WorkspaceInvitation{}, err
+	}
+	if err := usecase.workspaceInvitationMailManager.SendWorkspaceInvitationMail([]string{email}, inviter.Username, workspace.Name, role, invitation.Token); err != nil {
+		return domain.WorkspaceInvitation{}, errors.Join(err, usecase.repo.DeleteWorkspaceInvitation(int(invitation.ID)))
+	}
+	return invitation, nil
+}
+
+func (usecase *workspaceUsecase) AcceptWorkspaceInvitation(userID int, token string) (domain.WorkspaceMembership, error) {
+	invitation, err := usecase.repo.GetWorkspaceInvitationByToken(token)
+	if err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	if invitation.AcceptedAt != nil || time.Now().After(invitation.ExpiresAt) {
+		return domain.WorkspaceMembership{}, fmt.Errorf("%w: invitation has expired", domain.ErrInvalidArgument)
+	}
+	user, err := usecase.userRepo.GetUser(userID)
+	if err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return domain.WorkspaceMembership{}, domain.ErrPermissionDenied
+	}
+	if err := usecase.repo.AcceptWorkspaceInvitation(invitation, userID); err != nil {
+		return domain.WorkspaceMembership{}, err
+	}
+	return usecase.GetWorkspace(userID, int(invitation.WorkspaceID))
+}
+
+func (usecase *workspaceUsecase) checkRemainingOwner(workspaceID int) error {
+	count, err := usecase.repo.CountWorkspaceOwners(workspaceID)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return fmt.Errorf("%w: workspace must have at least one owner", domain.ErrInvalidArgument)
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/loak155/techbranch-backend/internal/domain"
+	"github.com/loak155/techbranch-backend/mock"
+	"github.com/loak155/techbranch-backend/pkg/mail"
+	smtpmock "github.com/mocktools/go-smtp-mock/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateWorkspace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	repo.EXPECT().CreateWorkspace(gomock.Any(), 1).DoAndReturn(func(workspace *domain.Workspace, ownerID int) error {
+		workspace.ID = 1
+		return nil
+	})
+
+	usecase := NewWorkspaceUsecase(repo, nil, mail.WorkspaceInvitationMailManager{}, time.Hour)
+	res, err := usecase.CreateWorkspace(1, "team")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), res.ID)
+	assert.Equal(t, "team", res.Name)
+	assert.Equal(t, domain.WorkspaceRoleOwner, res.Role)
+}
+
+func TestGetWorkspace(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res domain.WorkspaceMembership, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "team", res.Name)
+				assert.Equal(t, domain.WorkspaceRoleViewer, res.Role)
+			},
+		},
+		{
+			name: "NotMember",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{}, gorm.ErrRecordNotFound)
+				repo.EXPECT().GetWorkspace(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewWorkspaceUsecase(repo, nil, mail.WorkspaceInvitationMailManager{}, time.Hour)
+			res, err := usecase.GetWorkspace(1, 1)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+				repo.EXPECT().DeleteWorkspace(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Editor",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().DeleteWorkspace(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewWorkspaceUsecase(repo, nil, mail.WorkspaceInvitationMailManager{}, time.Hour)
+			err := usecase.DeleteWorkspace(1, 1)
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestUpdateWorkspaceMember(t *testing.T) {
+	testCases := []struct {
+		name          string
+		member        domain.WorkspaceMember
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, res domain.WorkspaceMember, err error)
+	}{
+		{
+			name:   "OK",
+			member: domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleEditor},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+				repo.EXPECT().GetWorkspaceMember(1, 2).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleViewer}, nil)
+				repo.EXPECT().UpdateWorkspaceMember(gomock.Any()).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMember, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.WorkspaceRoleEditor, res.Role)
+			},
+		},
+		{
+			name:   "LastOwner",
+			member: domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Times(2)
+				repo.EXPECT().CountWorkspaceOwners(1).Return(1, nil)
+				repo.EXPECT().UpdateWorkspaceMember(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMember, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name:   "NotOwner",
+			member: domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleOwner},
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().UpdateWorkspaceMember(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMember, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewWorkspaceUsecase(repo, nil, mail.WorkspaceInvitationMailManager{}, time.Hour)
+			res, err := usecase.UpdateWorkspaceMember(1, tc.member)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestRemoveWorkspaceMember(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memberUserID  int
+		buildStubs    func(repo *mock.MockIWorkspaceRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			name:         "Leave",
+			memberUserID: 1,
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleViewer}, nil).Times(2)
+				repo.EXPECT().DeleteWorkspaceMember(1, 1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "NotOwner",
+			memberUserID: 2,
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().DeleteWorkspaceMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name:         "LastOwner",
+			memberUserID: 1,
+			buildStubs: func(repo *mock.MockIWorkspaceRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Times(2)
+				repo.EXPECT().CountWorkspaceOwners(1).Return(1, nil)
+				repo.EXPECT().DeleteWorkspaceMember(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			tc.buildStubs(repo)
+
+			usecase := NewWorkspaceUsecase(repo, nil, mail.WorkspaceInvitationMailManager{}, time.Hour)
+			err := usecase.RemoveWorkspaceMember(1, 1, tc.memberUserID)
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func TestInviteWorkspaceMember(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res domain.WorkspaceInvitation, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+				repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+				userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "owner"}, nil)
+				repo.EXPECT().CreateWorkspaceInvitation(gomock.Any()).DoAndReturn(func(invitation *domain.WorkspaceInvitation) error {
+					invitation.ID = 1
+					return nil
+				})
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceInvitation, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), res.ID)
+				assert.Equal(t, "member@example.com", res.Email)
+				assert.NotEmpty(t, res.Token)
+				assert.WithinDuration(t, time.Now().Add(time.Hour), res.ExpiresAt, time.Minute)
+			},
+		},
+		{
+			name: "NotOwner",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().CreateWorkspaceInvitation(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceInvitation, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+	}
+
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer testSMTPServer.Stop()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			workspaceInvitationMailManager, err := mail.NewWorkspaceInvitationMailManager("localhost", testSMTPServer.PortNumber(), "test@example.com", "", "Test Workspace Invitation", "../../pkg/mail/workspace_invitation.tmpl", "http://localhost:8080/workspace-invitations/")
+			if err != nil {
+				t.Fatalf("failed to create workspace invitation mail manager: %v", err)
+			}
+			usecase := NewWorkspaceUsecase(repo, userRepo, *workspaceInvitationMailManager, time.Hour)
+			res, err := usecase.InviteWorkspaceMember(1, 1, "member@example.com", domain.WorkspaceRoleEditor)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestInviteWorkspaceMemberMailFailed(t *testing.T) {
+	testSMTPServer := smtpmock.New(smtpmock.ConfigurationAttr{})
+	if err := testSMTPServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	port := testSMTPServer.PortNumber()
+	if err := testSMTPServer.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+	userRepo := mock.NewMockIUserRepository(mockCtrl)
+	repo.EXPECT().GetWorkspaceMember(1, 1).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil)
+	repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+	userRepo.EXPECT().GetUser(1).Return(&domain.User{ID: 1, Username: "owner"}, nil)
+	repo.EXPECT().CreateWorkspaceInvitation(gomock.Any()).DoAndReturn(func(invitation *domain.WorkspaceInvitation) error {
+		invitation.ID = 1
+		return nil
+	})
+	repo.EXPECT().DeleteWorkspaceInvitation(1).Return(nil)
+
+	workspaceInvitationMailManager, err := mail.NewWorkspaceInvitationMailManager("localhost", port, "test@example.com", "", "Test Workspace Invitation", "../../pkg/mail/workspace_invitation.tmpl", "http://localhost:8080/workspace-invitations/")
+	if err != nil {
+		t.Fatalf("failed to create workspace invitation mail manager: %v", err)
+	}
+	usecase := NewWorkspaceUsecase(repo, userRepo, *workspaceInvitationMailManager, time.Hour)
+	_, err = usecase.InviteWorkspaceMember(1, 1, "member@example.com", domain.WorkspaceRoleEditor)
+	assert.Error(t, err)
+}
+
+func TestAcceptWorkspaceInvitation(t *testing.T) {
+	acceptedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository)
+		checkResponse func(t *testing.T, res domain.WorkspaceMembership, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				invitation := &domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", Role: domain.WorkspaceRoleEditor, ExpiresAt: time.Now().Add(time.Hour)}
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(invitation, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Email: "Member@example.com"}, nil)
+				repo.EXPECT().AcceptWorkspaceInvitation(invitation, 2).Return(nil)
+				repo.EXPECT().GetWorkspaceMember(1, 2).Return(&domain.WorkspaceMember{WorkspaceID: 1, UserID: 2, Role: domain.WorkspaceRoleEditor}, nil)
+				repo.EXPECT().GetWorkspace(1).Return(&domain.Workspace{ID: 1, Name: "team"}, nil)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), res.ID)
+				assert.Equal(t, domain.WorkspaceRoleEditor, res.Role)
+			},
+		},
+		{
+			name: "Expired",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+				repo.EXPECT().AcceptWorkspaceInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "AlreadyAccepted",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", ExpiresAt: time.Now().Add(time.Hour), AcceptedAt: &acceptedAt}, nil)
+				repo.EXPECT().AcceptWorkspaceInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "EmailMismatch",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{ID: 1, WorkspaceID: 1, Email: "member@example.com", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				userRepo.EXPECT().GetUser(2).Return(&domain.User{ID: 2, Email: "other@example.com"}, nil)
+				repo.EXPECT().AcceptWorkspaceInvitation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(repo *mock.MockIWorkspaceRepository, userRepo *mock.MockIUserRepository) {
+				repo.EXPECT().GetWorkspaceInvitationByToken("token").Return(&domain.WorkspaceInvitation{}, gorm.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res domain.WorkspaceMembership, err error) {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock.NewMockIWorkspaceRepository(mockCtrl)
+			userRepo := mock.NewMockIUserRepository(mockCtrl)
+			tc.buildStubs(repo, userRepo)
+
+			usecase := NewWorkspaceUsecase(repo, userRepo, mail.WorkspaceInvitationMailManager{}, time.Hour)
+			res, err := usecase.AcceptWorkspaceInvitation(2, "token")
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS articles_site_idx;
+DROP INDEX IF EXISTS articles_tags_idx;
+DROP INDEX IF EXISTS articles_search_vector_idx;
+DROP TRIGGER IF EXISTS articles_search_vector_update ON articles;
+DROP FUNCTION IF EXISTS articles_search_vector_update();
+ALTER TABLE articles DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE articles DROP COLUMN IF EXISTS language;
+ALTER TABLE articles DROP COLUMN IF EXISTS tags;
+ALTER TABLE articles DROP COLUMN IF EXISTS site;
+ALTER TABLE articles DROP COLUMN IF EXISTS body;
+ALTER TABLE articles DROP COLUMN IF EXISTS description;
@@ -0,0 +1,30 @@
+ALTER TABLE "articles" ADD COLUMN "description" text NOT NULL DEFAULT '';
+ALTER TABLE "articles" ADD COLUMN "body" text NOT NULL DEFAULT '';
+ALTER TABLE "articles" ADD COLUMN "site" varchar NOT NULL DEFAULT '';
+ALTER TABLE "articles" ADD COLUMN "tags" text[] DEFAULT '{}';
+ALTER TABLE "articles" ADD COLUMN "language" regconfig NOT NULL DEFAULT 'english';
+ALTER TABLE "articles" ADD COLUMN "search_vector" tsvector;
+
+CREATE FUNCTION articles_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector :=
+    setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+    setweight(to_tsvector(NEW.language, coalesce(NEW.title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+    setweight(to_tsvector(NEW.language, coalesce(NEW.description, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(NEW.body, '')), 'C') ||
+    setweight(to_tsvector(NEW.language, coalesce(NEW.body, '')), 'C');
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER articles_search_vector_update BEFORE INSERT OR UPDATE ON "articles"
+  FOR EACH ROW EXECUTE FUNCTION articles_search_vector_update();
+
+UPDATE "articles" SET "site" = substring("url" from '^[a-zA-Z]+://([^/:?#]+)');
+
+CREATE INDEX "articles_search_vector_idx" ON "articles" USING GIN ("search_vector");
+
+CREATE INDEX "articles_tags_idx" ON "articles" USING GIN ("tags");
+
+CREATE INDEX "articles_site_idx" ON "articles" ("site");
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS articles_trending_score_idx;
+DROP INDEX IF EXISTS articles_comment_count_idx;
+DROP INDEX IF EXISTS articles_bookmark_count_idx;
+DROP TRIGGER IF EXISTS articles_search_vector_update ON articles;
+CREATE TRIGGER articles_search_vector_update BEFORE INSERT OR UPDATE ON articles
+  FOR EACH ROW EXECUTE FUNCTION articles_search_vector_update();
+ALTER TABLE articles DROP COLUMN IF EXISTS trending_score;
+ALTER TABLE articles DROP COLUMN IF EXISTS comment_count;
+ALTER TABLE articles DROP COLUMN IF EXISTS bookmark_count;
@@ -0,0 +1,20 @@
+ALTER TABLE "articles" ADD COLUMN "bookmark_count" integer NOT NULL DEFAULT 0;
+ALTER TABLE "articles" ADD COLUMN "comment_count" integer NOT NULL DEFAULT 0;
+ALTER TABLE "articles" ADD COLUMN "trending_score" double precision NOT NULL DEFAULT 0;
+
+DROP TRIGGER IF EXISTS articles_search_vector_update ON "articles";
+CREATE TRIGGER articles_search_vector_update BEFORE INSERT OR UPDATE OF "title", "description", "body", "language" ON "articles"
+  FOR EACH ROW EXECUTE FUNCTION articles_search_vector_update();
+
+UPDATE "articles" SET
+  "bookmark_count" = (SELECT count(*) FROM "bookmarks" WHERE "bookmarks"."article_id" = "articles"."id"),
+  "comment_count" = (SELECT count(*) FROM "comments" WHERE "comments"."article_id" = "articles"."id");
+
+UPDATE "articles" SET
+  "trending_score" = ("bookmark_count" + "comment_count") / power(extract(epoch FROM (now() - "created_at")) / 3600 + 2, 1.8);
+
+CREATE INDEX "articles_bookmark_count_idx" ON "articles" ("bookmark_count" DESC, "id" DESC);
+
+CREATE INDEX "articles_comment_count_idx" ON "articles" ("comment_count" DESC, "id" DESC);
+
+CREATE INDEX "articles_trending_score_idx" ON "articles" ("trending_score" DESC, "id" DESC);
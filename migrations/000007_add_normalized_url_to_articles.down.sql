@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS articles_normalized_url_idx;
+ALTER TABLE articles DROP COLUMN IF EXISTS normalized_url;
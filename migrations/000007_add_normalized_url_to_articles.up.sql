@@ -0,0 +1,5 @@
+ALTER TABLE "articles" ADD COLUMN "normalized_url" text NOT NULL DEFAULT '';
+
+UPDATE "articles" SET "normalized_url" = regexp_replace(regexp_replace("url", '#.*$', ''), '/+$', '');
+
+CREATE INDEX "articles_normalized_url_idx" ON "articles" ("normalized_url");
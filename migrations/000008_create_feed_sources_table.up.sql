@@ -0,0 +1,15 @@
+CREATE TABLE "feed_sources" (
+  "id" bigserial PRIMARY KEY,
+  "url" text UNIQUE NOT NULL,
+  "title" varchar NOT NULL DEFAULT '',
+  "etag" varchar NOT NULL DEFAULT '',
+  "last_modified" varchar NOT NULL DEFAULT '',
+  "last_fetched_at" timestamp,
+  "next_fetch_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "error_count" integer NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE INDEX "feed_sources_next_fetch_at_idx" ON "feed_sources" ("next_fetch_at");
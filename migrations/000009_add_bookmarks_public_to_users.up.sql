@@ -0,0 +1 @@
+ALTER TABLE "users" ADD COLUMN "bookmarks_public" boolean NOT NULL DEFAULT false;
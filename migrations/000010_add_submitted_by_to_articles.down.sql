@@ -0,0 +1,3 @@
+ALTER TABLE articles DROP COLUMN IF EXISTS submitted_by_user_id;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
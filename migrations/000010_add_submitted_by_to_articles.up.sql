@@ -0,0 +1,7 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'user';
+
+ALTER TABLE "articles" ADD COLUMN "submitted_by_user_id" bigint;
+
+ALTER TABLE "articles" ADD FOREIGN KEY ("submitted_by_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "articles" ("submitted_by_user_id");
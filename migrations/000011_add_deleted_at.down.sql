@@ -0,0 +1,19 @@
+DELETE FROM comments WHERE deleted_at IS NOT NULL
+  OR article_id IN (SELECT id FROM articles WHERE deleted_at IS NOT NULL)
+  OR user_id IN (SELECT id FROM users WHERE deleted_at IS NOT NULL);
+
+DELETE FROM bookmarks WHERE deleted_at IS NOT NULL
+  OR article_id IN (SELECT id FROM articles WHERE deleted_at IS NOT NULL)
+  OR user_id IN (SELECT id FROM users WHERE deleted_at IS NOT NULL);
+
+DELETE FROM articles WHERE deleted_at IS NOT NULL;
+
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS deleted_at;
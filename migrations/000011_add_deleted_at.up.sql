@@ -0,0 +1,15 @@
+ALTER TABLE "articles" ADD COLUMN "deleted_at" timestamp;
+
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamp;
+
+ALTER TABLE "bookmarks" ADD COLUMN "deleted_at" timestamp;
+
+ALTER TABLE "comments" ADD COLUMN "deleted_at" timestamp;
+
+CREATE INDEX ON "articles" ("deleted_at");
+
+CREATE INDEX ON "users" ("deleted_at");
+
+CREATE INDEX ON "bookmarks" ("deleted_at");
+
+CREATE INDEX ON "comments" ("deleted_at");
@@ -0,0 +1,23 @@
+CREATE TABLE "article_revisions" (
+  "id" bigserial PRIMARY KEY,
+  "article_id" bigint NOT NULL,
+  "revision" integer NOT NULL,
+  "editor_user_id" bigint,
+  "title" varchar NOT NULL,
+  "url" text NOT NULL,
+  "image" text NOT NULL DEFAULT '',
+  "description" text NOT NULL DEFAULT '',
+  "body" text NOT NULL DEFAULT '',
+  "tags" text[] NOT NULL DEFAULT '{}',
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "article_revisions" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "article_revisions" ADD FOREIGN KEY ("editor_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX ON "article_revisions" ("article_id", "revision");
+
+INSERT INTO "article_revisions" ("article_id", "revision", "editor_user_id", "title", "url", "image", "description", "body", "tags", "created_at")
+SELECT "id", 1, "submitted_by_user_id", "title", "url", COALESCE("image", ''), "description", "body", COALESCE("tags", '{}'), "updated_at"
+FROM "articles";
@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS version;
@@ -0,0 +1,24 @@
+CREATE TABLE "article_links" (
+  "id" bigserial PRIMARY KEY,
+  "article_id" bigint UNIQUE NOT NULL,
+  "url" text NOT NULL,
+  "status_code" integer NOT NULL DEFAULT 0,
+  "redirect_url" text NOT NULL DEFAULT '',
+  "last_error" text NOT NULL DEFAULT '',
+  "failure_count" integer NOT NULL DEFAULT 0,
+  "broken" boolean NOT NULL DEFAULT false,
+  "last_checked_at" timestamp,
+  "next_check_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "article_links" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+CREATE INDEX "article_links_next_check_at_idx" ON "article_links" ("next_check_at");
+
+CREATE INDEX "article_links_broken_idx" ON "article_links" ("broken");
+
+INSERT INTO "article_links" ("article_id", "url")
+SELECT "id", "url"
+FROM "articles";
@@ -0,0 +1,13 @@
+CREATE TABLE "article_recommendations" (
+  "user_id" bigint NOT NULL,
+  "article_id" bigint NOT NULL,
+  "score" double precision NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  PRIMARY KEY ("user_id", "article_id")
+);
+
+ALTER TABLE "article_recommendations" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "article_recommendations" ADD FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "article_recommendations" ("user_id", "score", "article_id");
@@ -0,0 +1,3 @@
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS position;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS collection_id;
+DROP TABLE IF EXISTS collections;
@@ -0,0 +1,22 @@
+CREATE TABLE "collections" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "position" integer NOT NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "collections" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX ON "collections" ("user_id", "name");
+
+CREATE INDEX ON "collections" ("user_id", "position", "id");
+
+ALTER TABLE "bookmarks" ADD COLUMN "collection_id" bigint;
+ALTER TABLE "bookmarks" ADD COLUMN "position" integer NOT NULL DEFAULT 0;
+
+ALTER TABLE "bookmarks" ADD FOREIGN KEY ("collection_id") REFERENCES "collections" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "bookmarks" ("collection_id", "position", "id");
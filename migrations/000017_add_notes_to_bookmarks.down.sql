@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS bookmarks_tags_idx;
+DROP INDEX IF EXISTS bookmarks_search_vector_idx;
+DROP TRIGGER IF EXISTS bookmarks_search_vector_update ON bookmarks;
+DROP FUNCTION IF EXISTS bookmarks_search_vector_update();
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS tags;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS highlights;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS note;
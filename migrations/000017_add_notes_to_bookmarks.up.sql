@@ -0,0 +1,21 @@
+ALTER TABLE "bookmarks" ADD COLUMN "note" text NOT NULL DEFAULT '';
+ALTER TABLE "bookmarks" ADD COLUMN "highlights" text[] NOT NULL DEFAULT '{}';
+ALTER TABLE "bookmarks" ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}';
+ALTER TABLE "bookmarks" ADD COLUMN "search_vector" tsvector;
+
+CREATE FUNCTION bookmarks_search_vector_update() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector :=
+    setweight(to_tsvector('simple', array_to_string(NEW.tags, ' ')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(NEW.note, '')), 'B') ||
+    setweight(to_tsvector('simple', array_to_string(NEW.highlights, ' ')), 'B');
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bookmarks_search_vector_update BEFORE INSERT OR UPDATE OF "note", "highlights", "tags" ON "bookmarks"
+  FOR EACH ROW EXECUTE FUNCTION bookmarks_search_vector_update();
+
+CREATE INDEX "bookmarks_search_vector_idx" ON "bookmarks" USING GIN ("search_vector");
+
+CREATE INDEX "bookmarks_tags_idx" ON "bookmarks" USING GIN ("tags");
@@ -0,0 +1,9 @@
+DELETE FROM "bookmarks" b
+USING "bookmarks" d
+WHERE b."user_id" = d."user_id"
+  AND b."article_id" = d."article_id"
+  AND b."deleted_at" IS NULL
+  AND d."deleted_at" IS NULL
+  AND b."id" > d."id";
+
+CREATE UNIQUE INDEX "bookmarks_user_id_article_id_key" ON "bookmarks" ("user_id", "article_id") WHERE "deleted_at" IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS bookmarks_unread_idx;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS progress;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS read_at;
@@ -0,0 +1,4 @@
+ALTER TABLE "bookmarks" ADD COLUMN "read_at" timestamp;
+ALTER TABLE "bookmarks" ADD COLUMN "progress" integer NOT NULL DEFAULT 0 CHECK ("progress" BETWEEN 0 AND 100);
+
+CREATE INDEX "bookmarks_unread_idx" ON "bookmarks" ("user_id") WHERE "read_at" IS NULL AND "deleted_at" IS NULL;
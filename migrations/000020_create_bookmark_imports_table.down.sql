@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bookmark_import_errors;
+DROP TABLE IF EXISTS bookmark_imports;
@@ -0,0 +1,37 @@
+CREATE TABLE "bookmark_imports" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "format" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "data" text NOT NULL DEFAULT '',
+  "total_count" integer NOT NULL DEFAULT 0,
+  "processed_count" integer NOT NULL DEFAULT 0,
+  "created_count" integer NOT NULL DEFAULT 0,
+  "existing_count" integer NOT NULL DEFAULT 0,
+  "failed_count" integer NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "started_at" timestamp,
+  "finished_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "bookmark_import_errors" (
+  "id" bigserial PRIMARY KEY,
+  "import_id" bigint NOT NULL,
+  "item_index" integer NOT NULL,
+  "url" text NOT NULL DEFAULT '',
+  "title" text NOT NULL DEFAULT '',
+  "reason" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "bookmark_imports" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "bookmark_import_errors" ADD FOREIGN KEY ("import_id") REFERENCES "bookmark_imports" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "bookmark_imports" ("status", "id");
+
+CREATE INDEX ON "bookmark_imports" ("user_id", "id");
+
+CREATE INDEX ON "bookmark_import_errors" ("import_id", "item_index");
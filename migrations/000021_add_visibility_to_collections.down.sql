@@ -0,0 +1,2 @@
+ALTER TABLE collections DROP COLUMN IF EXISTS share_token;
+ALTER TABLE collections DROP COLUMN IF EXISTS visibility;
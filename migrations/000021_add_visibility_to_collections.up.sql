@@ -0,0 +1,4 @@
+ALTER TABLE "collections" ADD COLUMN "visibility" varchar NOT NULL DEFAULT 'private' CHECK ("visibility" IN ('private', 'unlisted', 'public'));
+ALTER TABLE "collections" ADD COLUMN "share_token" varchar NOT NULL DEFAULT (gen_random_uuid()::text);
+
+CREATE UNIQUE INDEX ON "collections" ("share_token");
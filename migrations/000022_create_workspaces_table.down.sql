@@ -0,0 +1,14 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS workspace_id;
+ALTER TABLE collections DROP COLUMN IF EXISTS workspace_id;
+UPDATE collections SET name = name || ' (' || id || ')'
+WHERE id IN (
+  SELECT id FROM (
+    SELECT id, row_number() OVER (PARTITION BY user_id, name ORDER BY id) AS n FROM collections
+  ) AS duplicates
+  WHERE n > 1
+);
+DROP INDEX IF EXISTS collections_user_id_name_idx;
+CREATE UNIQUE INDEX ON collections (user_id, name);
+DROP TABLE IF EXISTS workspace_invitations;
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,58 @@
+CREATE TABLE "workspaces" (
+  "id" bigserial PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+CREATE TABLE "workspace_members" (
+  "workspace_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  "role" varchar NOT NULL CHECK ("role" IN ('owner', 'editor', 'viewer')),
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  PRIMARY KEY ("workspace_id", "user_id")
+);
+
+CREATE TABLE "workspace_invitations" (
+  "id" bigserial PRIMARY KEY,
+  "workspace_id" bigint NOT NULL,
+  "email" varchar NOT NULL,
+  "role" varchar NOT NULL CHECK ("role" IN ('owner', 'editor', 'viewer')),
+  "token" varchar NOT NULL,
+  "invited_by_user_id" bigint,
+  "expires_at" timestamp NOT NULL,
+  "accepted_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "workspace_members" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_members" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_invitations" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "workspace_invitations" ADD FOREIGN KEY ("invited_by_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "workspace_members" ("user_id");
+
+CREATE UNIQUE INDEX ON "workspace_invitations" ("token");
+
+CREATE INDEX ON "workspace_invitations" ("workspace_id", "id");
+
+ALTER TABLE "collections" ADD COLUMN "workspace_id" bigint;
+
+ALTER TABLE "collections" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS "collections_user_id_name_idx";
+
+CREATE UNIQUE INDEX ON "collections" ("user_id", "name") WHERE "workspace_id" IS NULL;
+
+CREATE UNIQUE INDEX ON "collections" ("workspace_id", "name") WHERE "workspace_id" IS NOT NULL;
+
+CREATE INDEX ON "collections" ("workspace_id", "position", "id");
+
+ALTER TABLE "comments" ADD COLUMN "workspace_id" bigint;
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "comments" ("workspace_id", "article_id");
@@ -0,0 +1,5 @@
+ALTER TABLE users DROP COLUMN IF EXISTS digest_sent_at;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_enabled;
+DROP INDEX IF EXISTS bookmarks_remind_at_idx;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS remind_at;
@@ -0,0 +1,7 @@
+ALTER TABLE "bookmarks" ADD COLUMN "remind_at" timestamp;
+ALTER TABLE "bookmarks" ADD COLUMN "reminded_at" timestamp;
+
+CREATE INDEX "bookmarks_remind_at_idx" ON "bookmarks" ("remind_at") WHERE "reminded_at" IS NULL AND "deleted_at" IS NULL;
+
+ALTER TABLE "users" ADD COLUMN "digest_enabled" boolean NOT NULL DEFAULT true;
+ALTER TABLE "users" ADD COLUMN "digest_sent_at" timestamp;
@@ -0,0 +1,7 @@
+ALTER TABLE "comments" ADD COLUMN "parent_comment_id" bigint;
+
+ALTER TABLE "comments" ADD COLUMN "depth" integer NOT NULL DEFAULT 0 CHECK ("depth" >= 0);
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("parent_comment_id") REFERENCES "comments" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "comments" ("parent_comment_id", "created_at", "id");
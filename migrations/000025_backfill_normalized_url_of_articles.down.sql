@@ -0,0 +1 @@
+-- Nothing to undo: the backfilled "normalized_url" values stay valid.
@@ -0,0 +1 @@
+-- "normalized_url" is recomputed with urlnorm.Normalize by the Go step registered for this version in cmd/main.go.
@@ -0,0 +1,4 @@
+ALTER TABLE users DROP COLUMN IF EXISTS digest_retry_at;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_error_count;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS reminder_retry_at;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS reminder_error_count;
@@ -0,0 +1,7 @@
+ALTER TABLE "bookmarks" ADD COLUMN "reminder_error_count" integer NOT NULL DEFAULT 0;
+
+ALTER TABLE "bookmarks" ADD COLUMN "reminder_retry_at" timestamp;
+
+ALTER TABLE "users" ADD COLUMN "digest_error_count" integer NOT NULL DEFAULT 0;
+
+ALTER TABLE "users" ADD COLUMN "digest_retry_at" timestamp;
@@ -0,0 +1,3 @@
+ALTER TABLE "comments" DROP CONSTRAINT IF EXISTS "comments_parent_comment_id_fkey";
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("parent_comment_id") REFERENCES "comments" ("id") ON DELETE SET NULL;
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/article_link_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIArticleLinkRepository is a mock of IArticleLinkRepository interface.
+type MockIArticleLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleLinkRepositoryMockRecorder
+}
+
+// MockIArticleLinkRepositoryMockRecorder is the mock recorder for MockIArticleLinkRepository.
+type MockIArticleLinkRepositoryMockRecorder struct {
+	mock *MockIArticleLinkRepository
+}
+
+// NewMockIArticleLinkRepository creates a new mock instance.
+func NewMockIArticleLinkRepository(ctrl *gomock.Controller) *MockIArticleLinkRepository {
+	mock := &MockIArticleLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockIArticleLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleLinkRepository) EXPECT() *MockIArticleLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListBrokenArticleLinks mocks base method.
+func (m *MockIArticleLinkRepository) ListBrokenArticleLinks(pageSize int, pageToken string) (*[]domain.ArticleLink, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrokenArticleLinks", pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.ArticleLink)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBrokenArticleLinks indicates an expected call of ListBrokenArticleLinks.
+func (mr *MockIArticleLinkRepositoryMockRecorder) ListBrokenArticleLinks(pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrokenArticleLinks", reflect.TypeOf((*MockIArticleLinkRepository)(nil).ListBrokenArticleLinks), pageSize, pageToken)
+}
+
+// ListDueArticleLinks mocks base method.
+func (m *MockIArticleLinkRepository) ListDueArticleLinks(now time.Time, limit int) (*[]domain.ArticleLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueArticleLinks", now, limit)
+	ret0, _ := ret[0].(*[]domain.ArticleLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueArticleLinks indicates an expected call of ListDueArticleLinks.
+func (mr *MockIArticleLinkRepositoryMockRecorder) ListDueArticleLinks(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueArticleLinks", reflect.TypeOf((*MockIArticleLinkRepository)(nil).ListDueArticleLinks), now, limit)
+}
+
+// UpdateArticleLinkCheckState mocks base method.
+func (m *MockIArticleLinkRepository) UpdateArticleLinkCheckState(link *domain.ArticleLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticleLinkCheckState", link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArticleLinkCheckState indicates an expected call of UpdateArticleLinkCheckState.
+func (mr *MockIArticleLinkRepositoryMockRecorder) UpdateArticleLinkCheckState(link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleLinkCheckState", reflect.TypeOf((*MockIArticleLinkRepository)(nil).UpdateArticleLinkCheckState), link)
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/loak155/techbranch-backend/internal/domain"
@@ -62,6 +63,21 @@ func (mr *MockIArticleRepositoryMockRecorder) DeleteArticle(id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*MockIArticleRepository)(nil).DeleteArticle), id)
 }
 
+// ExistsArticleByNormalizedUrl mocks base method.
+func (m *MockIArticleRepository) ExistsArticleByNormalizedUrl(normalizedUrl string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsArticleByNormalizedUrl", normalizedUrl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsArticleByNormalizedUrl indicates an expected call of ExistsArticleByNormalizedUrl.
+func (mr *MockIArticleRepositoryMockRecorder) ExistsArticleByNormalizedUrl(normalizedUrl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsArticleByNormalizedUrl", reflect.TypeOf((*MockIArticleRepository)(nil).ExistsArticleByNormalizedUrl), normalizedUrl)
+}
+
 // GetArticle mocks base method.
 func (m *MockIArticleRepository) GetArticle(id int) (*domain.Article, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +93,21 @@ func (mr *MockIArticleRepositoryMockRecorder) GetArticle(id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticle", reflect.TypeOf((*MockIArticleRepository)(nil).GetArticle), id)
 }
 
+// GetArticleByNormalizedUrl mocks base method.
+func (m *MockIArticleRepository) GetArticleByNormalizedUrl(normalizedUrl string) (*domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleByNormalizedUrl", normalizedUrl)
+	ret0, _ := ret[0].(*domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleByNormalizedUrl indicates an expected call of GetArticleByNormalizedUrl.
+func (mr *MockIArticleRepositoryMockRecorder) GetArticleByNormalizedUrl(normalizedUrl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleByNormalizedUrl", reflect.TypeOf((*MockIArticleRepository)(nil).GetArticleByNormalizedUrl), normalizedUrl)
+}
+
 // GetArticleCount mocks base method.
 func (m *MockIArticleRepository) GetArticleCount() (int, error) {
 	m.ctrl.T.Helper()
@@ -93,45 +124,180 @@ func (mr *MockIArticleRepositoryMockRecorder) GetArticleCount() *gomock.Call {
 }
 
 // GetBookmarkedArticles mocks base method.
-func (m *MockIArticleRepository) GetBookmarkedArticles(userID int) (*[]domain.Article, error) {
+func (m *MockIArticleRepository) GetBookmarkedArticles(userID int, readState string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBookmarkedArticles", userID)
+	ret := m.ctrl.Call(m, "GetBookmarkedArticles", userID, readState, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Article)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBookmarkedArticles indicates an expected call of GetBookmarkedArticles.
+func (mr *MockIArticleRepositoryMockRecorder) GetBookmarkedArticles(userID, readState, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkedArticles", reflect.TypeOf((*MockIArticleRepository)(nil).GetBookmarkedArticles), userID, readState, pageSize, pageToken)
+}
+
+// ListArticleUrls mocks base method.
+func (m *MockIArticleRepository) ListArticleUrls(afterID, limit int) (*[]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleUrls", afterID, limit)
 	ret0, _ := ret[0].(*[]domain.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBookmarkedArticles indicates an expected call of GetBookmarkedArticles.
-func (mr *MockIArticleRepositoryMockRecorder) GetBookmarkedArticles(userID interface{}) *gomock.Call {
+// ListArticleUrls indicates an expected call of ListArticleUrls.
+func (mr *MockIArticleRepositoryMockRecorder) ListArticleUrls(afterID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkedArticles", reflect.TypeOf((*MockIArticleRepository)(nil).GetBookmarkedArticles), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleUrls", reflect.TypeOf((*MockIArticleRepository)(nil).ListArticleUrls), afterID, limit)
 }
 
 // ListArticles mocks base method.
-func (m *MockIArticleRepository) ListArticles(offset, limit int) (*[]domain.Article, error) {
+func (m *MockIArticleRepository) ListArticles(orderBy string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticles", orderBy, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Article)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticles indicates an expected call of ListArticles.
+func (mr *MockIArticleRepositoryMockRecorder) ListArticles(orderBy, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticles", reflect.TypeOf((*MockIArticleRepository)(nil).ListArticles), orderBy, pageSize, pageToken)
+}
+
+// ListArticlesByIDs mocks base method.
+func (m *MockIArticleRepository) ListArticlesByIDs(ids []int) (*[]domain.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListArticles", offset, limit)
+	ret := m.ctrl.Call(m, "ListArticlesByIDs", ids)
 	ret0, _ := ret[0].(*[]domain.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListArticles indicates an expected call of ListArticles.
-func (mr *MockIArticleRepositoryMockRecorder) ListArticles(offset, limit interface{}) *gomock.Call {
+// ListArticlesByIDs indicates an expected call of ListArticlesByIDs.
+func (mr *MockIArticleRepositoryMockRecorder) ListArticlesByIDs(ids interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticles", reflect.TypeOf((*MockIArticleRepository)(nil).ListArticles), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticlesByIDs", reflect.TypeOf((*MockIArticleRepository)(nil).ListArticlesByIDs), ids)
+}
+
+// ListArticlesByTag mocks base method.
+func (m *MockIArticleRepository) ListArticlesByTag(tag string, pageSize int, pageToken string) (*[]domain.Article, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticlesByTag", tag, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Article)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticlesByTag indicates an expected call of ListArticlesByTag.
+func (mr *MockIArticleRepositoryMockRecorder) ListArticlesByTag(tag, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticlesByTag", reflect.TypeOf((*MockIArticleRepository)(nil).ListArticlesByTag), tag, pageSize, pageToken)
+}
+
+// ListRelatedArticles mocks base method.
+func (m *MockIArticleRepository) ListRelatedArticles(id, limit int) (*[]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelatedArticles", id, limit)
+	ret0, _ := ret[0].(*[]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelatedArticles indicates an expected call of ListRelatedArticles.
+func (mr *MockIArticleRepositoryMockRecorder) ListRelatedArticles(id, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedArticles", reflect.TypeOf((*MockIArticleRepository)(nil).ListRelatedArticles), id, limit)
+}
+
+// PurgeDeletedArticles mocks base method.
+func (m *MockIArticleRepository) PurgeDeletedArticles(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedArticles", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedArticles indicates an expected call of PurgeDeletedArticles.
+func (mr *MockIArticleRepositoryMockRecorder) PurgeDeletedArticles(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedArticles", reflect.TypeOf((*MockIArticleRepository)(nil).PurgeDeletedArticles), before)
+}
+
+// RefreshArticleScores mocks base method.
+func (m *MockIArticleRepository) RefreshArticleScores(gravity float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshArticleScores", gravity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshArticleScores indicates an expected call of RefreshArticleScores.
+func (mr *MockIArticleRepositoryMockRecorder) RefreshArticleScores(gravity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshArticleScores", reflect.TypeOf((*MockIArticleRepository)(nil).RefreshArticleScores), gravity)
+}
+
+// RestoreArticle mocks base method.
+func (m *MockIArticleRepository) RestoreArticle(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArticle", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreArticle indicates an expected call of RestoreArticle.
+func (mr *MockIArticleRepositoryMockRecorder) RestoreArticle(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArticle", reflect.TypeOf((*MockIArticleRepository)(nil).RestoreArticle), id)
+}
+
+// SearchArticles mocks base method.
+func (m *MockIArticleRepository) SearchArticles(query domain.ArticleSearchQuery) (*[]domain.ArticleSearchResult, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchArticles", query)
+	ret0, _ := ret[0].(*[]domain.ArticleSearchResult)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchArticles indicates an expected call of SearchArticles.
+func (mr *MockIArticleRepositoryMockRecorder) SearchArticles(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArticles", reflect.TypeOf((*MockIArticleRepository)(nil).SearchArticles), query)
 }
 
 // UpdateArticle mocks base method.
-func (m *MockIArticleRepository) UpdateArticle(article *domain.Article) error {
+func (m *MockIArticleRepository) UpdateArticle(article *domain.Article, columns []string, editorUserID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateArticle", article)
+	ret := m.ctrl.Call(m, "UpdateArticle", article, columns, editorUserID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateArticle indicates an expected call of UpdateArticle.
-func (mr *MockIArticleRepositoryMockRecorder) UpdateArticle(article interface{}) *gomock.Call {
+func (mr *MockIArticleRepositoryMockRecorder) UpdateArticle(article, columns, editorUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*MockIArticleRepository)(nil).UpdateArticle), article, columns, editorUserID)
+}
+
+// UpdateArticleNormalizedUrl mocks base method.
+func (m *MockIArticleRepository) UpdateArticleNormalizedUrl(id int, normalizedUrl string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticleNormalizedUrl", id, normalizedUrl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArticleNormalizedUrl indicates an expected call of UpdateArticleNormalizedUrl.
+func (mr *MockIArticleRepositoryMockRecorder) UpdateArticleNormalizedUrl(id, normalizedUrl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*MockIArticleRepository)(nil).UpdateArticle), article)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleNormalizedUrl", reflect.TypeOf((*MockIArticleRepository)(nil).UpdateArticleNormalizedUrl), id, normalizedUrl)
 }
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/article_revision_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIArticleRevisionRepository is a mock of IArticleRevisionRepository interface.
+type MockIArticleRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArticleRevisionRepositoryMockRecorder
+}
+
+// MockIArticleRevisionRepositoryMockRecorder is the mock recorder for MockIArticleRevisionRepository.
+type MockIArticleRevisionRepositoryMockRecorder struct {
+	mock *MockIArticleRevisionRepository
+}
+
+// NewMockIArticleRevisionRepository creates a new mock instance.
+func NewMockIArticleRevisionRepository(ctrl *gomock.Controller) *MockIArticleRevisionRepository {
+	mock := &MockIArticleRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockIArticleRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArticleRevisionRepository) EXPECT() *MockIArticleRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetArticleRevision mocks base method.
+func (m *MockIArticleRevisionRepository) GetArticleRevision(articleID, revision int) (*domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleRevision", articleID, revision)
+	ret0, _ := ret[0].(*domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleRevision indicates an expected call of GetArticleRevision.
+func (mr *MockIArticleRevisionRepositoryMockRecorder) GetArticleRevision(articleID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleRevision", reflect.TypeOf((*MockIArticleRevisionRepository)(nil).GetArticleRevision), articleID, revision)
+}
+
+// ListArticleRevisions mocks base method.
+func (m *MockIArticleRevisionRepository) ListArticleRevisions(articleID, pageSize int, pageToken string) (*[]domain.ArticleRevision, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleRevisions", articleID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.ArticleRevision)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticleRevisions indicates an expected call of ListArticleRevisions.
+func (mr *MockIArticleRevisionRepositoryMockRecorder) ListArticleRevisions(articleID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleRevisions", reflect.TypeOf((*MockIArticleRevisionRepository)(nil).ListArticleRevisions), articleID, pageSize, pageToken)
+}
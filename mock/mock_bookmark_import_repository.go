@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/bookmark_import_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIBookmarkImportRepository is a mock of IBookmarkImportRepository interface.
+type MockIBookmarkImportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBookmarkImportRepositoryMockRecorder
+}
+
+// MockIBookmarkImportRepositoryMockRecorder is the mock recorder for MockIBookmarkImportRepository.
+type MockIBookmarkImportRepositoryMockRecorder struct {
+	mock *MockIBookmarkImportRepository
+}
+
+// NewMockIBookmarkImportRepository creates a new mock instance.
+func NewMockIBookmarkImportRepository(ctrl *gomock.Controller) *MockIBookmarkImportRepository {
+	mock := &MockIBookmarkImportRepository{ctrl: ctrl}
+	mock.recorder = &MockIBookmarkImportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBookmarkImportRepository) EXPECT() *MockIBookmarkImportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingBookmarkImport mocks base method.
+func (m *MockIBookmarkImportRepository) ClaimPendingBookmarkImport(staleBefore time.Time) (*domain.BookmarkImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingBookmarkImport", staleBefore)
+	ret0, _ := ret[0].(*domain.BookmarkImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingBookmarkImport indicates an expected call of ClaimPendingBookmarkImport.
+func (mr *MockIBookmarkImportRepositoryMockRecorder) ClaimPendingBookmarkImport(staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingBookmarkImport", reflect.TypeOf((*MockIBookmarkImportRepository)(nil).ClaimPendingBookmarkImport), staleBefore)
+}
+
+// CreateBookmarkImport mocks base method.
+func (m *MockIBookmarkImportRepository) CreateBookmarkImport(bookmarkImport *domain.BookmarkImport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBookmarkImport", bookmarkImport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBookmarkImport indicates an expected call of CreateBookmarkImport.
+func (mr *MockIBookmarkImportRepositoryMockRecorder) CreateBookmarkImport(bookmarkImport interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookmarkImport", reflect.TypeOf((*MockIBookmarkImportRepository)(nil).CreateBookmarkImport), bookmarkImport)
+}
+
+// GetBookmarkImport mocks base method.
+func (m *MockIBookmarkImportRepository) GetBookmarkImport(id int) (*domain.BookmarkImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarkImport", id)
+	ret0, _ := ret[0].(*domain.BookmarkImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarkImport indicates an expected call of GetBookmarkImport.
+func (mr *MockIBookmarkImportRepositoryMockRecorder) GetBookmarkImport(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkImport", reflect.TypeOf((*MockIBookmarkImportRepository)(nil).GetBookmarkImport), id)
+}
+
+// UpdateBookmarkImport mocks base method.
+func (m *MockIBookmarkImportRepository) UpdateBookmarkImport(bookmarkImport *domain.BookmarkImport, importErrors []domain.BookmarkImportError) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookmarkImport", bookmarkImport, importErrors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBookmarkImport indicates an expected call of UpdateBookmarkImport.
+func (mr *MockIBookmarkImportRepositoryMockRecorder) UpdateBookmarkImport(bookmarkImport, importErrors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmarkImport", reflect.TypeOf((*MockIBookmarkImportRepository)(nil).UpdateBookmarkImport), bookmarkImport, importErrors)
+}
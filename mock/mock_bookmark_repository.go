@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/loak155/techbranch-backend/internal/domain"
@@ -90,6 +91,36 @@ func (mr *MockIBookmarkRepositoryMockRecorder) DeleteBookmarkByUserIDAndArticleI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmarkByUserIDAndArticleID", reflect.TypeOf((*MockIBookmarkRepository)(nil).DeleteBookmarkByUserIDAndArticleID), userID, articleID)
 }
 
+// GetBookmark mocks base method.
+func (m *MockIBookmarkRepository) GetBookmark(id int) (*domain.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmark", id)
+	ret0, _ := ret[0].(*domain.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmark indicates an expected call of GetBookmark.
+func (mr *MockIBookmarkRepositoryMockRecorder) GetBookmark(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmark", reflect.TypeOf((*MockIBookmarkRepository)(nil).GetBookmark), id)
+}
+
+// GetBookmarkByUserIDAndArticleID mocks base method.
+func (m *MockIBookmarkRepository) GetBookmarkByUserIDAndArticleID(userID, articleID int) (*domain.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarkByUserIDAndArticleID", userID, articleID)
+	ret0, _ := ret[0].(*domain.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarkByUserIDAndArticleID indicates an expected call of GetBookmarkByUserIDAndArticleID.
+func (mr *MockIBookmarkRepositoryMockRecorder) GetBookmarkByUserIDAndArticleID(userID, articleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkByUserIDAndArticleID", reflect.TypeOf((*MockIBookmarkRepository)(nil).GetBookmarkByUserIDAndArticleID), userID, articleID)
+}
+
 // GetBookmarkCountByArticleID mocks base method.
 func (m *MockIBookmarkRepository) GetBookmarkCountByArticleID(articleID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -105,32 +136,197 @@ func (mr *MockIBookmarkRepositoryMockRecorder) GetBookmarkCountByArticleID(artic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkCountByArticleID", reflect.TypeOf((*MockIBookmarkRepository)(nil).GetBookmarkCountByArticleID), articleID)
 }
 
-// ListBookmarksByArticleID mocks base method.
-func (m *MockIBookmarkRepository) ListBookmarksByArticleID(articleID int) (*[]domain.Bookmark, error) {
+// GetUnreadBookmarkCount mocks base method.
+func (m *MockIBookmarkRepository) GetUnreadBookmarkCount(userID int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBookmarksByArticleID", articleID)
-	ret0, _ := ret[0].(*[]domain.Bookmark)
+	ret := m.ctrl.Call(m, "GetUnreadBookmarkCount", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadBookmarkCount indicates an expected call of GetUnreadBookmarkCount.
+func (mr *MockIBookmarkRepositoryMockRecorder) GetUnreadBookmarkCount(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadBookmarkCount", reflect.TypeOf((*MockIBookmarkRepository)(nil).GetUnreadBookmarkCount), userID)
+}
+
+// ListBookmarkDigestItems mocks base method.
+func (m *MockIBookmarkRepository) ListBookmarkDigestItems(userID, limit int) (*[]domain.BookmarkDigestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarkDigestItems", userID, limit)
+	ret0, _ := ret[0].(*[]domain.BookmarkDigestItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ListBookmarkDigestItems indicates an expected call of ListBookmarkDigestItems.
+func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarkDigestItems(userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarkDigestItems", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarkDigestItems), userID, limit)
+}
+
+// ListBookmarkExportItems mocks base method.
+func (m *MockIBookmarkRepository) ListBookmarkExportItems(userID int) (*[]domain.BookmarkExportItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarkExportItems", userID)
+	ret0, _ := ret[0].(*[]domain.BookmarkExportItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookmarkExportItems indicates an expected call of ListBookmarkExportItems.
+func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarkExportItems(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarkExportItems", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarkExportItems), userID)
+}
+
+// ListBookmarksByArticleID mocks base method.
+func (m *MockIBookmarkRepository) ListBookmarksByArticleID(articleID, pageSize int, pageToken string) (*[]domain.Bookmark, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarksByArticleID", articleID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Bookmark)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // ListBookmarksByArticleID indicates an expected call of ListBookmarksByArticleID.
-func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarksByArticleID(articleID interface{}) *gomock.Call {
+func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarksByArticleID(articleID, pageSize, pageToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarksByArticleID", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarksByArticleID), articleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarksByArticleID", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarksByArticleID), articleID, pageSize, pageToken)
 }
 
 // ListBookmarksByUserID mocks base method.
-func (m *MockIBookmarkRepository) ListBookmarksByUserID(userID int) (*[]domain.Bookmark, error) {
+func (m *MockIBookmarkRepository) ListBookmarksByUserID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBookmarksByUserID", userID)
+	ret := m.ctrl.Call(m, "ListBookmarksByUserID", query)
 	ret0, _ := ret[0].(*[]domain.Bookmark)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBookmarksByUserID indicates an expected call of ListBookmarksByUserID.
+func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarksByUserID(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarksByUserID", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarksByUserID), query)
+}
+
+// ListBookmarksByWorkspaceID mocks base method.
+func (m *MockIBookmarkRepository) ListBookmarksByWorkspaceID(query domain.BookmarkListQuery) (*[]domain.Bookmark, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarksByWorkspaceID", query)
+	ret0, _ := ret[0].(*[]domain.Bookmark)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBookmarksByWorkspaceID indicates an expected call of ListBookmarksByWorkspaceID.
+func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarksByWorkspaceID(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarksByWorkspaceID", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarksByWorkspaceID), query)
+}
+
+// ListDueBookmarkReminders mocks base method.
+func (m *MockIBookmarkRepository) ListDueBookmarkReminders(now time.Time, limit int) (*[]domain.BookmarkReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueBookmarkReminders", now, limit)
+	ret0, _ := ret[0].(*[]domain.BookmarkReminder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBookmarksByUserID indicates an expected call of ListBookmarksByUserID.
-func (mr *MockIBookmarkRepositoryMockRecorder) ListBookmarksByUserID(userID interface{}) *gomock.Call {
+// ListDueBookmarkReminders indicates an expected call of ListDueBookmarkReminders.
+func (mr *MockIBookmarkRepositoryMockRecorder) ListDueBookmarkReminders(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueBookmarkReminders", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListDueBookmarkReminders), now, limit)
+}
+
+// MarkBookmarkReminded mocks base method.
+func (m *MockIBookmarkRepository) MarkBookmarkReminded(id int, remindedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBookmarkReminded", id, remindedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBookmarkReminded indicates an expected call of MarkBookmarkReminded.
+func (mr *MockIBookmarkRepositoryMockRecorder) MarkBookmarkReminded(id, remindedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBookmarkReminded", reflect.TypeOf((*MockIBookmarkRepository)(nil).MarkBookmarkReminded), id, remindedAt)
+}
+
+// MarkBookmarkReminderFailed mocks base method.
+func (m *MockIBookmarkRepository) MarkBookmarkReminderFailed(id, errorCount int, retryAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBookmarkReminderFailed", id, errorCount, retryAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBookmarkReminderFailed indicates an expected call of MarkBookmarkReminderFailed.
+func (mr *MockIBookmarkRepositoryMockRecorder) MarkBookmarkReminderFailed(id, errorCount, retryAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBookmarkReminderFailed", reflect.TypeOf((*MockIBookmarkRepository)(nil).MarkBookmarkReminderFailed), id, errorCount, retryAt)
+}
+
+// PurgeDeletedBookmarks mocks base method.
+func (m *MockIBookmarkRepository) PurgeDeletedBookmarks(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBookmarks", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedBookmarks indicates an expected call of PurgeDeletedBookmarks.
+func (mr *MockIBookmarkRepositoryMockRecorder) PurgeDeletedBookmarks(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBookmarks", reflect.TypeOf((*MockIBookmarkRepository)(nil).PurgeDeletedBookmarks), before)
+}
+
+// SearchBookmarks mocks base method.
+func (m *MockIBookmarkRepository) SearchBookmarks(query domain.BookmarkSearchQuery) (*[]domain.BookmarkSearchResult, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBookmarks", query)
+	ret0, _ := ret[0].(*[]domain.BookmarkSearchResult)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchBookmarks indicates an expected call of SearchBookmarks.
+func (mr *MockIBookmarkRepositoryMockRecorder) SearchBookmarks(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBookmarks", reflect.TypeOf((*MockIBookmarkRepository)(nil).SearchBookmarks), query)
+}
+
+// SetBookmarksRead mocks base method.
+func (m *MockIBookmarkRepository) SetBookmarksRead(userID int, articleIDs []int, read bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBookmarksRead", userID, articleIDs, read)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBookmarksRead indicates an expected call of SetBookmarksRead.
+func (mr *MockIBookmarkRepositoryMockRecorder) SetBookmarksRead(userID, articleIDs, read interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBookmarksRead", reflect.TypeOf((*MockIBookmarkRepository)(nil).SetBookmarksRead), userID, articleIDs, read)
+}
+
+// UpdateBookmark mocks base method.
+func (m *MockIBookmarkRepository) UpdateBookmark(bookmark *domain.Bookmark, columns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookmark", bookmark, columns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBookmark indicates an expected call of UpdateBookmark.
+func (mr *MockIBookmarkRepositoryMockRecorder) UpdateBookmark(bookmark, columns interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarksByUserID", reflect.TypeOf((*MockIBookmarkRepository)(nil).ListBookmarksByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmark", reflect.TypeOf((*MockIBookmarkRepository)(nil).UpdateBookmark), bookmark, columns)
 }
@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/collection_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockICollectionRepository is a mock of ICollectionRepository interface.
+type MockICollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICollectionRepositoryMockRecorder
+}
+
+// MockICollectionRepositoryMockRecorder is the mock recorder for MockICollectionRepository.
+type MockICollectionRepositoryMockRecorder struct {
+	mock *MockICollectionRepository
+}
+
+// NewMockICollectionRepository creates a new mock instance.
+func NewMockICollectionRepository(ctrl *gomock.Controller) *MockICollectionRepository {
+	mock := &MockICollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockICollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICollectionRepository) EXPECT() *MockICollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCollection mocks base method.
+func (m *MockICollectionRepository) CreateCollection(collection *domain.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockICollectionRepositoryMockRecorder) CreateCollection(collection interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockICollectionRepository)(nil).CreateCollection), collection)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockICollectionRepository) DeleteCollection(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockICollectionRepositoryMockRecorder) DeleteCollection(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockICollectionRepository)(nil).DeleteCollection), id)
+}
+
+// GetCollection mocks base method.
+func (m *MockICollectionRepository) GetCollection(id int) (*domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", id)
+	ret0, _ := ret[0].(*domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollection(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollection), id)
+}
+
+// GetCollectionByShareToken mocks base method.
+func (m *MockICollectionRepository) GetCollectionByShareToken(shareToken string) (*domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByShareToken", shareToken)
+	ret0, _ := ret[0].(*domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByShareToken indicates an expected call of GetCollectionByShareToken.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollectionByShareToken(shareToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByShareToken", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollectionByShareToken), shareToken)
+}
+
+// GetCollectionByUserIDAndName mocks base method.
+func (m *MockICollectionRepository) GetCollectionByUserIDAndName(userID int, name string) (*domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByUserIDAndName", userID, name)
+	ret0, _ := ret[0].(*domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByUserIDAndName indicates an expected call of GetCollectionByUserIDAndName.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollectionByUserIDAndName(userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByUserIDAndName", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollectionByUserIDAndName), userID, name)
+}
+
+// GetCollectionByWorkspaceIDAndName mocks base method.
+func (m *MockICollectionRepository) GetCollectionByWorkspaceIDAndName(workspaceID int, name string) (*domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByWorkspaceIDAndName", workspaceID, name)
+	ret0, _ := ret[0].(*domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByWorkspaceIDAndName indicates an expected call of GetCollectionByWorkspaceIDAndName.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollectionByWorkspaceIDAndName(workspaceID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByWorkspaceIDAndName", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollectionByWorkspaceIDAndName), workspaceID, name)
+}
+
+// ListCollectionsByUserID mocks base method.
+func (m *MockICollectionRepository) ListCollectionsByUserID(userID, pageSize int, pageToken string) (*[]domain.Collection, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollectionsByUserID", userID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Collection)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCollectionsByUserID indicates an expected call of ListCollectionsByUserID.
+func (mr *MockICollectionRepositoryMockRecorder) ListCollectionsByUserID(userID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollectionsByUserID", reflect.TypeOf((*MockICollectionRepository)(nil).ListCollectionsByUserID), userID, pageSize, pageToken)
+}
+
+// ListCollectionsByWorkspaceID mocks base method.
+func (m *MockICollectionRepository) ListCollectionsByWorkspaceID(workspaceID, pageSize int, pageToken string) (*[]domain.Collection, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollectionsByWorkspaceID", workspaceID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Collection)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCollectionsByWorkspaceID indicates an expected call of ListCollectionsByWorkspaceID.
+func (mr *MockICollectionRepositoryMockRecorder) ListCollectionsByWorkspaceID(workspaceID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollectionsByWorkspaceID", reflect.TypeOf((*MockICollectionRepository)(nil).ListCollectionsByWorkspaceID), workspaceID, pageSize, pageToken)
+}
+
+// MoveBookmarks mocks base method.
+func (m *MockICollectionRepository) MoveBookmarks(userID int, bookmarkIDs []int, collectionID *uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBookmarks", userID, bookmarkIDs, collectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveBookmarks indicates an expected call of MoveBookmarks.
+func (mr *MockICollectionRepositoryMockRecorder) MoveBookmarks(userID, bookmarkIDs, collectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBookmarks", reflect.TypeOf((*MockICollectionRepository)(nil).MoveBookmarks), userID, bookmarkIDs, collectionID)
+}
+
+// ReorderCollectionBookmarks mocks base method.
+func (m *MockICollectionRepository) ReorderCollectionBookmarks(collectionID int, bookmarkIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCollectionBookmarks", collectionID, bookmarkIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCollectionBookmarks indicates an expected call of ReorderCollectionBookmarks.
+func (mr *MockICollectionRepositoryMockRecorder) ReorderCollectionBookmarks(collectionID, bookmarkIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCollectionBookmarks", reflect.TypeOf((*MockICollectionRepository)(nil).ReorderCollectionBookmarks), collectionID, bookmarkIDs)
+}
+
+// ReorderCollections mocks base method.
+func (m *MockICollectionRepository) ReorderCollections(userID int, collectionIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCollections", userID, collectionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCollections indicates an expected call of ReorderCollections.
+func (mr *MockICollectionRepositoryMockRecorder) ReorderCollections(userID, collectionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCollections", reflect.TypeOf((*MockICollectionRepository)(nil).ReorderCollections), userID, collectionIDs)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockICollectionRepository) UpdateCollection(collection *domain.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockICollectionRepositoryMockRecorder) UpdateCollection(collection interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockICollectionRepository)(nil).UpdateCollection), collection)
+}
+
+// UpdateCollectionVisibility mocks base method.
+func (m *MockICollectionRepository) UpdateCollectionVisibility(collection *domain.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollectionVisibility", collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollectionVisibility indicates an expected call of UpdateCollectionVisibility.
+func (mr *MockICollectionRepositoryMockRecorder) UpdateCollectionVisibility(collection interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollectionVisibility", reflect.TypeOf((*MockICollectionRepository)(nil).UpdateCollectionVisibility), collection)
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/loak155/techbranch-backend/internal/domain"
@@ -104,32 +105,93 @@ func (mr *MockICommentRepositoryMockRecorder) DeleteCommentByUserIDAndArticleID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentByUserIDAndArticleID", reflect.TypeOf((*MockICommentRepository)(nil).DeleteCommentByUserIDAndArticleID), userID, articleID)
 }
 
-// ListCommentsByArticleID mocks base method.
-func (m *MockICommentRepository) ListCommentsByArticleID(articleID int) (*[]domain.Comment, error) {
+// GetComment mocks base method.
+func (m *MockICommentRepository) GetComment(id int) (*domain.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCommentsByArticleID", articleID)
-	ret0, _ := ret[0].(*[]domain.Comment)
+	ret := m.ctrl.Call(m, "GetComment", id)
+	ret0, _ := ret[0].(*domain.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetComment indicates an expected call of GetComment.
+func (mr *MockICommentRepositoryMockRecorder) GetComment(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockICommentRepository)(nil).GetComment), id)
+}
+
+// ListCommentsByArticleID mocks base method.
+func (m *MockICommentRepository) ListCommentsByArticleID(articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentsByArticleID", articleID, parentCommentID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Comment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // ListCommentsByArticleID indicates an expected call of ListCommentsByArticleID.
-func (mr *MockICommentRepositoryMockRecorder) ListCommentsByArticleID(articleID interface{}) *gomock.Call {
+func (mr *MockICommentRepositoryMockRecorder) ListCommentsByArticleID(articleID, parentCommentID, pageSize, pageToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByArticleID", reflect.TypeOf((*MockICommentRepository)(nil).ListCommentsByArticleID), articleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByArticleID", reflect.TypeOf((*MockICommentRepository)(nil).ListCommentsByArticleID), articleID, parentCommentID, pageSize, pageToken)
 }
 
 // ListCommentsByUserID mocks base method.
-func (m *MockICommentRepository) ListCommentsByUserID(userID int) (*[]domain.Comment, error) {
+func (m *MockICommentRepository) ListCommentsByUserID(userID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCommentsByUserID", userID)
+	ret := m.ctrl.Call(m, "ListCommentsByUserID", userID, pageSize, pageToken)
 	ret0, _ := ret[0].(*[]domain.Comment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ListCommentsByUserID indicates an expected call of ListCommentsByUserID.
-func (mr *MockICommentRepositoryMockRecorder) ListCommentsByUserID(userID interface{}) *gomock.Call {
+func (mr *MockICommentRepositoryMockRecorder) ListCommentsByUserID(userID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByUserID", reflect.TypeOf((*MockICommentRepository)(nil).ListCommentsByUserID), userID, pageSize, pageToken)
+}
+
+// ListCommentsByWorkspaceIDAndArticleID mocks base method.
+func (m *MockICommentRepository) ListCommentsByWorkspaceIDAndArticleID(workspaceID, articleID, parentCommentID, pageSize int, pageToken string) (*[]domain.Comment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentsByWorkspaceIDAndArticleID", workspaceID, articleID, parentCommentID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.Comment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCommentsByWorkspaceIDAndArticleID indicates an expected call of ListCommentsByWorkspaceIDAndArticleID.
+func (mr *MockICommentRepositoryMockRecorder) ListCommentsByWorkspaceIDAndArticleID(workspaceID, articleID, parentCommentID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByWorkspaceIDAndArticleID", reflect.TypeOf((*MockICommentRepository)(nil).ListCommentsByWorkspaceIDAndArticleID), workspaceID, articleID, parentCommentID, pageSize, pageToken)
+}
+
+// PurgeDeletedComments mocks base method.
+func (m *MockICommentRepository) PurgeDeletedComments(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedComments", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedComments indicates an expected call of PurgeDeletedComments.
+func (mr *MockICommentRepositoryMockRecorder) PurgeDeletedComments(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedComments", reflect.TypeOf((*MockICommentRepository)(nil).PurgeDeletedComments), before)
+}
+
+// RestoreComment mocks base method.
+func (m *MockICommentRepository) RestoreComment(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockICommentRepositoryMockRecorder) RestoreComment(id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentsByUserID", reflect.TypeOf((*MockICommentRepository)(nil).ListCommentsByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockICommentRepository)(nil).RestoreComment), id)
 }
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/feed_source_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIFeedSourceRepository is a mock of IFeedSourceRepository interface.
+type MockIFeedSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFeedSourceRepositoryMockRecorder
+}
+
+// MockIFeedSourceRepositoryMockRecorder is the mock recorder for MockIFeedSourceRepository.
+type MockIFeedSourceRepositoryMockRecorder struct {
+	mock *MockIFeedSourceRepository
+}
+
+// NewMockIFeedSourceRepository creates a new mock instance.
+func NewMockIFeedSourceRepository(ctrl *gomock.Controller) *MockIFeedSourceRepository {
+	mock := &MockIFeedSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockIFeedSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFeedSourceRepository) EXPECT() *MockIFeedSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFeedSource mocks base method.
+func (m *MockIFeedSourceRepository) CreateFeedSource(feedSource *domain.FeedSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedSource", feedSource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFeedSource indicates an expected call of CreateFeedSource.
+func (mr *MockIFeedSourceRepositoryMockRecorder) CreateFeedSource(feedSource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedSource", reflect.TypeOf((*MockIFeedSourceRepository)(nil).CreateFeedSource), feedSource)
+}
+
+// DeleteFeedSource mocks base method.
+func (m *MockIFeedSourceRepository) DeleteFeedSource(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeedSource", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeedSource indicates an expected call of DeleteFeedSource.
+func (mr *MockIFeedSourceRepositoryMockRecorder) DeleteFeedSource(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeedSource", reflect.TypeOf((*MockIFeedSourceRepository)(nil).DeleteFeedSource), id)
+}
+
+// GetFeedSource mocks base method.
+func (m *MockIFeedSourceRepository) GetFeedSource(id int) (*domain.FeedSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedSource", id)
+	ret0, _ := ret[0].(*domain.FeedSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedSource indicates an expected call of GetFeedSource.
+func (mr *MockIFeedSourceRepositoryMockRecorder) GetFeedSource(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedSource", reflect.TypeOf((*MockIFeedSourceRepository)(nil).GetFeedSource), id)
+}
+
+// GetFeedSourceByUrl mocks base method.
+func (m *MockIFeedSourceRepository) GetFeedSourceByUrl(url string) (*domain.FeedSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedSourceByUrl", url)
+	ret0, _ := ret[0].(*domain.FeedSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedSourceByUrl indicates an expected call of GetFeedSourceByUrl.
+func (mr *MockIFeedSourceRepositoryMockRecorder) GetFeedSourceByUrl(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedSourceByUrl", reflect.TypeOf((*MockIFeedSourceRepository)(nil).GetFeedSourceByUrl), url)
+}
+
+// ListAllFeedSources mocks base method.
+func (m *MockIFeedSourceRepository) ListAllFeedSources() (*[]domain.FeedSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllFeedSources")
+	ret0, _ := ret[0].(*[]domain.FeedSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllFeedSources indicates an expected call of ListAllFeedSources.
+func (mr *MockIFeedSourceRepositoryMockRecorder) ListAllFeedSources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllFeedSources", reflect.TypeOf((*MockIFeedSourceRepository)(nil).ListAllFeedSources))
+}
+
+// ListDueFeedSources mocks base method.
+func (m *MockIFeedSourceRepository) ListDueFeedSources(now time.Time, limit int) (*[]domain.FeedSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueFeedSources", now, limit)
+	ret0, _ := ret[0].(*[]domain.FeedSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueFeedSources indicates an expected call of ListDueFeedSources.
+func (mr *MockIFeedSourceRepositoryMockRecorder) ListDueFeedSources(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueFeedSources", reflect.TypeOf((*MockIFeedSourceRepository)(nil).ListDueFeedSources), now, limit)
+}
+
+// ListFeedSources mocks base method.
+func (m *MockIFeedSourceRepository) ListFeedSources(pageSize int, pageToken string) (*[]domain.FeedSource, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeedSources", pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.FeedSource)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFeedSources indicates an expected call of ListFeedSources.
+func (mr *MockIFeedSourceRepositoryMockRecorder) ListFeedSources(pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeedSources", reflect.TypeOf((*MockIFeedSourceRepository)(nil).ListFeedSources), pageSize, pageToken)
+}
+
+// UpdateFeedSourceFetchState mocks base method.
+func (m *MockIFeedSourceRepository) UpdateFeedSourceFetchState(feedSource *domain.FeedSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeedSourceFetchState", feedSource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFeedSourceFetchState indicates an expected call of UpdateFeedSourceFetchState.
+func (mr *MockIFeedSourceRepositoryMockRecorder) UpdateFeedSourceFetchState(feedSource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeedSourceFetchState", reflect.TypeOf((*MockIFeedSourceRepository)(nil).UpdateFeedSourceFetchState), feedSource)
+}
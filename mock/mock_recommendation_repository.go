@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/recommendation_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIRecommendationRepository is a mock of IRecommendationRepository interface.
+type MockIRecommendationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecommendationRepositoryMockRecorder
+}
+
+// MockIRecommendationRepositoryMockRecorder is the mock recorder for MockIRecommendationRepository.
+type MockIRecommendationRepositoryMockRecorder struct {
+	mock *MockIRecommendationRepository
+}
+
+// NewMockIRecommendationRepository creates a new mock instance.
+func NewMockIRecommendationRepository(ctrl *gomock.Controller) *MockIRecommendationRepository {
+	mock := &MockIRecommendationRepository{ctrl: ctrl}
+	mock.recorder = &MockIRecommendationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecommendationRepository) EXPECT() *MockIRecommendationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListRecommendedArticles mocks base method.
+func (m *MockIRecommendationRepository) ListRecommendedArticles(userID, pageSize int, pageToken string) (*[]domain.RecommendedArticle, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecommendedArticles", userID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.RecommendedArticle)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRecommendedArticles indicates an expected call of ListRecommendedArticles.
+func (mr *MockIRecommendationRepositoryMockRecorder) ListRecommendedArticles(userID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecommendedArticles", reflect.TypeOf((*MockIRecommendationRepository)(nil).ListRecommendedArticles), userID, pageSize, pageToken)
+}
+
+// RefreshRecommendations mocks base method.
+func (m *MockIRecommendationRepository) RefreshRecommendations(limitPerUser int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshRecommendations", limitPerUser)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshRecommendations indicates an expected call of RefreshRecommendations.
+func (mr *MockIRecommendationRepositoryMockRecorder) RefreshRecommendations(limitPerUser interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshRecommendations", reflect.TypeOf((*MockIRecommendationRepository)(nil).RefreshRecommendations), limitPerUser)
+}
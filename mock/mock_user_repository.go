@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/loak155/techbranch-backend/internal/domain"
@@ -92,31 +93,131 @@ func (mr *MockIUserRepositoryMockRecorder) GetUserByEmail(email interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockIUserRepository)(nil).GetUserByEmail), email)
 }
 
-// ListUsers mocks base method.
-func (m *MockIUserRepository) ListUsers(offset, limit int) (*[]domain.User, error) {
+// ListDigestRecipients mocks base method.
+func (m *MockIUserRepository) ListDigestRecipients(now, sentBefore time.Time, limit int) (*[]domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", offset, limit)
+	ret := m.ctrl.Call(m, "ListDigestRecipients", now, sentBefore, limit)
 	ret0, _ := ret[0].(*[]domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ListDigestRecipients indicates an expected call of ListDigestRecipients.
+func (mr *MockIUserRepositoryMockRecorder) ListDigestRecipients(now, sentBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestRecipients", reflect.TypeOf((*MockIUserRepository)(nil).ListDigestRecipients), now, sentBefore, limit)
+}
+
+// ListUsers mocks base method.
+func (m *MockIUserRepository) ListUsers(pageSize int, pageToken string) (*[]domain.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockIUserRepositoryMockRecorder) ListUsers(offset, limit interface{}) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) ListUsers(pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockIUserRepository)(nil).ListUsers), pageSize, pageToken)
+}
+
+// PurgeDeletedUsers mocks base method.
+func (m *MockIUserRepository) PurgeDeletedUsers(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedUsers", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedUsers indicates an expected call of PurgeDeletedUsers.
+func (mr *MockIUserRepositoryMockRecorder) PurgeDeletedUsers(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedUsers", reflect.TypeOf((*MockIUserRepository)(nil).PurgeDeletedUsers), before)
+}
+
+// RestoreUser mocks base method.
+func (m *MockIUserRepository) RestoreUser(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockIUserRepositoryMockRecorder) RestoreUser(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockIUserRepository)(nil).RestoreUser), id)
+}
+
+// UpdateBookmarksPublic mocks base method.
+func (m *MockIUserRepository) UpdateBookmarksPublic(id int, public bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookmarksPublic", id, public)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBookmarksPublic indicates an expected call of UpdateBookmarksPublic.
+func (mr *MockIUserRepositoryMockRecorder) UpdateBookmarksPublic(id, public interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmarksPublic", reflect.TypeOf((*MockIUserRepository)(nil).UpdateBookmarksPublic), id, public)
+}
+
+// UpdateDigestEnabled mocks base method.
+func (m *MockIUserRepository) UpdateDigestEnabled(id int, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDigestEnabled", id, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDigestEnabled indicates an expected call of UpdateDigestEnabled.
+func (mr *MockIUserRepositoryMockRecorder) UpdateDigestEnabled(id, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDigestEnabled", reflect.TypeOf((*MockIUserRepository)(nil).UpdateDigestEnabled), id, enabled)
+}
+
+// UpdateDigestFailed mocks base method.
+func (m *MockIUserRepository) UpdateDigestFailed(id, errorCount int, retryAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDigestFailed", id, errorCount, retryAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDigestFailed indicates an expected call of UpdateDigestFailed.
+func (mr *MockIUserRepositoryMockRecorder) UpdateDigestFailed(id, errorCount, retryAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDigestFailed", reflect.TypeOf((*MockIUserRepository)(nil).UpdateDigestFailed), id, errorCount, retryAt)
+}
+
+// UpdateDigestSentAt mocks base method.
+func (m *MockIUserRepository) UpdateDigestSentAt(id int, sentAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDigestSentAt", id, sentAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDigestSentAt indicates an expected call of UpdateDigestSentAt.
+func (mr *MockIUserRepositoryMockRecorder) UpdateDigestSentAt(id, sentAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockIUserRepository)(nil).ListUsers), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDigestSentAt", reflect.TypeOf((*MockIUserRepository)(nil).UpdateDigestSentAt), id, sentAt)
 }
 
 // UpdateUser mocks base method.
-func (m *MockIUserRepository) UpdateUser(user *domain.User) error {
+func (m *MockIUserRepository) UpdateUser(user *domain.User, columns []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", user)
+	ret := m.ctrl.Call(m, "UpdateUser", user, columns)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockIUserRepositoryMockRecorder) UpdateUser(user interface{}) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) UpdateUser(user, columns interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserRepository)(nil).UpdateUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserRepository)(nil).UpdateUser), user, columns)
 }
@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/workspace_repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/loak155/techbranch-backend/internal/domain"
+)
+
+// MockIWorkspaceRepository is a mock of IWorkspaceRepository interface.
+type MockIWorkspaceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWorkspaceRepositoryMockRecorder
+}
+
+// MockIWorkspaceRepositoryMockRecorder is the mock recorder for MockIWorkspaceRepository.
+type MockIWorkspaceRepositoryMockRecorder struct {
+	mock *MockIWorkspaceRepository
+}
+
+// NewMockIWorkspaceRepository creates a new mock instance.
+func NewMockIWorkspaceRepository(ctrl *gomock.Controller) *MockIWorkspaceRepository {
+	mock := &MockIWorkspaceRepository{ctrl: ctrl}
+	mock.recorder = &MockIWorkspaceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWorkspaceRepository) EXPECT() *MockIWorkspaceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptWorkspaceInvitation mocks base method.
+func (m *MockIWorkspaceRepository) AcceptWorkspaceInvitation(invitation *domain.WorkspaceInvitation, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptWorkspaceInvitation", invitation, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptWorkspaceInvitation indicates an expected call of AcceptWorkspaceInvitation.
+func (mr *MockIWorkspaceRepositoryMockRecorder) AcceptWorkspaceInvitation(invitation, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptWorkspaceInvitation", reflect.TypeOf((*MockIWorkspaceRepository)(nil).AcceptWorkspaceInvitation), invitation, userID)
+}
+
+// CountWorkspaceOwners mocks base method.
+func (m *MockIWorkspaceRepository) CountWorkspaceOwners(workspaceID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkspaceOwners", workspaceID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkspaceOwners indicates an expected call of CountWorkspaceOwners.
+func (mr *MockIWorkspaceRepositoryMockRecorder) CountWorkspaceOwners(workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkspaceOwners", reflect.TypeOf((*MockIWorkspaceRepository)(nil).CountWorkspaceOwners), workspaceID)
+}
+
+// CreateWorkspace mocks base method.
+func (m *MockIWorkspaceRepository) CreateWorkspace(workspace *domain.Workspace, ownerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspace", workspace, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockIWorkspaceRepositoryMockRecorder) CreateWorkspace(workspace, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockIWorkspaceRepository)(nil).CreateWorkspace), workspace, ownerID)
+}
+
+// CreateWorkspaceInvitation mocks base method.
+func (m *MockIWorkspaceRepository) CreateWorkspaceInvitation(invitation *domain.WorkspaceInvitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspaceInvitation", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkspaceInvitation indicates an expected call of CreateWorkspaceInvitation.
+func (mr *MockIWorkspaceRepositoryMockRecorder) CreateWorkspaceInvitation(invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspaceInvitation", reflect.TypeOf((*MockIWorkspaceRepository)(nil).CreateWorkspaceInvitation), invitation)
+}
+
+// DeleteWorkspace mocks base method.
+func (m *MockIWorkspaceRepository) DeleteWorkspace(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspace", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspace indicates an expected call of DeleteWorkspace.
+func (mr *MockIWorkspaceRepositoryMockRecorder) DeleteWorkspace(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspace", reflect.TypeOf((*MockIWorkspaceRepository)(nil).DeleteWorkspace), id)
+}
+
+// DeleteWorkspaceInvitation mocks base method.
+func (m *MockIWorkspaceRepository) DeleteWorkspaceInvitation(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceInvitation", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceInvitation indicates an expected call of DeleteWorkspaceInvitation.
+func (mr *MockIWorkspaceRepositoryMockRecorder) DeleteWorkspaceInvitation(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceInvitation", reflect.TypeOf((*MockIWorkspaceRepository)(nil).DeleteWorkspaceInvitation), id)
+}
+
+// DeleteWorkspaceMember mocks base method.
+func (m *MockIWorkspaceRepository) DeleteWorkspaceMember(workspaceID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceMember", workspaceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceMember indicates an expected call of DeleteWorkspaceMember.
+func (mr *MockIWorkspaceRepositoryMockRecorder) DeleteWorkspaceMember(workspaceID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceMember", reflect.TypeOf((*MockIWorkspaceRepository)(nil).DeleteWorkspaceMember), workspaceID, userID)
+}
+
+// GetWorkspace mocks base method.
+func (m *MockIWorkspaceRepository) GetWorkspace(id int) (*domain.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspace", id)
+	ret0, _ := ret[0].(*domain.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspace indicates an expected call of GetWorkspace.
+func (mr *MockIWorkspaceRepositoryMockRecorder) GetWorkspace(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspace", reflect.TypeOf((*MockIWorkspaceRepository)(nil).GetWorkspace), id)
+}
+
+// GetWorkspaceInvitationByToken mocks base method.
+func (m *MockIWorkspaceRepository) GetWorkspaceInvitationByToken(token string) (*domain.WorkspaceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceInvitationByToken", token)
+	ret0, _ := ret[0].(*domain.WorkspaceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceInvitationByToken indicates an expected call of GetWorkspaceInvitationByToken.
+func (mr *MockIWorkspaceRepositoryMockRecorder) GetWorkspaceInvitationByToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceInvitationByToken", reflect.TypeOf((*MockIWorkspaceRepository)(nil).GetWorkspaceInvitationByToken), token)
+}
+
+// GetWorkspaceMember mocks base method.
+func (m *MockIWorkspaceRepository) GetWorkspaceMember(workspaceID, userID int) (*domain.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceMember", workspaceID, userID)
+	ret0, _ := ret[0].(*domain.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceMember indicates an expected call of GetWorkspaceMember.
+func (mr *MockIWorkspaceRepositoryMockRecorder) GetWorkspaceMember(workspaceID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceMember", reflect.TypeOf((*MockIWorkspaceRepository)(nil).GetWorkspaceMember), workspaceID, userID)
+}
+
+// ListWorkspaceMembers mocks base method.
+func (m *MockIWorkspaceRepository) ListWorkspaceMembers(workspaceID, pageSize int, pageToken string) (*[]domain.WorkspaceMember, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceMembers", workspaceID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.WorkspaceMember)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWorkspaceMembers indicates an expected call of ListWorkspaceMembers.
+func (mr *MockIWorkspaceRepositoryMockRecorder) ListWorkspaceMembers(workspaceID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceMembers", reflect.TypeOf((*MockIWorkspaceRepository)(nil).ListWorkspaceMembers), workspaceID, pageSize, pageToken)
+}
+
+// ListWorkspacesByUserID mocks base method.
+func (m *MockIWorkspaceRepository) ListWorkspacesByUserID(userID, pageSize int, pageToken string) (*[]domain.WorkspaceMembership, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspacesByUserID", userID, pageSize, pageToken)
+	ret0, _ := ret[0].(*[]domain.WorkspaceMembership)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWorkspacesByUserID indicates an expected call of ListWorkspacesByUserID.
+func (mr *MockIWorkspaceRepositoryMockRecorder) ListWorkspacesByUserID(userID, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspacesByUserID", reflect.TypeOf((*MockIWorkspaceRepository)(nil).ListWorkspacesByUserID), userID, pageSize, pageToken)
+}
+
+// UpdateWorkspaceMember mocks base method.
+func (m *MockIWorkspaceRepository) UpdateWorkspaceMember(member *domain.WorkspaceMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkspaceMember indicates an expected call of UpdateWorkspaceMember.
+func (mr *MockIWorkspaceRepositoryMockRecorder) UpdateWorkspaceMember(member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceMember", reflect.TypeOf((*MockIWorkspaceRepository)(nil).UpdateWorkspaceMember), member)
+}
@@ -9,9 +9,17 @@ var AuthRequests = []AuthRequest{
 	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/articles$`), Auth: true},
 	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/articles$`), Auth: true},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/[0-9]*$`), Auth: false},
+	{Mehtod: "PATCH", URL: regexp.MustCompile(`/v1/articles/[0-9]*$`), Auth: true},
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/articles/[0-9]*$`), Auth: true},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/counts$`), Auth: false},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/users/[0-9]*/bookmarks/articles$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/search$`), Auth: false},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/articles/[0-9]*/restore$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/[0-9]*/revisions$`), Auth: false},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/articles/[0-9]*/revisions/[0-9]*/revert$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/[0-9]*/revisions/diff$`), Auth: false},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/[0-9]*/related$`), Auth: false},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/recommended$`), Auth: true},
 
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/oauth/google/callback`), Auth: false},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/oauth/google/login$`), Auth: false},
@@ -29,6 +37,24 @@ var AuthRequests = []AuthRequest{
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/users/[0-9]*/articles/[0-9]*/bookmarks$`), Auth: true},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/users/[0-9]*/bookmarks$`), Auth: true},
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/users/[0-9]*/bookmarks$`), Auth: true},
+	{Mehtod: "PATCH", URL: regexp.MustCompile(`/v1/bookmarks/[0-9]*$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/bookmarks/search$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/bookmarks/read$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/bookmarks/unread$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/bookmarks/import$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/bookmarks/imports/[0-9]*$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/bookmarks/export$`), Auth: true},
+
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/collections$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/collections$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/collections/[0-9]*$`), Auth: true},
+	{Mehtod: "PATCH", URL: regexp.MustCompile(`/v1/collections/[0-9]*$`), Auth: true},
+	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/collections/[0-9]*$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/collections/reorder$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/collections/[0-9]*/bookmarks/reorder$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/collections/move-bookmarks$`), Auth: true},
+	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/collections/[0-9]*/visibility$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/shared-collections/[^/]+$`), Auth: false},
 
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/articles/[0-9]*/comments$`), Auth: false},
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/articles/[0-9]*/comments$`), Auth: true},
@@ -37,22 +63,66 @@ var AuthRequests = []AuthRequest{
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/users/[0-9]*/articles/[0-9]*/comments$`), Auth: true},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/users/[0-9]*/comments$`), Auth: true},
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/users/[0-9]*/comments$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/comments/[0-9]*/restore$`), Auth: true},
+
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/workspaces$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/workspaces$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*$`), Auth: true},
+	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/members$`), Auth: true},
+	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/members/[0-9]*$`), Auth: true},
+	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/members/[0-9]*$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/invitations$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/workspace-invitations/[^/]+/accept$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/bookmarks$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/workspaces/[0-9]*/articles/[0-9]*/comments$`), Auth: true},
+
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/feed-sources$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/feed-sources$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/feed-sources/[0-9]*$`), Auth: true},
+	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/feed-sources/[0-9]*$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/feed-sources/import$`), Auth: true},
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/feed-sources/export$`), Auth: true},
+
+	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/article-links/broken$`), Auth: true},
+
+	{Mehtod: "GET", URL: regexp.MustCompile(`^/feeds/`), Auth: false},
+	{Mehtod: "HEAD", URL: regexp.MustCompile(`^/feeds/`), Auth: false},
+
+	{Mehtod: "GET", URL: regexp.MustCompile(`^/images/`), Auth: false},
+	{Mehtod: "HEAD", URL: regexp.MustCompile(`^/images/`), Auth: false},
+
+	{Mehtod: "GET", URL: regexp.MustCompile(`^/files/`), Auth: false},
+	{Mehtod: "HEAD", URL: regexp.MustCompile(`^/files/`), Auth: false},
 
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/users$`), Auth: true},
 	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/users$`), Auth: true},
 	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/users$`), Auth: true},
 	{Mehtod: "GET", URL: regexp.MustCompile(`/v1/users/[0-9]*$`), Auth: true},
+	{Mehtod: "PATCH", URL: regexp.MustCompile(`/v1/users/[0-9]*$`), Auth: true},
 	{Mehtod: "DELETE", URL: regexp.MustCompile(`/v1/users/[0-9]*$`), Auth: true},
+	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/users/[0-9]*/bookmark-visibility$`), Auth: true},
+	{Mehtod: "PUT", URL: regexp.MustCompile(`/v1/users/[0-9]*/digest-subscription$`), Auth: true},
+	{Mehtod: "POST", URL: regexp.MustCompile(`/v1/users/[0-9]*/restore$`), Auth: true},
 }
 
 var AuthMethods = map[string]bool{
-	"/proto.ArticleService/CreateArticle":         true,
-	"/proto.ArticleService/GetArticle":            false,
-	"/proto.ArticleService/ListArticles":          false,
-	"/proto.ArticleService/UpdateArticle":         true,
-	"/proto.ArticleService/DeleteArticle":         true,
-	"/proto.ArticleService/GetArticleCount":       false,
-	"/proto.ArticleService/GetBookmarkedArticles": true,
+	"/proto.ArticleLinkService/ListBrokenArticleLinks": true,
+
+	"/proto.ArticleService/CreateArticle":           true,
+	"/proto.ArticleService/GetArticle":              false,
+	"/proto.ArticleService/ListArticles":            false,
+	"/proto.ArticleService/UpdateArticle":           true,
+	"/proto.ArticleService/DeleteArticle":           true,
+	"/proto.ArticleService/GetArticleCount":         false,
+	"/proto.ArticleService/GetBookmarkedArticles":   true,
+	"/proto.ArticleService/SearchArticles":          false,
+	"/proto.ArticleService/RestoreArticle":          true,
+	"/proto.ArticleService/ListArticleRevisions":    false,
+	"/proto.ArticleService/RevertArticle":           true,
+	"/proto.ArticleService/DiffArticleRevisions":    false,
+	"/proto.ArticleService/ListRelatedArticles":     false,
+	"/proto.ArticleService/ListRecommendedArticles": true,
 
 	"/proto.AuthService/PreSignup":           false,
 	"/proto.AuthService/Signup":              false,
@@ -66,22 +136,63 @@ var AuthMethods = map[string]bool{
 	"/proto.BookmarkService/CreateBookmark":                     true,
 	"/proto.BookmarkService/GetBookmarkCountByArticleID":        false,
 	"/proto.BookmarkService/ListBookmarksByUserID":              true,
+	"/proto.BookmarkService/ListWorkspaceBookmarks":             true,
 	"/proto.BookmarkService/ListBookmarksByArticleID":           false,
 	"/proto.BookmarkService/DeleteBookmarkByUserIDAndArticleID": true,
 	"/proto.BookmarkService/DeleteBookmarkByUserID":             true,
 	"/proto.BookmarkService/DeleteBookmarkByArticleID":          true,
+	"/proto.BookmarkService/UpdateBookmark":                     true,
+	"/proto.BookmarkService/SearchBookmarks":                    true,
+	"/proto.BookmarkService/MarkBookmarksRead":                  true,
+	"/proto.BookmarkService/MarkBookmarksUnread":                true,
+	"/proto.BookmarkService/ImportBookmarks":                    true,
+	"/proto.BookmarkService/GetBookmarkImport":                  true,
+	"/proto.BookmarkService/ExportBookmarks":                    true,
+
+	"/proto.CollectionService/CreateCollection":           true,
+	"/proto.CollectionService/GetCollection":              true,
+	"/proto.CollectionService/ListCollections":            true,
+	"/proto.CollectionService/UpdateCollection":           true,
+	"/proto.CollectionService/DeleteCollection":           true,
+	"/proto.CollectionService/ReorderCollections":         true,
+	"/proto.CollectionService/ReorderCollectionBookmarks": true,
+	"/proto.CollectionService/MoveBookmarks":              true,
+	"/proto.CollectionService/UpdateCollectionVisibility": true,
+	"/proto.CollectionService/GetSharedCollection":        false,
 
 	"/proto.CommentService/CreateComment":                     true,
 	"/proto.CommentService/ListCommentsByUserID":              true,
 	"/proto.CommentService/ListCommentsByArticleID":           false,
+	"/proto.CommentService/ListWorkspaceComments":             true,
 	"/proto.CommentService/DeleteComment":                     true,
 	"/proto.CommentService/DeleteCommentByUserIDAndArticleID": true,
 	"/proto.CommentService/DeleteCommentByUserID":             true,
 	"/proto.CommentService/DeleteCommentByArticleID":          true,
+	"/proto.CommentService/RestoreComment":                    true,
+
+	"/proto.FeedSourceService/CreateFeedSource": true,
+	"/proto.FeedSourceService/GetFeedSource":    true,
+	"/proto.FeedSourceService/ListFeedSources":  true,
+	"/proto.FeedSourceService/DeleteFeedSource": true,
+	"/proto.FeedSourceService/ImportOPML":       true,
+	"/proto.FeedSourceService/ExportOPML":       true,
+
+	"/proto.UserService/CreateUser":               true,
+	"/proto.UserService/GetUser":                  true,
+	"/proto.UserService/ListUsers":                true,
+	"/proto.UserService/UpdateUser":               true,
+	"/proto.UserService/DeleteUser":               true,
+	"/proto.UserService/UpdateBookmarkVisibility": true,
+	"/proto.UserService/UpdateDigestSubscription": true,
+	"/proto.UserService/RestoreUser":              true,
 
-	"/proto.UserService/CreateUser": true,
-	"/proto.UserService/GetUser":    true,
-	"/proto.UserService/ListUsers":  true,
-	"/proto.UserService/UpdateUser": true,
-	"/proto.UserService/DeleteUser": true,
+	"/proto.WorkspaceService/CreateWorkspace":           true,
+	"/proto.WorkspaceService/GetWorkspace":              true,
+	"/proto.WorkspaceService/ListWorkspaces":            true,
+	"/proto.WorkspaceService/DeleteWorkspace":           true,
+	"/proto.WorkspaceService/ListWorkspaceMembers":      true,
+	"/proto.WorkspaceService/UpdateWorkspaceMember":     true,
+	"/proto.WorkspaceService/RemoveWorkspaceMember":     true,
+	"/proto.WorkspaceService/InviteWorkspaceMember":     true,
+	"/proto.WorkspaceService/AcceptWorkspaceInvitation": true,
 }
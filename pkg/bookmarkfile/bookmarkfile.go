@@ -0,0 +1,229 @@
+package bookmarkfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	FormatNetscape = "netscape"
+	FormatPocket   = "pocket"
+	FormatRaindrop = "raindrop"
+)
+
+var ErrUnknownFormat = errors.New("unknown bookmark file format")
+
+type Item struct {
+	Url     string
+	Title   string
+	Folder  string
+	Note    string
+	Tags    []string
+	Read    bool
+	AddedAt time.Time
+}
+
+func Parse(format string, data []byte) ([]Item, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	switch format {
+	case FormatNetscape:
+		return parseHTML(data), nil
+	case FormatPocket:
+		if bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")) {
+			return parseHTML(data), nil
+		}
+		return parsePocketCSV(data)
+	case FormatRaindrop:
+		return parseRaindropCSV(data)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func parseHTML(data []byte) []Item {
+	tokenizer := html.NewTokenizer(bytes.NewReader(data))
+	items := []Item{}
+	folders := []string{}
+	pendingFolder := ""
+	read := false
+	capture := ""
+	text := strings.Builder{}
+	last := -1
+
+	endCapture := func() {
+		value := strings.Join(strings.Fields(text.String()), " ")
+		switch capture {
+		case "h1":
+			read = strings.Contains(strings.ToLower(value), "archive")
+		case "h3":
+			pendingFolder = value
+		case "a":
+			items[last].Title = value
+		case "dd":
+			if last >= 0 && items[last].Note == "" {
+				items[last].Note = value
+			}
+		}
+		capture = ""
+		text.Reset()
+	}
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if capture != "" {
+				endCapture()
+			}
+			return items
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			tag := string(name)
+			if capture == "dd" {
+				endCapture()
+			}
+			switch tag {
+			case "h1", "h3":
+				capture = tag
+				last = -1
+			case "dd":
+				capture = tag
+			case "dl":
+				folders = append(folders, pendingFolder)
+				pendingFolder = ""
+			case "a":
+				attrs := map[string]string{}
+				for hasAttr {
+					var key, value []byte
+					key, value, hasAttr = tokenizer.TagAttr()
+					attrs[string(key)] = string(value)
+				}
+				addedAt := attrs["add_date"]
+				if addedAt == "" {
+					addedAt = attrs["time_added"]
+				}
+				items = append(items, Item{
+					Url:     strings.TrimSpace(attrs["href"]),
+					Folder:  folderPath(folders),
+					Tags:    splitTags(attrs["tags"], ","),
+					Read:    read,
+					AddedAt: unixTime(addedAt),
+				})
+				last = len(items) - 1
+				capture = tag
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if tag == capture || (tag == "dl" && capture == "dd") {
+				endCapture()
+			}
+			if tag == "dl" && len(folders) > 0 {
+				folders = folders[:len(folders)-1]
+			}
+		case html.TextToken:
+			if capture != "" {
+				text.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+func parsePocketCSV(data []byte) ([]Item, error) {
+	rows, err := readCSV(data)
+	if err != nil {
+		return nil, err
+	}
+	items := []Item{}
+	for _, row := range rows {
+		items = append(items, Item{
+			Url:     row["url"],
+			Title:   row["title"],
+			Tags:    splitTags(row["tags"], "|"),
+			Read:    row["status"] == "archive",
+			AddedAt: unixTime(row["time_added"]),
+		})
+	}
+	return items, nil
+}
+
+func parseRaindropCSV(data []byte) ([]Item, error) {
+	rows, err := readCSV(data)
+	if err != nil {
+		return nil, err
+	}
+	items := []Item{}
+	for _, row := range rows {
+		addedAt, _ := time.Parse(time.RFC3339, row["created"])
+		items = append(items, Item{
+			Url:     row["url"],
+			Title:   row["title"],
+			Folder:  strings.Trim(row["folder"], "/ "),
+			Note:    row["note"],
+			Tags:    splitTags(row["tags"], ","),
+			AddedAt: addedAt,
+		})
+	}
+	return items, nil
+}
+
+func readCSV(data []byte) ([]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("missing csv header")
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+	rows := []map[string]string{}
+	for _, record := range records[1:] {
+		row := map[string]string{}
+		for i, value := range record {
+			if i < len(header) {
+				row[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func folderPath(folders []string) string {
+	names := []string{}
+	for _, folder := range folders {
+		if folder != "" {
+			names = append(names, folder)
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+func splitTags(value, separator string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(value, separator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func unixTime(value string) time.Time {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
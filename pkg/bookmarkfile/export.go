@@ -0,0 +1,136 @@
+package bookmarkfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+const exportTitle = "Bookmarks"
+
+var (
+	markdownTextReplacer = strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`)
+	markdownUrlReplacer  = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29")
+)
+
+type group struct {
+	folder string
+	items  []Item
+}
+
+func Marshal(format string, items []Item) ([]byte, error) {
+	switch format {
+	case FormatNetscape:
+		return marshalHTML(items), nil
+	case FormatCSV:
+		return marshalCSV(items)
+	case FormatMarkdown:
+		return marshalMarkdown(items), nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+func marshalHTML(items []Item) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteString("<!DOCTYPE NETSCAPE-Bookmark-file-1>\n")
+	buf.WriteString("<META HTTP-EQUIV=\"Content-Type\" CONTENT=\"text/html; charset=UTF-8\">\n")
+	fmt.Fprintf(&buf, "<TITLE>%s</TITLE>\n<H1>%s</H1>\n<DL><p>\n", exportTitle, exportTitle)
+	for _, g := range groupByFolder(items) {
+		indent := "    "
+		if g.folder != "" {
+			fmt.Fprintf(&buf, "    <DT><H3>%s</H3>\n    <DL><p>\n", html.EscapeString(g.folder))
+			indent = "        "
+		}
+		for _, item := range g.items {
+			fmt.Fprintf(&buf, "%s<DT><A HREF=\"%s\"", indent, html.EscapeString(item.Url))
+			if !item.AddedAt.IsZero() {
+				fmt.Fprintf(&buf, " ADD_DATE=\"%d\"", item.AddedAt.Unix())
+			}
+			if len(item.Tags) > 0 {
+				fmt.Fprintf(&buf, " TAGS=\"%s\"", html.EscapeString(strings.Join(item.Tags, ",")))
+			}
+			fmt.Fprintf(&buf, ">%s</A>\n", html.EscapeString(item.Title))
+			if item.Note != "" {
+				fmt.Fprintf(&buf, "%s<DD>%s\n", indent, html.EscapeString(item.Note))
+			}
+		}
+		if g.folder != "" {
+			buf.WriteString("    </DL><p>\n")
+		}
+	}
+	buf.WriteString("</DL><p>\n")
+	return buf.Bytes()
+}
+
+func marshalCSV(items []Item) ([]byte, error) {
+	buf := bytes.Buffer{}
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"title", "note", "url", "folder", "tags", "created", "read"}); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		created := ""
+		if !item.AddedAt.IsZero() {
+			created = item.AddedAt.UTC().Format(time.RFC3339)
+		}
+		record := []string{item.Title, item.Note, item.Url, item.Folder, strings.Join(item.Tags, ","), created, strconv.FormatBool(item.Read)}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+func marshalMarkdown(items []Item) []byte {
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, "# %s\n", exportTitle)
+	for _, g := range groupByFolder(items) {
+		folder := g.folder
+		if folder == "" {
+			folder = "Uncategorized"
+		}
+		fmt.Fprintf(&buf, "\n## %s\n\n", folder)
+		for _, item := range g.items {
+			title := item.Title
+			if title == "" {
+				title = item.Url
+			}
+			fmt.Fprintf(&buf, "- [%s](%s)", markdownTextReplacer.Replace(title), markdownUrlReplacer.Replace(item.Url))
+			for _, tag := range item.Tags {
+				fmt.Fprintf(&buf, " `%s`", strings.ReplaceAll(tag, "`", ""))
+			}
+			buf.WriteString("\n")
+			if item.Note != "" {
+				for _, line := range strings.Split(strings.TrimSpace(item.Note), "\n") {
+					fmt.Fprintf(&buf, "  > %s\n", strings.TrimRight(line, "\r"))
+				}
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+func groupByFolder(items []Item) []group {
+	groups := []group{}
+	index := map[string]int{}
+	for _, item := range items {
+		i, ok := index[item.Folder]
+		if !ok {
+			i = len(groups)
+			index[item.Folder] = i
+			groups = append(groups, group{folder: item.Folder})
+		}
+		groups[i].items = append(groups[i].items, item)
+	}
+	return groups
+}
@@ -7,28 +7,73 @@ import (
 )
 
 type Config struct {
-	DbSource                string        `env:"DB_SOURCE"`
-	MigrationUrl            string        `env:"MIGRATION_URL"`
-	HttpServerAddress       string        `env:"HTTP_SERVER_ADDRESS"`
-	GrpcServerAddress       string        `env:"GRPC_SERVER_ADDRESS"`
-	RedisAddress            string        `env:"REDIS_ADDRESS"`
-	RedisAccessTokenDB      int           `env:"REDIS_ACCESS_TOKEN_DB"`
-	RedisRefreshTokenDB     int           `env:"REDIS_REFRESH_TOKEN_DB"`
-	JWTIssuer               string        `env:"JWT_ISSUER"`
-	JwtSecret               string        `env:"JWT_SECRET"`
-	AccessTokenExpires      time.Duration `env:"ACCESS_TOKEN_EXPIRES"`
-	RefreshTokenExpires     time.Duration `env:"REFRESH_TOKEN_EXPIRES"`
-	OauthGoogleState        string        `env:"OAUTH_GOOGLE_STATE"`
-	OauthGoogleClientID     string        `env:"OAUTH_GOOGLE_CLIENT_ID"`
-	OauthGoogleClientSecret string        `env:"OAUTH_GOOGLE_CLIENT_SECRET"`
-	OauthGoogleRedirectURL  string        `env:"OAUTH_GOOGLE_REDIRECT_URL"`
-	GmailFrom               string        `env:"GMAIL_FROM"`
-	GmailPassword           string        `env:"GMAIL_PASSWORD"`
-	RedisPresignupDB        int           `env:"REDIS_PRESIGNUP_DB"`
-	PresignupExpires        time.Duration `env:"PRESIGNUP_EXPIRES"`
-	PresignupMailSubject    string        `env:"PRESIGNUP_MAIL_SUBJECT"`
-	PresignupMailTemplate   string        `env:"PRESIGNUP_MAIL_TEMPLATE"`
-	SignupURL               string        `env:"SIGNUP_URL"`
+	DbSource                        string        `env:"DB_SOURCE"`
+	MigrationUrl                    string        `env:"MIGRATION_URL"`
+	HttpServerAddress               string        `env:"HTTP_SERVER_ADDRESS"`
+	GrpcServerAddress               string        `env:"GRPC_SERVER_ADDRESS"`
+	RedisAddress                    string        `env:"REDIS_ADDRESS"`
+	RedisAccessTokenDB              int           `env:"REDIS_ACCESS_TOKEN_DB"`
+	RedisRefreshTokenDB             int           `env:"REDIS_REFRESH_TOKEN_DB"`
+	JWTIssuer                       string        `env:"JWT_ISSUER"`
+	JwtSecret                       string        `env:"JWT_SECRET"`
+	AccessTokenExpires              time.Duration `env:"ACCESS_TOKEN_EXPIRES"`
+	RefreshTokenExpires             time.Duration `env:"REFRESH_TOKEN_EXPIRES"`
+	OauthGoogleState                string        `env:"OAUTH_GOOGLE_STATE"`
+	OauthGoogleClientID             string        `env:"OAUTH_GOOGLE_CLIENT_ID"`
+	OauthGoogleClientSecret         string        `env:"OAUTH_GOOGLE_CLIENT_SECRET"`
+	OauthGoogleRedirectURL          string        `env:"OAUTH_GOOGLE_REDIRECT_URL"`
+	GmailFrom                       string        `env:"GMAIL_FROM"`
+	GmailPassword                   string        `env:"GMAIL_PASSWORD"`
+	RedisPresignupDB                int           `env:"REDIS_PRESIGNUP_DB"`
+	PresignupExpires                time.Duration `env:"PRESIGNUP_EXPIRES"`
+	PresignupMailSubject            string        `env:"PRESIGNUP_MAIL_SUBJECT"`
+	PresignupMailTemplate           string        `env:"PRESIGNUP_MAIL_TEMPLATE"`
+	SignupURL                       string        `env:"SIGNUP_URL"`
+	WorkspaceInvitationExpires      time.Duration `env:"WORKSPACE_INVITATION_EXPIRES" envDefault:"168h"`
+	WorkspaceInvitationMailSubject  string        `env:"WORKSPACE_INVITATION_MAIL_SUBJECT" envDefault:"ワークスペースへの招待"`
+	WorkspaceInvitationMailTemplate string        `env:"WORKSPACE_INVITATION_MAIL_TEMPLATE" envDefault:"./pkg/mail/workspace_invitation.tmpl"`
+	WorkspaceInvitationURL          string        `env:"WORKSPACE_INVITATION_URL"`
+	CommentMaxDepth                 int           `env:"COMMENT_MAX_DEPTH" envDefault:"5"`
+	BookmarkReminderPollInterval    time.Duration `env:"BOOKMARK_REMINDER_POLL_INTERVAL" envDefault:"5m"`
+	BookmarkReminderMailSubject     string        `env:"BOOKMARK_REMINDER_MAIL_SUBJECT" envDefault:"ブックマークのリマインダー"`
+	BookmarkReminderMailTemplate    string        `env:"BOOKMARK_REMINDER_MAIL_TEMPLATE" envDefault:"./pkg/mail/bookmark_reminder.tmpl"`
+	BookmarkDigestPollInterval      time.Duration `env:"BOOKMARK_DIGEST_POLL_INTERVAL" envDefault:"1h"`
+	BookmarkDigestInterval          time.Duration `env:"BOOKMARK_DIGEST_INTERVAL" envDefault:"168h"`
+	BookmarkDigestLimit             int           `env:"BOOKMARK_DIGEST_LIMIT" envDefault:"10"`
+	BookmarkDigestMailSubject       string        `env:"BOOKMARK_DIGEST_MAIL_SUBJECT" envDefault:"未読のブックマーク"`
+	BookmarkDigestMailTemplate      string        `env:"BOOKMARK_DIGEST_MAIL_TEMPLATE" envDefault:"./pkg/mail/bookmark_digest.tmpl"`
+	BookmarkDigestSettingsURL       string        `env:"BOOKMARK_DIGEST_SETTINGS_URL"`
+	SearchLanguage                  string        `env:"SEARCH_LANGUAGE" envDefault:"english"`
+	ArticleScoreRefreshInterval     time.Duration `env:"ARTICLE_SCORE_REFRESH_INTERVAL" envDefault:"10m"`
+	FeedPollInterval                time.Duration `env:"FEED_POLL_INTERVAL" envDefault:"1m"`
+	FeedFetchInterval               time.Duration `env:"FEED_FETCH_INTERVAL" envDefault:"30m"`
+	FeedFetchTimeout                time.Duration `env:"FEED_FETCH_TIMEOUT" envDefault:"30s"`
+	FeedMaxBackoff                  time.Duration `env:"FEED_MAX_BACKOFF" envDefault:"24h"`
+	PurgeInterval                   time.Duration `env:"PURGE_INTERVAL" envDefault:"24h"`
+	SoftDeleteRetention             time.Duration `env:"SOFT_DELETE_RETENTION" envDefault:"720h"`
+	LinkCheckPollInterval           time.Duration `env:"LINK_CHECK_POLL_INTERVAL" envDefault:"10m"`
+	LinkCheckInterval               time.Duration `env:"LINK_CHECK_INTERVAL" envDefault:"168h"`
+	LinkCheckRetryInterval          time.Duration `env:"LINK_CHECK_RETRY_INTERVAL" envDefault:"6h"`
+	LinkCheckTimeout                time.Duration `env:"LINK_CHECK_TIMEOUT" envDefault:"10s"`
+	LinkBrokenThreshold             int           `env:"LINK_BROKEN_THRESHOLD" envDefault:"3"`
+	RecommendationInterval          time.Duration `env:"RECOMMENDATION_INTERVAL" envDefault:"1h"`
+	RecommendationLimit             int           `env:"RECOMMENDATION_LIMIT" envDefault:"100"`
+	BookmarkImportPollInterval      time.Duration `env:"BOOKMARK_IMPORT_POLL_INTERVAL" envDefault:"10s"`
+	BookmarkImportSyncLimit         int           `env:"BOOKMARK_IMPORT_SYNC_LIMIT" envDefault:"100"`
+	BookmarkImportStaleTimeout      time.Duration `env:"BOOKMARK_IMPORT_STALE_TIMEOUT" envDefault:"10m"`
+	StorageDriver                   string        `env:"STORAGE_DRIVER" envDefault:"local"`
+	StorageLocalDir                 string        `env:"STORAGE_LOCAL_DIR" envDefault:"./storage"`
+	StorageLocalBaseURL             string        `env:"STORAGE_LOCAL_BASE_URL" envDefault:"http://localhost:8080/files"`
+	StorageSigningSecret            string        `env:"STORAGE_SIGNING_SECRET"`
+	StorageS3Endpoint               string        `env:"STORAGE_S3_ENDPOINT"`
+	StorageS3Region                 string        `env:"STORAGE_S3_REGION" envDefault:"us-east-1"`
+	StorageS3Bucket                 string        `env:"STORAGE_S3_BUCKET"`
+	StorageS3AccessKeyID            string        `env:"STORAGE_S3_ACCESS_KEY_ID"`
+	StorageS3SecretAccessKey        string        `env:"STORAGE_S3_SECRET_ACCESS_KEY"`
+	StorageS3UseSSL                 bool          `env:"STORAGE_S3_USE_SSL" envDefault:"true"`
+	ImageProxySecret                string        `env:"IMAGE_PROXY_SECRET"`
+	ImageProxyBaseURL               string        `env:"IMAGE_PROXY_BASE_URL" envDefault:"http://localhost:8080/images"`
+	ImageFetchTimeout               time.Duration `env:"IMAGE_FETCH_TIMEOUT" envDefault:"10s"`
 }
 
 func Load() (*Config, error) {
@@ -11,5 +11,6 @@ func SetUserID(ctx context.Context, userId int) context.Context {
 }
 
 func GetUserID(ctx context.Context) int {
-	return ctx.Value(userIdKey).(int)
+	userId, _ := ctx.Value(userIdKey).(int)
+	return userId
 }
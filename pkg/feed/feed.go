@@ -0,0 +1,262 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported feed format")
+
+type Feed struct {
+	Title       string
+	Link        string
+	FeedUrl     string
+	Description string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID          string
+	Title       string
+	Url         string
+	Description string
+	Body        string
+	Image       string
+	Tags        []string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+type rssItem struct {
+	Guid        string   `xml:"guid"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Categories  []string `xml:"category"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Enclosure   struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+}
+
+type rss struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items []rssItem `xml:"item"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Text  string `xml:",chardata"`
+	Inner string `xml:",innerxml"`
+}
+
+func (t atomText) value() string {
+	if t.Type == "xhtml" {
+		return t.Inner
+	}
+	return t.Text
+}
+
+type atom struct {
+	Title   string `xml:"title"`
+	Entries []struct {
+		ID         string     `xml:"id"`
+		Title      string     `xml:"title"`
+		Published  string     `xml:"published"`
+		Updated    string     `xml:"updated"`
+		Links      []atomLink `xml:"link"`
+		Summary    atomText   `xml:"summary"`
+		Content    atomText   `xml:"content"`
+		Categories []struct {
+			Term string `xml:"term,attr"`
+		} `xml:"category"`
+	} `xml:"entry"`
+}
+
+type jsonFeed struct {
+	Title string `json:"title"`
+	Items []struct {
+		ID            string   `json:"id"`
+		Url           string   `json:"url"`
+		ExternalUrl   string   `json:"external_url"`
+		Title         string   `json:"title"`
+		Summary       string   `json:"summary"`
+		ContentHTML   string   `json:"content_html"`
+		ContentText   string   `json:"content_text"`
+		Image         string   `json:"image"`
+		Tags          []string `json:"tags"`
+		DatePublished string   `json:"date_published"`
+		DateModified  string   `json:"date_modified"`
+	} `json:"items"`
+}
+
+func Parse(data []byte) (*Feed, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("{")) {
+		return parseJSON(data)
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, ErrUnsupportedFormat
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "rss", "RDF":
+			v := rss{}
+			if err := decoder.DecodeElement(&v, &start); err != nil {
+				return nil, err
+			}
+			return parseRSS(v), nil
+		case "feed":
+			v := atom{}
+			if err := decoder.DecodeElement(&v, &start); err != nil {
+				return nil, err
+			}
+			return parseAtom(v), nil
+		default:
+			return nil, ErrUnsupportedFormat
+		}
+	}
+}
+
+func parseRSS(v rss) *Feed {
+	feed := &Feed{Title: strings.TrimSpace(v.Channel.Title)}
+	for _, item := range append(v.Channel.Items, v.Items...) {
+		image := ""
+		if strings.HasPrefix(item.Enclosure.Type, "image/") {
+			image = item.Enclosure.URL
+		}
+		pubDate := item.PubDate
+		if pubDate == "" {
+			pubDate = item.Date
+		}
+		publishedAt := parseTime(pubDate)
+		feed.Items = append(feed.Items, Item{
+			ID:          strings.TrimSpace(item.Guid),
+			Title:       strings.TrimSpace(item.Title),
+			Url:         strings.TrimSpace(item.Link),
+			Description: item.Description,
+			Body:        item.Content,
+			Image:       image,
+			Tags:        item.Categories,
+			PublishedAt: publishedAt,
+			UpdatedAt:   publishedAt,
+		})
+	}
+	return feed
+}
+
+func parseAtom(v atom) *Feed {
+	feed := &Feed{Title: strings.TrimSpace(v.Title)}
+	for _, entry := range v.Entries {
+		item := Item{
+			ID:          strings.TrimSpace(entry.ID),
+			Title:       strings.TrimSpace(entry.Title),
+			Description: entry.Summary.value(),
+			Body:        entry.Content.value(),
+			PublishedAt: parseTime(entry.Published),
+			UpdatedAt:   parseTime(entry.Updated),
+		}
+		if item.PublishedAt.IsZero() {
+			item.PublishedAt = item.UpdatedAt
+		}
+		if item.UpdatedAt.IsZero() {
+			item.UpdatedAt = item.PublishedAt
+		}
+		for _, link := range entry.Links {
+			switch {
+			case (link.Rel == "" || link.Rel == "alternate") && item.Url == "":
+				item.Url = strings.TrimSpace(link.Href)
+			case link.Rel == "enclosure" && strings.HasPrefix(link.Type, "image/"):
+				item.Image = link.Href
+			}
+		}
+		for _, category := range entry.Categories {
+			item.Tags = append(item.Tags, category.Term)
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed
+}
+
+func parseJSON(data []byte) (*Feed, error) {
+	v := jsonFeed{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	feed := &Feed{Title: strings.TrimSpace(v.Title)}
+	for _, item := range v.Items {
+		url := item.Url
+		if url == "" {
+			url = item.ExternalUrl
+		}
+		body := item.ContentHTML
+		if body == "" {
+			body = item.ContentText
+		}
+		publishedAt := parseTime(item.DatePublished)
+		updatedAt := parseTime(item.DateModified)
+		if updatedAt.IsZero() {
+			updatedAt = publishedAt
+		}
+		feed.Items = append(feed.Items, Item{
+			ID:          strings.TrimSpace(item.ID),
+			Title:       strings.TrimSpace(item.Title),
+			Url:         strings.TrimSpace(url),
+			Description: item.Summary,
+			Body:        body,
+			Image:       item.Image,
+			Tags:        item.Tags,
+			PublishedAt: publishedAt,
+			UpdatedAt:   updatedAt,
+		})
+	}
+	return feed, nil
+}
+
+var timeLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02",
+}
+
+func parseTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
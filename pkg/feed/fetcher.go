@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+)
+
+const maxFeedSize = 10 << 20
+
+type Fetcher struct {
+	client *http.Client
+}
+
+type FetchResult struct {
+	Feed         *Feed
+	Etag         string
+	LastModified string
+	NotModified  bool
+}
+
+func NewFetcher(client *http.Client) *Fetcher {
+	return &Fetcher{client: client}
+}
+
+func (f *Fetcher) Fetch(rawUrl, etag, lastModified string) (*FetchResult, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := safehttp.CheckScheme(u.Scheme); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, rawUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/feed+json, application/xml;q=0.9, */*;q=0.8")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &FetchResult{Etag: etag, LastModified: lastModified, NotModified: true}, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedSize))
+	if err != nil {
+		return nil, err
+	}
+	feed, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &FetchResult{
+		Feed:         feed,
+		Etag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
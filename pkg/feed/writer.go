@@ -0,0 +1,260 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	FormatAtom = "atom"
+	FormatRSS  = "rss"
+	FormatJSON = "json"
+)
+
+var contentTypes = map[string]string{
+	FormatAtom: "application/atom+xml; charset=utf-8",
+	FormatRSS:  "application/rss+xml; charset=utf-8",
+	FormatJSON: "application/feed+json; charset=utf-8",
+}
+
+type atomOutText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Text string `xml:",chardata"`
+}
+
+type atomOutLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomOutCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomOutEntry struct {
+	Title      string            `xml:"title"`
+	ID         string            `xml:"id"`
+	Links      []atomOutLink     `xml:"link"`
+	Published  string            `xml:"published,omitempty"`
+	Updated    string            `xml:"updated"`
+	Summary    *atomOutText      `xml:"summary,omitempty"`
+	Content    *atomOutText      `xml:"content,omitempty"`
+	Categories []atomOutCategory `xml:"category"`
+}
+
+type atomOut struct {
+	XMLName  xml.Name       `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string         `xml:"title"`
+	ID       string         `xml:"id"`
+	Updated  string         `xml:"updated"`
+	Subtitle string         `xml:"subtitle,omitempty"`
+	Links    []atomOutLink  `xml:"link"`
+	Entries  []atomOutEntry `xml:"entry"`
+}
+
+type rssOutGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssOutEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssOutItem struct {
+	Title       string           `xml:"title"`
+	Link        string           `xml:"link"`
+	Guid        rssOutGuid       `xml:"guid"`
+	PubDate     string           `xml:"pubDate,omitempty"`
+	Description string           `xml:"description,omitempty"`
+	Content     string           `xml:"content:encoded,omitempty"`
+	Categories  []string         `xml:"category"`
+	Enclosure   *rssOutEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssOut struct {
+	XMLName     xml.Name     `xml:"rss"`
+	Version     string       `xml:"version,attr"`
+	ContentNS   string       `xml:"xmlns:content,attr"`
+	AtomNS      string       `xml:"xmlns:atom,attr"`
+	Title       string       `xml:"channel>title"`
+	Link        string       `xml:"channel>link"`
+	Description string       `xml:"channel>description"`
+	LastBuild   string       `xml:"channel>lastBuildDate,omitempty"`
+	SelfLink    atomOutLink  `xml:"channel>atom:link"`
+	Items       []rssOutItem `xml:"channel>item"`
+}
+
+type jsonOutItem struct {
+	ID            string   `json:"id"`
+	Url           string   `json:"url,omitempty"`
+	Title         string   `json:"title,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	ContentHTML   string   `json:"content_html"`
+	Image         string   `json:"image,omitempty"`
+	DatePublished string   `json:"date_published,omitempty"`
+	DateModified  string   `json:"date_modified,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+type jsonOut struct {
+	Version     string        `json:"version"`
+	Title       string        `json:"title"`
+	HomePageUrl string        `json:"home_page_url,omitempty"`
+	FeedUrl     string        `json:"feed_url,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Items       []jsonOutItem `json:"items"`
+}
+
+func ContentType(format string) (string, error) {
+	contentType, ok := contentTypes[format]
+	if !ok {
+		return "", ErrUnsupportedFormat
+	}
+	return contentType, nil
+}
+
+func Render(format string, feed *Feed) ([]byte, error) {
+	switch format {
+	case FormatAtom:
+		return renderAtom(feed)
+	case FormatRSS:
+		return renderRSS(feed)
+	case FormatJSON:
+		return renderJSON(feed)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func renderAtom(feed *Feed) ([]byte, error) {
+	v := atomOut{
+		Title:    feed.Title,
+		ID:       feed.FeedUrl,
+		Updated:  formatTime(feed.Updated, time.RFC3339),
+		Subtitle: feed.Description,
+		Links: []atomOutLink{
+			{Href: feed.Link, Rel: "alternate"},
+			{Href: feed.FeedUrl, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, item := range feed.Items {
+		entry := atomOutEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Links:     []atomOutLink{{Href: item.Url, Rel: "alternate"}},
+			Published: formatTime(item.PublishedAt, time.RFC3339),
+			Updated:   formatTime(latest(item.UpdatedAt, item.PublishedAt), time.RFC3339),
+		}
+		if item.Description != "" {
+			entry.Summary = &atomOutText{Type: "html", Text: item.Description}
+		}
+		if item.Body != "" {
+			entry.Content = &atomOutText{Type: "html", Text: item.Body}
+		}
+		if imageType := imageType(item.Image); imageType != "" {
+			entry.Links = append(entry.Links, atomOutLink{Href: item.Image, Rel: "enclosure", Type: imageType})
+		}
+		for _, tag := range item.Tags {
+			entry.Categories = append(entry.Categories, atomOutCategory{Term: tag})
+		}
+		v.Entries = append(v.Entries, entry)
+	}
+	return marshalXML(v)
+}
+
+func renderRSS(feed *Feed) ([]byte, error) {
+	v := rssOut{
+		Version:     "2.0",
+		ContentNS:   "http://purl.org/rss/1.0/modules/content/",
+		AtomNS:      "http://www.w3.org/2005/Atom",
+		Title:       feed.Title,
+		Link:        feed.Link,
+		Description: feed.Description,
+		LastBuild:   formatTime(feed.Updated, time.RFC1123Z),
+		SelfLink:    atomOutLink{Href: feed.FeedUrl, Rel: "self", Type: "application/rss+xml"},
+	}
+	for _, item := range feed.Items {
+		out := rssOutItem{
+			Title:       item.Title,
+			Link:        item.Url,
+			Guid:        rssOutGuid{Value: item.ID},
+			PubDate:     formatTime(item.PublishedAt, time.RFC1123Z),
+			Description: item.Description,
+			Content:     item.Body,
+			Categories:  item.Tags,
+		}
+		if imageType := imageType(item.Image); imageType != "" {
+			out.Enclosure = &rssOutEnclosure{URL: item.Image, Type: imageType}
+		}
+		v.Items = append(v.Items, out)
+	}
+	return marshalXML(v)
+}
+
+func renderJSON(feed *Feed) ([]byte, error) {
+	v := jsonOut{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageUrl: feed.Link,
+		FeedUrl:     feed.FeedUrl,
+		Description: feed.Description,
+		Items:       []jsonOutItem{},
+	}
+	for _, item := range feed.Items {
+		v.Items = append(v.Items, jsonOutItem{
+			ID:            item.ID,
+			Url:           item.Url,
+			Title:         item.Title,
+			Summary:       item.Description,
+			ContentHTML:   item.Body,
+			Image:         item.Image,
+			DatePublished: formatTime(item.PublishedAt, time.RFC3339),
+			DateModified:  formatTime(item.UpdatedAt, time.RFC3339),
+			Tags:          item.Tags,
+		})
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(layout)
+}
+
+func imageType(imageUrl string) string {
+	u, err := url.Parse(imageUrl)
+	if imageUrl == "" || err != nil {
+		return ""
+	}
+	imageType := mime.TypeByExtension(path.Ext(u.Path))
+	if !strings.HasPrefix(imageType, "image/") {
+		return ""
+	}
+	return imageType
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,43 @@
+package htmltext
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var skipTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"head":     true,
+	"nav":      true,
+	"footer":   true,
+}
+
+func Extract(src string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(src))
+	builder := strings.Builder{}
+	depth := 0
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return strings.Join(strings.Fields(builder.String()), " ")
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			if skipTags[string(name)] {
+				depth++
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if skipTags[string(name)] && depth > 0 {
+				depth--
+			}
+		case html.TextToken:
+			if depth == 0 {
+				builder.Write(tokenizer.Text())
+				builder.WriteString(" ")
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	FormatWebP = "webp"
+	FormatJPEG = "jpeg"
+
+	maxPixels   = 40_000_000
+	jpegQuality = 80
+)
+
+var (
+	ErrTooLarge          = errors.New("image is too large")
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+)
+
+func Decode(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+func Resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	if bounds.Dx() <= width {
+		width = bounds.Dx()
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	return dst
+}
+
+func Encode(w io.Writer, img image.Image, format string) error {
+	switch format {
+	case FormatWebP:
+		return nativewebp.Encode(w, img, nil)
+	case FormatJPEG:
+		bounds := img.Bounds()
+		flattened := image.NewRGBA(bounds)
+		draw.Draw(flattened, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(flattened, bounds, img, bounds.Min, draw.Over)
+		return jpeg.Encode(w, flattened, &jpeg.Options{Quality: jpegQuality})
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatWebP:
+		return "image/webp"
+	case FormatJPEG:
+		return "image/jpeg"
+	default:
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,64 @@
+package linkcheck
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/loak155/techbranch-backend/pkg/safehttp"
+)
+
+const (
+	userAgent    = "techbranch-linkcheck/1.0"
+	maxBodyBytes = 64 << 10
+)
+
+type Checker struct {
+	client *http.Client
+}
+
+type Result struct {
+	StatusCode int
+	FinalUrl   string
+}
+
+func NewChecker(client *http.Client) *Checker {
+	return &Checker{client: client}
+}
+
+func (c *Checker) Check(rawUrl string) (*Result, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := safehttp.CheckScheme(u.Scheme); err != nil {
+		return nil, err
+	}
+
+	result, err := c.do(http.MethodHead, rawUrl)
+	if err == nil && result.StatusCode < 400 {
+		return result, nil
+	}
+	return c.do(http.MethodGet, rawUrl)
+}
+
+func (c *Checker) do(method, rawUrl string) (*Result, error) {
+	req, err := http.NewRequest(method, rawUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodyBytes))
+
+	return &Result{StatusCode: resp.StatusCode, FinalUrl: resp.Request.URL.String()}, nil
+}
+
+func ArchivedUrl(rawUrl string) string {
+	return "https://web.archive.org/web/" + rawUrl
+}
@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+type BookmarkDigestMailManager struct {
+	mailManager *Manager
+	subject     string
+	tmpl        *template.Template
+	settingsURL string
+}
+
+type BookmarkDigestItem struct {
+	Title string
+	URL   string
+}
+
+type BookmarkDigestTemplateData struct {
+	Username    string
+	UnreadCount int
+	Items       []BookmarkDigestItem
+	SettingsURL string
+}
+
+func NewBookmarkDigestMailManager(host string, port int, from, password, subject, templateFilePath, settingsURL string) (*BookmarkDigestMailManager, error) {
+	mailManager := NewManager(host, port, from, password)
+
+	tmpl, err := template.ParseFiles(templateFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return &BookmarkDigestMailManager{
+		mailManager: mailManager,
+		subject:     subject,
+		tmpl:        tmpl,
+		settingsURL: settingsURL,
+	}, nil
+}
+
+func (m *BookmarkDigestMailManager) SendBookmarkDigestMail(to []string, username string, unreadCount int, items []BookmarkDigestItem) error {
+	tmplData := BookmarkDigestTemplateData{
+		Username:    username,
+		UnreadCount: unreadCount,
+		Items:       items,
+		SettingsURL: m.settingsURL,
+	}
+
+	writer := new(bytes.Buffer)
+	if err := m.tmpl.Execute(writer, tmplData); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return m.mailManager.SendMailWithHTML(to, m.subject, writer.String())
+}
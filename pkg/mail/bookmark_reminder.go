@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+type BookmarkReminderMailManager struct {
+	mailManager *Manager
+	subject     string
+	tmpl        *template.Template
+}
+
+type BookmarkReminderTemplateData struct {
+	Username string
+	Title    string
+	URL      string
+	Note     string
+}
+
+func NewBookmarkReminderMailManager(host string, port int, from, password, subject, templateFilePath string) (*BookmarkReminderMailManager, error) {
+	mailManager := NewManager(host, port, from, password)
+
+	tmpl, err := template.ParseFiles(templateFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return &BookmarkReminderMailManager{
+		mailManager: mailManager,
+		subject:     subject,
+		tmpl:        tmpl,
+	}, nil
+}
+
+func (m *BookmarkReminderMailManager) SendBookmarkReminderMail(to []string, username, title, url, note string) error {
+	tmplData := BookmarkReminderTemplateData{
+		Username: username,
+		Title:    title,
+		URL:      url,
+		Note:     note,
+	}
+
+	writer := new(bytes.Buffer)
+	if err := m.tmpl.Execute(writer, tmplData); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return m.mailManager.SendMailWithHTML(to, m.subject, writer.String())
+}
@@ -0,0 +1,53 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+type WorkspaceInvitationMailManager struct {
+	mailManager   *Manager
+	subject       string
+	tmpl          *template.Template
+	invitationURL string
+}
+
+type WorkspaceInvitationTemplateData struct {
+	Inviter   string
+	Workspace string
+	Role      string
+	URL       string
+}
+
+func NewWorkspaceInvitationMailManager(host string, port int, from, password, subject, templateFilePath, invitationURL string) (*WorkspaceInvitationMailManager, error) {
+	mailManager := NewManager(host, port, from, password)
+
+	tmpl, err := template.ParseFiles(templateFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return &WorkspaceInvitationMailManager{
+		mailManager:   mailManager,
+		subject:       subject,
+		tmpl:          tmpl,
+		invitationURL: invitationURL,
+	}, nil
+}
+
+func (m *WorkspaceInvitationMailManager) SendWorkspaceInvitationMail(to []string, inviter, workspace, role, token string) error {
+	tmplData := WorkspaceInvitationTemplateData{
+		Inviter:   inviter,
+		Workspace: workspace,
+		Role:      role,
+		URL:       m.invitationURL + token,
+	}
+
+	writer := new(bytes.Buffer)
+	if err := m.tmpl.Execute(writer, tmplData); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return m.mailManager.SendMailWithHTML(to, m.subject, writer.String())
+}
@@ -1,16 +1,43 @@
 package migration
 
 import (
+	"errors"
+
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/rs/zerolog/log"
 )
 
-func DBMigrate(migrationURL string, dbSource string) {
+type Step struct {
+	Version uint
+	Run     func() error
+}
+
+func DBMigrate(migrationURL string, dbSource string, steps ...Step) {
 	migration, err := migrate.New(migrationURL, dbSource)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create new migrate instance")
 	}
 
+	version, _, err := migration.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		log.Fatal().Err(err).Msg("failed to get migration version")
+	}
+	for _, step := range steps {
+		if version >= step.Version {
+			continue
+		}
+		if err = migration.Migrate(step.Version); err != nil && err != migrate.ErrNoChange {
+			log.Fatal().Err(err).Uint("version", step.Version).Msg("failed to run migrate")
+		}
+		if err = step.Run(); err != nil {
+			if forceErr := migration.Force(int(step.Version) - 1); forceErr != nil {
+				log.Error().Err(forceErr).Uint("version", step.Version).Msg("failed to reset migration version")
+			}
+			log.Fatal().Err(err).Uint("version", step.Version).Msg("failed to run migration step")
+		}
+		version = step.Version
+	}
+
 	if err = migration.Up(); err != nil && err != migrate.ErrNoChange {
 		log.Fatal().Err(err).Msg("failed to run migrate up")
 	}
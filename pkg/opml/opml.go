@@ -0,0 +1,69 @@
+package opml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+type Outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XmlUrl   string    `xml:"xmlUrl,attr,omitempty"`
+	HtmlUrl  string    `xml:"htmlUrl,attr,omitempty"`
+	Outlines []Outline `xml:"outline"`
+}
+
+type document struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    struct {
+		Title       string `xml:"title"`
+		DateCreated string `xml:"dateCreated,omitempty"`
+	} `xml:"head"`
+	Body struct {
+		Outlines []Outline `xml:"outline"`
+	} `xml:"body"`
+}
+
+func Parse(data []byte) ([]Outline, error) {
+	doc := document{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder.Strict = false
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return flatten(doc.Body.Outlines), nil
+}
+
+func flatten(outlines []Outline) []Outline {
+	result := []Outline{}
+	for _, outline := range outlines {
+		children := outline.Outlines
+		outline.Outlines = nil
+		if outline.XmlUrl != "" || len(children) == 0 {
+			outline.XmlUrl = strings.TrimSpace(outline.XmlUrl)
+			result = append(result, outline)
+		}
+		result = append(result, flatten(children)...)
+	}
+	return result
+}
+
+func Marshal(title string, outlines []Outline) ([]byte, error) {
+	doc := document{Version: "2.0"}
+	doc.Head.Title = title
+	doc.Head.DateCreated = time.Now().UTC().Format(time.RFC1123Z)
+	doc.Body.Outlines = outlines
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
@@ -0,0 +1,53 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type Cursor struct {
+	CreatedAt time.Time `json:"c,omitempty"`
+	ID        uint      `json:"i"`
+	Rank      float32   `json:"r,omitempty"`
+	Score     float64   `json:"s,omitempty"`
+	Position  int       `json:"p,omitempty"`
+}
+
+func PageSize(size int) int {
+	if size <= 0 {
+		return DefaultPageSize
+	}
+	if size > MaxPageSize {
+		return MaxPageSize
+	}
+	return size
+}
+
+func EncodeToken(cursor Cursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeToken(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	cursor := &Cursor{}
+	if err := json.Unmarshal(b, cursor); err != nil || cursor.ID == 0 {
+		return nil, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
@@ -150,6 +150,52 @@ func (m *Article) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Description
+
+	// no validation rules for Site
+
+	// no validation rules for BookmarkCount
+
+	// no validation rules for CommentCount
+
+	// no validation rules for SubmittedByUserId
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetThumbnails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ArticleValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ArticleValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ArticleValidationError{
+					field:  fmt.Sprintf("Thumbnails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ArticleMultiError(errors)
 	}
@@ -227,6 +273,114 @@ var _ interface {
 	ErrorName() string
 } = ArticleValidationError{}
 
+// Validate checks the field values on ArticleThumbnail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArticleThumbnail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleThumbnail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArticleThumbnailMultiError, or nil if none found.
+func (m *ArticleThumbnail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleThumbnail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for WebpUrl
+
+	// no validation rules for JpegUrl
+
+	if len(errors) > 0 {
+		return ArticleThumbnailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleThumbnailMultiError is an error wrapping multiple validation errors
+// returned by ArticleThumbnail.ValidateAll() if the designated constraints
+// aren't met.
+type ArticleThumbnailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleThumbnailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleThumbnailMultiError) AllErrors() []error { return m }
+
+// ArticleThumbnailValidationError is the validation error returned by
+// ArticleThumbnail.Validate if the designated constraints aren't met.
+type ArticleThumbnailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleThumbnailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleThumbnailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleThumbnailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleThumbnailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleThumbnailValidationError) ErrorName() string { return "ArticleThumbnailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleThumbnailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleThumbnail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleThumbnailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleThumbnailValidationError{}
+
 // Validate checks the field values on CreateArticleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -283,6 +437,37 @@ func (m *CreateArticleRequest) validate(all bool) error {
 
 	// no validation rules for Image
 
+	// no validation rules for Description
+
+	// no validation rules for Body
+
+	if len(m.GetTags()) > 20 {
+		err := CreateArticleRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := CreateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateArticleRequestMultiError(errors)
 	}
@@ -751,9 +936,29 @@ func (m *ListArticlesRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Offset
+	if m.GetPageSize() < 0 {
+		err := ListArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
 
-	// no validation rules for Limit
+	if _, ok := _ListArticlesRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ListArticlesRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ newest most_bookmarked most_commented trending]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ListArticlesRequestMultiError(errors)
@@ -835,6 +1040,14 @@ var _ interface {
 	ErrorName() string
 } = ListArticlesRequestValidationError{}
 
+var _ListArticlesRequest_OrderBy_InLookup = map[string]struct{}{
+	"":                {},
+	"newest":          {},
+	"most_bookmarked": {},
+	"most_commented":  {},
+	"trending":        {},
+}
+
 // Validate checks the field values on ListArticlesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -891,6 +1104,8 @@ func (m *ListArticlesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListArticlesResponseMultiError(errors)
 	}
@@ -1029,6 +1244,77 @@ func (m *UpdateArticleRequest) validate(all bool) error {
 
 	// no validation rules for Image
 
+	// no validation rules for Description
+
+	// no validation rules for Body
+
+	if len(m.GetTags()) > 20 {
+		err := UpdateArticleRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := UpdateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetVersion() < 0 {
+		err := UpdateArticleRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateArticleRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateArticleRequestMultiError(errors)
 	}
@@ -1676,6 +1962,30 @@ func (m *GetBookmarkedArticlesRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	if m.GetPageSize() < 0 {
+		err := GetBookmarkedArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if _, ok := _GetBookmarkedArticlesRequest_ReadState_InLookup[m.GetReadState()]; !ok {
+		err := GetBookmarkedArticlesRequestValidationError{
+			field:  "ReadState",
+			reason: "value must be in list [ read unread]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetBookmarkedArticlesRequestMultiError(errors)
 	}
@@ -1757,6 +2067,12 @@ var _ interface {
 	ErrorName() string
 } = GetBookmarkedArticlesRequestValidationError{}
 
+var _GetBookmarkedArticlesRequest_ReadState_InLookup = map[string]struct{}{
+	"":       {},
+	"read":   {},
+	"unread": {},
+}
+
 // Validate checks the field values on GetBookmarkedArticlesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1813,6 +2129,8 @@ func (m *GetBookmarkedArticlesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return GetBookmarkedArticlesResponseMultiError(errors)
 	}
@@ -1893,3 +2211,2237 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetBookmarkedArticlesResponseValidationError{}
+
+// Validate checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesRequestMultiError, or nil if none found.
+func (m *SearchArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 200 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Tag
+
+	// no validation rules for Site
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchArticlesRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchArticlesRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchArticlesRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchArticlesRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchArticlesRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchArticlesRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() < 0 {
+		err := SearchArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesRequestMultiError) AllErrors() []error { return m }
+
+// SearchArticlesRequestValidationError is the validation error returned by
+// SearchArticlesRequest.Validate if the designated constraints aren't met.
+type SearchArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesRequestValidationError) ErrorName() string {
+	return "SearchArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesRequestValidationError{}
+
+// Validate checks the field values on SearchArticleResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticleResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticleResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticleResultMultiError, or nil if none found.
+func (m *SearchArticleResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticleResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchArticleResultValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchArticleResultValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchArticleResultValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Highlight
+
+	if len(errors) > 0 {
+		return SearchArticleResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticleResultMultiError is an error wrapping multiple validation
+// errors returned by SearchArticleResult.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticleResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticleResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticleResultMultiError) AllErrors() []error { return m }
+
+// SearchArticleResultValidationError is the validation error returned by
+// SearchArticleResult.Validate if the designated constraints aren't met.
+type SearchArticleResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticleResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticleResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticleResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticleResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticleResultValidationError) ErrorName() string {
+	return "SearchArticleResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticleResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticleResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticleResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticleResultValidationError{}
+
+// Validate checks the field values on SearchArticlesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesResponseMultiError, or nil if none found.
+func (m *SearchArticlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchArticlesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchArticlesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchArticlesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchArticlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesResponseMultiError) AllErrors() []error { return m }
+
+// SearchArticlesResponseValidationError is the validation error returned by
+// SearchArticlesResponse.Validate if the designated constraints aren't met.
+type SearchArticlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesResponseValidationError) ErrorName() string {
+	return "SearchArticlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesResponseValidationError{}
+
+// Validate checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreArticleRequestMultiError, or nil if none found.
+func (m *RestoreArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleRequestMultiError) AllErrors() []error { return m }
+
+// RestoreArticleRequestValidationError is the validation error returned by
+// RestoreArticleRequest.Validate if the designated constraints aren't met.
+type RestoreArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleRequestValidationError) ErrorName() string {
+	return "RestoreArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleRequestValidationError{}
+
+// Validate checks the field values on RestoreArticleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreArticleResponseMultiError, or nil if none found.
+func (m *RestoreArticleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreArticleResponseValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreArticleResponseValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreArticleResponseValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreArticleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreArticleResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreArticleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreArticleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleResponseMultiError) AllErrors() []error { return m }
+
+// RestoreArticleResponseValidationError is the validation error returned by
+// RestoreArticleResponse.Validate if the designated constraints aren't met.
+type RestoreArticleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleResponseValidationError) ErrorName() string {
+	return "RestoreArticleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleResponseValidationError{}
+
+// Validate checks the field values on ArticleRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArticleRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArticleRevisionMultiError, or nil if none found.
+func (m *ArticleRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ArticleId
+
+	// no validation rules for Revision
+
+	// no validation rules for EditorUserId
+
+	// no validation rules for Title
+
+	// no validation rules for Url
+
+	// no validation rules for Image
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleRevisionMultiError is an error wrapping multiple validation errors
+// returned by ArticleRevision.ValidateAll() if the designated constraints
+// aren't met.
+type ArticleRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleRevisionMultiError) AllErrors() []error { return m }
+
+// ArticleRevisionValidationError is the validation error returned by
+// ArticleRevision.Validate if the designated constraints aren't met.
+type ArticleRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleRevisionValidationError) ErrorName() string { return "ArticleRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleRevisionValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsRequestMultiError, or nil if none found.
+func (m *ListArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if m.GetPageSize() < 0 {
+		err := ListArticleRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsRequestValidationError is the validation error returned
+// by ListArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type ListArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsRequestValidationError) ErrorName() string {
+	return "ListArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsResponseMultiError, or nil if none found.
+func (m *ListArticleRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArticleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListArticleRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListArticleRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsResponseValidationError is the validation error returned
+// by ListArticleRevisionsResponse.Validate if the designated constraints
+// aren't met.
+type ListArticleRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsResponseValidationError) ErrorName() string {
+	return "ListArticleRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsResponseValidationError{}
+
+// Validate checks the field values on RevertArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertArticleRequestMultiError, or nil if none found.
+func (m *RevertArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if m.GetRevision() <= 0 {
+		err := RevertArticleRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := RevertArticleRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevertArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by RevertArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevertArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertArticleRequestMultiError) AllErrors() []error { return m }
+
+// RevertArticleRequestValidationError is the validation error returned by
+// RevertArticleRequest.Validate if the designated constraints aren't met.
+type RevertArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertArticleRequestValidationError) ErrorName() string {
+	return "RevertArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertArticleRequestValidationError{}
+
+// Validate checks the field values on RevertArticleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevertArticleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevertArticleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevertArticleResponseMultiError, or nil if none found.
+func (m *RevertArticleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevertArticleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevertArticleResponseValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevertArticleResponseValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevertArticleResponseValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevertArticleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevertArticleResponseMultiError is an error wrapping multiple validation
+// errors returned by RevertArticleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevertArticleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevertArticleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevertArticleResponseMultiError) AllErrors() []error { return m }
+
+// RevertArticleResponseValidationError is the validation error returned by
+// RevertArticleResponse.Validate if the designated constraints aren't met.
+type RevertArticleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertArticleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertArticleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertArticleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertArticleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertArticleResponseValidationError) ErrorName() string {
+	return "RevertArticleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertArticleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertArticleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertArticleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertArticleResponseValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsRequestMultiError, or nil if none found.
+func (m *DiffArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if m.GetFrom() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiffArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiffArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsRequestValidationError is the validation error returned
+// by DiffArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type DiffArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsRequestValidationError) ErrorName() string {
+	return "DiffArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on ArticleFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArticleFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArticleFieldChangeMultiError, or nil if none found.
+func (m *ArticleFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return ArticleFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by ArticleFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type ArticleFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleFieldChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleFieldChangeMultiError) AllErrors() []error { return m }
+
+// ArticleFieldChangeValidationError is the validation error returned by
+// ArticleFieldChange.Validate if the designated constraints aren't met.
+type ArticleFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleFieldChangeValidationError) ErrorName() string {
+	return "ArticleFieldChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArticleFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleFieldChangeValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsResponseMultiError, or nil if none found.
+func (m *DiffArticleRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffArticleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffArticleRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffArticleRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsResponseValidationError is the validation error returned
+// by DiffArticleRevisionsResponse.Validate if the designated constraints
+// aren't met.
+type DiffArticleRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsResponseValidationError) ErrorName() string {
+	return "DiffArticleRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsResponseValidationError{}
+
+// Validate checks the field values on ListRelatedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelatedArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelatedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelatedArticlesRequestMultiError, or nil if none found.
+func (m *ListRelatedArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelatedArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if m.GetPageSize() < 0 {
+		err := ListRelatedArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRelatedArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelatedArticlesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRelatedArticlesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRelatedArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelatedArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelatedArticlesRequestMultiError) AllErrors() []error { return m }
+
+// ListRelatedArticlesRequestValidationError is the validation error returned
+// by ListRelatedArticlesRequest.Validate if the designated constraints aren't met.
+type ListRelatedArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelatedArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelatedArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelatedArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelatedArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelatedArticlesRequestValidationError) ErrorName() string {
+	return "ListRelatedArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelatedArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelatedArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelatedArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelatedArticlesRequestValidationError{}
+
+// Validate checks the field values on ListRelatedArticlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelatedArticlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelatedArticlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelatedArticlesResponseMultiError, or nil if none found.
+func (m *ListRelatedArticlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelatedArticlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArticles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRelatedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRelatedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRelatedArticlesResponseValidationError{
+					field:  fmt.Sprintf("Articles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRelatedArticlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelatedArticlesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRelatedArticlesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRelatedArticlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelatedArticlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelatedArticlesResponseMultiError) AllErrors() []error { return m }
+
+// ListRelatedArticlesResponseValidationError is the validation error returned
+// by ListRelatedArticlesResponse.Validate if the designated constraints
+// aren't met.
+type ListRelatedArticlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelatedArticlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelatedArticlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelatedArticlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelatedArticlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelatedArticlesResponseValidationError) ErrorName() string {
+	return "ListRelatedArticlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelatedArticlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelatedArticlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelatedArticlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelatedArticlesResponseValidationError{}
+
+// Validate checks the field values on ListRecommendedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecommendedArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecommendedArticlesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListRecommendedArticlesRequestMultiError, or nil if none found.
+func (m *ListRecommendedArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecommendedArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListRecommendedArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRecommendedArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecommendedArticlesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRecommendedArticlesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListRecommendedArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecommendedArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecommendedArticlesRequestMultiError) AllErrors() []error { return m }
+
+// ListRecommendedArticlesRequestValidationError is the validation error
+// returned by ListRecommendedArticlesRequest.Validate if the designated
+// constraints aren't met.
+type ListRecommendedArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecommendedArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecommendedArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecommendedArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecommendedArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecommendedArticlesRequestValidationError) ErrorName() string {
+	return "ListRecommendedArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecommendedArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecommendedArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecommendedArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecommendedArticlesRequestValidationError{}
+
+// Validate checks the field values on ListRecommendedArticlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecommendedArticlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecommendedArticlesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListRecommendedArticlesResponseMultiError, or nil if none found.
+func (m *ListRecommendedArticlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecommendedArticlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArticles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRecommendedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRecommendedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRecommendedArticlesResponseValidationError{
+					field:  fmt.Sprintf("Articles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRecommendedArticlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecommendedArticlesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRecommendedArticlesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListRecommendedArticlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecommendedArticlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecommendedArticlesResponseMultiError) AllErrors() []error { return m }
+
+// ListRecommendedArticlesResponseValidationError is the validation error
+// returned by ListRecommendedArticlesResponse.Validate if the designated
+// constraints aren't met.
+type ListRecommendedArticlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecommendedArticlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecommendedArticlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecommendedArticlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecommendedArticlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecommendedArticlesResponseValidationError) ErrorName() string {
+	return "ListRecommendedArticlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecommendedArticlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecommendedArticlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecommendedArticlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecommendedArticlesResponseValidationError{}
@@ -19,13 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ArticleService_CreateArticle_FullMethodName         = "/proto.ArticleService/CreateArticle"
-	ArticleService_GetArticle_FullMethodName            = "/proto.ArticleService/GetArticle"
-	ArticleService_ListArticles_FullMethodName          = "/proto.ArticleService/ListArticles"
-	ArticleService_UpdateArticle_FullMethodName         = "/proto.ArticleService/UpdateArticle"
-	ArticleService_DeleteArticle_FullMethodName         = "/proto.ArticleService/DeleteArticle"
-	ArticleService_GetArticleCount_FullMethodName       = "/proto.ArticleService/GetArticleCount"
-	ArticleService_GetBookmarkedArticles_FullMethodName = "/proto.ArticleService/GetBookmarkedArticles"
+	ArticleService_CreateArticle_FullMethodName           = "/proto.ArticleService/CreateArticle"
+	ArticleService_GetArticle_FullMethodName              = "/proto.ArticleService/GetArticle"
+	ArticleService_ListArticles_FullMethodName            = "/proto.ArticleService/ListArticles"
+	ArticleService_UpdateArticle_FullMethodName           = "/proto.ArticleService/UpdateArticle"
+	ArticleService_DeleteArticle_FullMethodName           = "/proto.ArticleService/DeleteArticle"
+	ArticleService_GetArticleCount_FullMethodName         = "/proto.ArticleService/GetArticleCount"
+	ArticleService_GetBookmarkedArticles_FullMethodName   = "/proto.ArticleService/GetBookmarkedArticles"
+	ArticleService_SearchArticles_FullMethodName          = "/proto.ArticleService/SearchArticles"
+	ArticleService_RestoreArticle_FullMethodName          = "/proto.ArticleService/RestoreArticle"
+	ArticleService_ListArticleRevisions_FullMethodName    = "/proto.ArticleService/ListArticleRevisions"
+	ArticleService_RevertArticle_FullMethodName           = "/proto.ArticleService/RevertArticle"
+	ArticleService_DiffArticleRevisions_FullMethodName    = "/proto.ArticleService/DiffArticleRevisions"
+	ArticleService_ListRelatedArticles_FullMethodName     = "/proto.ArticleService/ListRelatedArticles"
+	ArticleService_ListRecommendedArticles_FullMethodName = "/proto.ArticleService/ListRecommendedArticles"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -39,6 +46,13 @@ type ArticleServiceClient interface {
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleResponse, error)
 	GetArticleCount(ctx context.Context, in *GetArticleCountRequest, opts ...grpc.CallOption) (*GetArticleCountResponse, error)
 	GetBookmarkedArticles(ctx context.Context, in *GetBookmarkedArticlesRequest, opts ...grpc.CallOption) (*GetBookmarkedArticlesResponse, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error)
+	ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsResponse, error)
+	RevertArticle(ctx context.Context, in *RevertArticleRequest, opts ...grpc.CallOption) (*RevertArticleResponse, error)
+	DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsResponse, error)
+	ListRelatedArticles(ctx context.Context, in *ListRelatedArticlesRequest, opts ...grpc.CallOption) (*ListRelatedArticlesResponse, error)
+	ListRecommendedArticles(ctx context.Context, in *ListRecommendedArticlesRequest, opts ...grpc.CallOption) (*ListRecommendedArticlesResponse, error)
 }
 
 type articleServiceClient struct {
@@ -112,6 +126,69 @@ func (c *articleServiceClient) GetBookmarkedArticles(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *articleServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SearchArticles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error) {
+	out := new(RestoreArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RestoreArticle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsResponse, error) {
+	out := new(ListArticleRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListArticleRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RevertArticle(ctx context.Context, in *RevertArticleRequest, opts ...grpc.CallOption) (*RevertArticleResponse, error) {
+	out := new(RevertArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RevertArticle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsResponse, error) {
+	out := new(DiffArticleRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DiffArticleRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListRelatedArticles(ctx context.Context, in *ListRelatedArticlesRequest, opts ...grpc.CallOption) (*ListRelatedArticlesResponse, error) {
+	out := new(ListRelatedArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListRelatedArticles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListRecommendedArticles(ctx context.Context, in *ListRecommendedArticlesRequest, opts ...grpc.CallOption) (*ListRecommendedArticlesResponse, error) {
+	out := new(ListRecommendedArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListRecommendedArticles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServiceServer is the server API for ArticleService service.
 // All implementations must embed UnimplementedArticleServiceServer
 // for forward compatibility
@@ -123,6 +200,13 @@ type ArticleServiceServer interface {
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
 	GetArticleCount(context.Context, *GetArticleCountRequest) (*GetArticleCountResponse, error)
 	GetBookmarkedArticles(context.Context, *GetBookmarkedArticlesRequest) (*GetBookmarkedArticlesResponse, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleResponse, error)
+	ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsResponse, error)
+	RevertArticle(context.Context, *RevertArticleRequest) (*RevertArticleResponse, error)
+	DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsResponse, error)
+	ListRelatedArticles(context.Context, *ListRelatedArticlesRequest) (*ListRelatedArticlesResponse, error)
+	ListRecommendedArticles(context.Context, *ListRecommendedArticlesRequest) (*ListRecommendedArticlesResponse, error)
 	mustEmbedUnimplementedArticleServiceServer()
 }
 
@@ -151,6 +235,27 @@ func (UnimplementedArticleServiceServer) GetArticleCount(context.Context, *GetAr
 func (UnimplementedArticleServiceServer) GetBookmarkedArticles(context.Context, *GetBookmarkedArticlesRequest) (*GetBookmarkedArticlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkedArticles not implemented")
 }
+func (UnimplementedArticleServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) RevertArticle(context.Context, *RevertArticleRequest) (*RevertArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffArticleRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) ListRelatedArticles(context.Context, *ListRelatedArticlesRequest) (*ListRelatedArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelatedArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) ListRecommendedArticles(context.Context, *ListRecommendedArticlesRequest) (*ListRecommendedArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecommendedArticles not implemented")
+}
 func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
 
 // UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +395,132 @@ func _ArticleService_GetBookmarkedArticles_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RestoreArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListArticleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticleRevisions(ctx, req.(*ListArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RevertArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RevertArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RevertArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RevertArticle(ctx, req.(*RevertArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DiffArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DiffArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DiffArticleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DiffArticleRevisions(ctx, req.(*DiffArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListRelatedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListRelatedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListRelatedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListRelatedArticles(ctx, req.(*ListRelatedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListRecommendedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecommendedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListRecommendedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListRecommendedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListRecommendedArticles(ctx, req.(*ListRecommendedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +556,34 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBookmarkedArticles",
 			Handler:    _ArticleService_GetBookmarkedArticles_Handler,
 		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _ArticleService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _ArticleService_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "ListArticleRevisions",
+			Handler:    _ArticleService_ListArticleRevisions_Handler,
+		},
+		{
+			MethodName: "RevertArticle",
+			Handler:    _ArticleService_RevertArticle_Handler,
+		},
+		{
+			MethodName: "DiffArticleRevisions",
+			Handler:    _ArticleService_DiffArticleRevisions_Handler,
+		},
+		{
+			MethodName: "ListRelatedArticles",
+			Handler:    _ArticleService_ListRelatedArticles_Handler,
+		},
+		{
+			MethodName: "ListRecommendedArticles",
+			Handler:    _ArticleService_ListRecommendedArticles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "article.proto",
@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: article_link.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ArticleLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ArticleLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArticleLinkMultiError, or
+// nil if none found.
+func (m *ArticleLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	// no validation rules for Title
+
+	// no validation rules for Url
+
+	// no validation rules for StatusCode
+
+	// no validation rules for RedirectUrl
+
+	// no validation rules for LastError
+
+	// no validation rules for FailureCount
+
+	// no validation rules for Broken
+
+	// no validation rules for ArchivedUrl
+
+	if all {
+		switch v := interface{}(m.GetLastCheckedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleLinkValidationError{
+					field:  "LastCheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleLinkValidationError{
+					field:  "LastCheckedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastCheckedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleLinkValidationError{
+				field:  "LastCheckedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextCheckAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleLinkValidationError{
+					field:  "NextCheckAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleLinkValidationError{
+					field:  "NextCheckAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextCheckAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleLinkValidationError{
+				field:  "NextCheckAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleLinkMultiError is an error wrapping multiple validation errors
+// returned by ArticleLink.ValidateAll() if the designated constraints aren't met.
+type ArticleLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleLinkMultiError) AllErrors() []error { return m }
+
+// ArticleLinkValidationError is the validation error returned by
+// ArticleLink.Validate if the designated constraints aren't met.
+type ArticleLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleLinkValidationError) ErrorName() string { return "ArticleLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleLinkValidationError{}
+
+// Validate checks the field values on ListBrokenArticleLinksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBrokenArticleLinksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBrokenArticleLinksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListBrokenArticleLinksRequestMultiError, or nil if none found.
+func (m *ListBrokenArticleLinksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBrokenArticleLinksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListBrokenArticleLinksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBrokenArticleLinksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBrokenArticleLinksRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBrokenArticleLinksRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListBrokenArticleLinksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBrokenArticleLinksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBrokenArticleLinksRequestMultiError) AllErrors() []error { return m }
+
+// ListBrokenArticleLinksRequestValidationError is the validation error
+// returned by ListBrokenArticleLinksRequest.Validate if the designated
+// constraints aren't met.
+type ListBrokenArticleLinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBrokenArticleLinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBrokenArticleLinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBrokenArticleLinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBrokenArticleLinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBrokenArticleLinksRequestValidationError) ErrorName() string {
+	return "ListBrokenArticleLinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBrokenArticleLinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBrokenArticleLinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBrokenArticleLinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBrokenArticleLinksRequestValidationError{}
+
+// Validate checks the field values on ListBrokenArticleLinksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBrokenArticleLinksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBrokenArticleLinksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListBrokenArticleLinksResponseMultiError, or nil if none found.
+func (m *ListBrokenArticleLinksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBrokenArticleLinksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArticleLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBrokenArticleLinksResponseValidationError{
+						field:  fmt.Sprintf("ArticleLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBrokenArticleLinksResponseValidationError{
+						field:  fmt.Sprintf("ArticleLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBrokenArticleLinksResponseValidationError{
+					field:  fmt.Sprintf("ArticleLinks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBrokenArticleLinksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBrokenArticleLinksResponseMultiError is an error wrapping multiple
+// validation errors returned by ListBrokenArticleLinksResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListBrokenArticleLinksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBrokenArticleLinksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBrokenArticleLinksResponseMultiError) AllErrors() []error { return m }
+
+// ListBrokenArticleLinksResponseValidationError is the validation error
+// returned by ListBrokenArticleLinksResponse.Validate if the designated
+// constraints aren't met.
+type ListBrokenArticleLinksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBrokenArticleLinksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBrokenArticleLinksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBrokenArticleLinksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBrokenArticleLinksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBrokenArticleLinksResponseValidationError) ErrorName() string {
+	return "ListBrokenArticleLinksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBrokenArticleLinksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBrokenArticleLinksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBrokenArticleLinksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBrokenArticleLinksResponseValidationError{}
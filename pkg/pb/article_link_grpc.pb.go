@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: article_link.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ArticleLinkService_ListBrokenArticleLinks_FullMethodName = "/proto.ArticleLinkService/ListBrokenArticleLinks"
+)
+
+// ArticleLinkServiceClient is the client API for ArticleLinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArticleLinkServiceClient interface {
+	ListBrokenArticleLinks(ctx context.Context, in *ListBrokenArticleLinksRequest, opts ...grpc.CallOption) (*ListBrokenArticleLinksResponse, error)
+}
+
+type articleLinkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArticleLinkServiceClient(cc grpc.ClientConnInterface) ArticleLinkServiceClient {
+	return &articleLinkServiceClient{cc}
+}
+
+func (c *articleLinkServiceClient) ListBrokenArticleLinks(ctx context.Context, in *ListBrokenArticleLinksRequest, opts ...grpc.CallOption) (*ListBrokenArticleLinksResponse, error) {
+	out := new(ListBrokenArticleLinksResponse)
+	err := c.cc.Invoke(ctx, ArticleLinkService_ListBrokenArticleLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArticleLinkServiceServer is the server API for ArticleLinkService service.
+// All implementations must embed UnimplementedArticleLinkServiceServer
+// for forward compatibility
+type ArticleLinkServiceServer interface {
+	ListBrokenArticleLinks(context.Context, *ListBrokenArticleLinksRequest) (*ListBrokenArticleLinksResponse, error)
+	mustEmbedUnimplementedArticleLinkServiceServer()
+}
+
+// UnimplementedArticleLinkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedArticleLinkServiceServer struct {
+}
+
+func (UnimplementedArticleLinkServiceServer) ListBrokenArticleLinks(context.Context, *ListBrokenArticleLinksRequest) (*ListBrokenArticleLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrokenArticleLinks not implemented")
+}
+func (UnimplementedArticleLinkServiceServer) mustEmbedUnimplementedArticleLinkServiceServer() {}
+
+// UnsafeArticleLinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArticleLinkServiceServer will
+// result in compilation errors.
+type UnsafeArticleLinkServiceServer interface {
+	mustEmbedUnimplementedArticleLinkServiceServer()
+}
+
+func RegisterArticleLinkServiceServer(s grpc.ServiceRegistrar, srv ArticleLinkServiceServer) {
+	s.RegisterService(&ArticleLinkService_ServiceDesc, srv)
+}
+
+func _ArticleLinkService_ListBrokenArticleLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrokenArticleLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleLinkServiceServer).ListBrokenArticleLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleLinkService_ListBrokenArticleLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleLinkServiceServer).ListBrokenArticleLinks(ctx, req.(*ListBrokenArticleLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArticleLinkService_ServiceDesc is the grpc.ServiceDesc for ArticleLinkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArticleLinkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ArticleLinkService",
+	HandlerType: (*ArticleLinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBrokenArticleLinks",
+			Handler:    _ArticleLinkService_ListBrokenArticleLinks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "article_link.proto",
+}
@@ -1371,6 +1371,8 @@ func (m *GetSigninUserResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for UnreadBookmarkCount
+
 	if len(errors) > 0 {
 		return GetSigninUserResponseMultiError(errors)
 	}
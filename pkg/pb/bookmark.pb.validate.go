@@ -121,6 +121,74 @@ func (m *Bookmark) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for CollectionId
+
+	// no validation rules for Position
+
+	// no validation rules for Note
+
+	// no validation rules for Read
+
+	if all {
+		switch v := interface{}(m.GetReadAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkValidationError{
+				field:  "ReadAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Progress
+
+	if all {
+		switch v := interface{}(m.GetRemindAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "RemindAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkValidationError{
+					field:  "RemindAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRemindAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkValidationError{
+				field:  "RemindAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BookmarkMultiError(errors)
 	}
@@ -224,6 +292,8 @@ func (m *CreateBookmarkRequest) validate(all bool) error {
 
 	// no validation rules for ArticleId
 
+	// no validation rules for Strict
+
 	if len(errors) > 0 {
 		return CreateBookmarkRequestMultiError(errors)
 	}
@@ -673,6 +743,52 @@ func (m *ListBookmarksByUserIDRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	if m.GetPageSize() < 0 {
+		err := ListBookmarksByUserIDRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetCollectionId() < 0 {
+		err := ListBookmarksByUserIDRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTag()) > 50 {
+		err := ListBookmarksByUserIDRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListBookmarksByUserIDRequest_ReadState_InLookup[m.GetReadState()]; !ok {
+		err := ListBookmarksByUserIDRequestValidationError{
+			field:  "ReadState",
+			reason: "value must be in list [ read unread]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListBookmarksByUserIDRequestMultiError(errors)
 	}
@@ -754,6 +870,12 @@ var _ interface {
 	ErrorName() string
 } = ListBookmarksByUserIDRequestValidationError{}
 
+var _ListBookmarksByUserIDRequest_ReadState_InLookup = map[string]struct{}{
+	"":       {},
+	"read":   {},
+	"unread": {},
+}
+
 // Validate checks the field values on ListBookmarksByUserIDResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -810,6 +932,8 @@ func (m *ListBookmarksByUserIDResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListBookmarksByUserIDResponseMultiError(errors)
 	}
@@ -891,44 +1015,79 @@ var _ interface {
 	ErrorName() string
 } = ListBookmarksByUserIDResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksByArticleIDRequest with the
+// Validate checks the field values on ListWorkspaceBookmarksRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksByArticleIDRequest) Validate() error {
+func (m *ListWorkspaceBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksByArticleIDRequest with
+// ValidateAll checks the field values on ListWorkspaceBookmarksRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListBookmarksByArticleIDRequestMultiError, or nil if none found.
-func (m *ListBookmarksByArticleIDRequest) ValidateAll() error {
+// ListWorkspaceBookmarksRequestMultiError, or nil if none found.
+func (m *ListWorkspaceBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksByArticleIDRequest) validate(all bool) error {
+func (m *ListWorkspaceBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ArticleId
+	// no validation rules for WorkspaceId
+
+	if m.GetPageSize() < 0 {
+		err := ListWorkspaceBookmarksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetCollectionId() < 0 {
+		err := ListWorkspaceBookmarksRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTag()) > 50 {
+		err := ListWorkspaceBookmarksRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListBookmarksByArticleIDRequestMultiError(errors)
+		return ListWorkspaceBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksByArticleIDRequestMultiError is an error wrapping multiple
-// validation errors returned by ListBookmarksByArticleIDRequest.ValidateAll()
+// ListWorkspaceBookmarksRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceBookmarksRequest.ValidateAll()
 // if the designated constraints aren't met.
-type ListBookmarksByArticleIDRequestMultiError []error
+type ListWorkspaceBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksByArticleIDRequestMultiError) Error() string {
+func (m ListWorkspaceBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -937,12 +1096,12 @@ func (m ListBookmarksByArticleIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksByArticleIDRequestMultiError) AllErrors() []error { return m }
+func (m ListWorkspaceBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksByArticleIDRequestValidationError is the validation error
-// returned by ListBookmarksByArticleIDRequest.Validate if the designated
+// ListWorkspaceBookmarksRequestValidationError is the validation error
+// returned by ListWorkspaceBookmarksRequest.Validate if the designated
 // constraints aren't met.
-type ListBookmarksByArticleIDRequestValidationError struct {
+type ListWorkspaceBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -950,24 +1109,24 @@ type ListBookmarksByArticleIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksByArticleIDRequestValidationError) Field() string { return e.field }
+func (e ListWorkspaceBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksByArticleIDRequestValidationError) Reason() string { return e.reason }
+func (e ListWorkspaceBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksByArticleIDRequestValidationError) Cause() error { return e.cause }
+func (e ListWorkspaceBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksByArticleIDRequestValidationError) Key() bool { return e.key }
+func (e ListWorkspaceBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksByArticleIDRequestValidationError) ErrorName() string {
-	return "ListBookmarksByArticleIDRequestValidationError"
+func (e ListWorkspaceBookmarksRequestValidationError) ErrorName() string {
+	return "ListWorkspaceBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksByArticleIDRequestValidationError) Error() string {
+func (e ListWorkspaceBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -979,14 +1138,14 @@ func (e ListBookmarksByArticleIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksByArticleIDRequest.%s: %s%s",
+		"invalid %sListWorkspaceBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksByArticleIDRequestValidationError{}
+var _ error = ListWorkspaceBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -994,25 +1153,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksByArticleIDRequestValidationError{}
+} = ListWorkspaceBookmarksRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksByArticleIDResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ListBookmarksByArticleIDResponse) Validate() error {
+// Validate checks the field values on ListWorkspaceBookmarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspaceBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksByArticleIDResponse with
+// ValidateAll checks the field values on ListWorkspaceBookmarksResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListBookmarksByArticleIDResponseMultiError, or nil if none found.
-func (m *ListBookmarksByArticleIDResponse) ValidateAll() error {
+// ListWorkspaceBookmarksResponseMultiError, or nil if none found.
+func (m *ListWorkspaceBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
+func (m *ListWorkspaceBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1026,7 +1184,7 @@ func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksByArticleIDResponseValidationError{
+					errors = append(errors, ListWorkspaceBookmarksResponseValidationError{
 						field:  fmt.Sprintf("Bookmarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1034,7 +1192,7 @@ func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksByArticleIDResponseValidationError{
+					errors = append(errors, ListWorkspaceBookmarksResponseValidationError{
 						field:  fmt.Sprintf("Bookmarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1043,7 +1201,7 @@ func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListBookmarksByArticleIDResponseValidationError{
+				return ListWorkspaceBookmarksResponseValidationError{
 					field:  fmt.Sprintf("Bookmarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1053,21 +1211,22 @@ func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return ListBookmarksByArticleIDResponseMultiError(errors)
+		return ListWorkspaceBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksByArticleIDResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ListBookmarksByArticleIDResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBookmarksByArticleIDResponseMultiError []error
+// ListWorkspaceBookmarksResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceBookmarksResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWorkspaceBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksByArticleIDResponseMultiError) Error() string {
+func (m ListWorkspaceBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1076,12 +1235,12 @@ func (m ListBookmarksByArticleIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksByArticleIDResponseMultiError) AllErrors() []error { return m }
+func (m ListWorkspaceBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksByArticleIDResponseValidationError is the validation error
-// returned by ListBookmarksByArticleIDResponse.Validate if the designated
+// ListWorkspaceBookmarksResponseValidationError is the validation error
+// returned by ListWorkspaceBookmarksResponse.Validate if the designated
 // constraints aren't met.
-type ListBookmarksByArticleIDResponseValidationError struct {
+type ListWorkspaceBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1089,24 +1248,24 @@ type ListBookmarksByArticleIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksByArticleIDResponseValidationError) Field() string { return e.field }
+func (e ListWorkspaceBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksByArticleIDResponseValidationError) Reason() string { return e.reason }
+func (e ListWorkspaceBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksByArticleIDResponseValidationError) Cause() error { return e.cause }
+func (e ListWorkspaceBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksByArticleIDResponseValidationError) Key() bool { return e.key }
+func (e ListWorkspaceBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksByArticleIDResponseValidationError) ErrorName() string {
-	return "ListBookmarksByArticleIDResponseValidationError"
+func (e ListWorkspaceBookmarksResponseValidationError) ErrorName() string {
+	return "ListWorkspaceBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksByArticleIDResponseValidationError) Error() string {
+func (e ListWorkspaceBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1118,14 +1277,14 @@ func (e ListBookmarksByArticleIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksByArticleIDResponse.%s: %s%s",
+		"invalid %sListWorkspaceBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksByArticleIDResponseValidationError{}
+var _ error = ListWorkspaceBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1133,51 +1292,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksByArticleIDResponseValidationError{}
+} = ListWorkspaceBookmarksResponseValidationError{}
 
-// Validate checks the field values on
-// DeleteBookmarkByUserIDAndArticleIDRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookmarkByUserIDAndArticleIDRequest) Validate() error {
+// Validate checks the field values on ListBookmarksByArticleIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksByArticleIDRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// DeleteBookmarkByUserIDAndArticleIDRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// DeleteBookmarkByUserIDAndArticleIDRequestMultiError, or nil if none found.
-func (m *DeleteBookmarkByUserIDAndArticleIDRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListBookmarksByArticleIDRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListBookmarksByArticleIDRequestMultiError, or nil if none found.
+func (m *ListBookmarksByArticleIDRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByUserIDAndArticleIDRequest) validate(all bool) error {
+func (m *ListBookmarksByArticleIDRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
 	// no validation rules for ArticleId
 
+	if m.GetPageSize() < 0 {
+		err := ListBookmarksByArticleIDRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return DeleteBookmarkByUserIDAndArticleIDRequestMultiError(errors)
+		return ListBookmarksByArticleIDRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookmarkByUserIDAndArticleIDRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteBookmarkByUserIDAndArticleIDRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBookmarkByUserIDAndArticleIDRequestMultiError []error
+// ListBookmarksByArticleIDRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBookmarksByArticleIDRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListBookmarksByArticleIDRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByUserIDAndArticleIDRequestMultiError) Error() string {
+func (m ListBookmarksByArticleIDRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1186,12 +1353,12 @@ func (m DeleteBookmarkByUserIDAndArticleIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByUserIDAndArticleIDRequestMultiError) AllErrors() []error { return m }
+func (m ListBookmarksByArticleIDRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByUserIDAndArticleIDRequestValidationError is the validation
-// error returned by DeleteBookmarkByUserIDAndArticleIDRequest.Validate if the
-// designated constraints aren't met.
-type DeleteBookmarkByUserIDAndArticleIDRequestValidationError struct {
+// ListBookmarksByArticleIDRequestValidationError is the validation error
+// returned by ListBookmarksByArticleIDRequest.Validate if the designated
+// constraints aren't met.
+type ListBookmarksByArticleIDRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1199,24 +1366,24 @@ type DeleteBookmarkByUserIDAndArticleIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Field() string { return e.field }
+func (e ListBookmarksByArticleIDRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Reason() string { return e.reason }
+func (e ListBookmarksByArticleIDRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Cause() error { return e.cause }
+func (e ListBookmarksByArticleIDRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Key() bool { return e.key }
+func (e ListBookmarksByArticleIDRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) ErrorName() string {
-	return "DeleteBookmarkByUserIDAndArticleIDRequestValidationError"
+func (e ListBookmarksByArticleIDRequestValidationError) ErrorName() string {
+	return "ListBookmarksByArticleIDRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Error() string {
+func (e ListBookmarksByArticleIDRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1228,14 +1395,14 @@ func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByUserIDAndArticleIDRequest.%s: %s%s",
+		"invalid %sListBookmarksByArticleIDRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByUserIDAndArticleIDRequestValidationError{}
+var _ error = ListBookmarksByArticleIDRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1243,47 +1410,2490 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByUserIDAndArticleIDRequestValidationError{}
+} = ListBookmarksByArticleIDRequestValidationError{}
 
-// Validate checks the field values on
-// DeleteBookmarkByUserIDAndArticleIDResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookmarkByUserIDAndArticleIDResponse) Validate() error {
+// Validate checks the field values on ListBookmarksByArticleIDResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListBookmarksByArticleIDResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// DeleteBookmarkByUserIDAndArticleIDResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// DeleteBookmarkByUserIDAndArticleIDResponseMultiError, or nil if none found.
-func (m *DeleteBookmarkByUserIDAndArticleIDResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListBookmarksByArticleIDResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListBookmarksByArticleIDResponseMultiError, or nil if none found.
+func (m *ListBookmarksByArticleIDResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByUserIDAndArticleIDResponse) validate(all bool) error {
+func (m *ListBookmarksByArticleIDResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return DeleteBookmarkByUserIDAndArticleIDResponseMultiError(errors)
-	}
-
-	return nil
-}
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
 
-// DeleteBookmarkByUserIDAndArticleIDResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteBookmarkByUserIDAndArticleIDResponse.ValidateAll() if the designated
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksByArticleIDResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksByArticleIDResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksByArticleIDResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBookmarksByArticleIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksByArticleIDResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListBookmarksByArticleIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksByArticleIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksByArticleIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksByArticleIDResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarksByArticleIDResponseValidationError is the validation error
+// returned by ListBookmarksByArticleIDResponse.Validate if the designated
+// constraints aren't met.
+type ListBookmarksByArticleIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksByArticleIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksByArticleIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksByArticleIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksByArticleIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksByArticleIDResponseValidationError) ErrorName() string {
+	return "ListBookmarksByArticleIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksByArticleIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksByArticleIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksByArticleIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksByArticleIDResponseValidationError{}
+
+// Validate checks the field values on
+// DeleteBookmarkByUserIDAndArticleIDRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkByUserIDAndArticleIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteBookmarkByUserIDAndArticleIDRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeleteBookmarkByUserIDAndArticleIDRequestMultiError, or nil if none found.
+func (m *DeleteBookmarkByUserIDAndArticleIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByUserIDAndArticleIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ArticleId
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByUserIDAndArticleIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByUserIDAndArticleIDRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteBookmarkByUserIDAndArticleIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookmarkByUserIDAndArticleIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByUserIDAndArticleIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByUserIDAndArticleIDRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByUserIDAndArticleIDRequestValidationError is the validation
+// error returned by DeleteBookmarkByUserIDAndArticleIDRequest.Validate if the
+// designated constraints aren't met.
+type DeleteBookmarkByUserIDAndArticleIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) ErrorName() string {
+	return "DeleteBookmarkByUserIDAndArticleIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByUserIDAndArticleIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByUserIDAndArticleIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByUserIDAndArticleIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByUserIDAndArticleIDRequestValidationError{}
+
+// Validate checks the field values on
+// DeleteBookmarkByUserIDAndArticleIDResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkByUserIDAndArticleIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteBookmarkByUserIDAndArticleIDResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeleteBookmarkByUserIDAndArticleIDResponseMultiError, or nil if none found.
+func (m *DeleteBookmarkByUserIDAndArticleIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByUserIDAndArticleIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByUserIDAndArticleIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByUserIDAndArticleIDResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteBookmarkByUserIDAndArticleIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookmarkByUserIDAndArticleIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByUserIDAndArticleIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByUserIDAndArticleIDResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByUserIDAndArticleIDResponseValidationError is the validation
+// error returned by DeleteBookmarkByUserIDAndArticleIDResponse.Validate if
+// the designated constraints aren't met.
+type DeleteBookmarkByUserIDAndArticleIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) ErrorName() string {
+	return "DeleteBookmarkByUserIDAndArticleIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByUserIDAndArticleIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByUserIDAndArticleIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByUserIDAndArticleIDResponseValidationError{}
+
+// Validate checks the field values on DeleteBookmarkByUserIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkByUserIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkByUserIDRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkByUserIDRequestMultiError, or nil if none found.
+func (m *DeleteBookmarkByUserIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByUserIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByUserIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByUserIDRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBookmarkByUserIDRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteBookmarkByUserIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByUserIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByUserIDRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByUserIDRequestValidationError is the validation error
+// returned by DeleteBookmarkByUserIDRequest.Validate if the designated
+// constraints aren't met.
+type DeleteBookmarkByUserIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByUserIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByUserIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByUserIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByUserIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByUserIDRequestValidationError) ErrorName() string {
+	return "DeleteBookmarkByUserIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByUserIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByUserIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByUserIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByUserIDRequestValidationError{}
+
+// Validate checks the field values on DeleteBookmarkByUserIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkByUserIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkByUserIDResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkByUserIDResponseMultiError, or nil if none found.
+func (m *DeleteBookmarkByUserIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByUserIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByUserIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByUserIDResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteBookmarkByUserIDResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteBookmarkByUserIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByUserIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByUserIDResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByUserIDResponseValidationError is the validation error
+// returned by DeleteBookmarkByUserIDResponse.Validate if the designated
+// constraints aren't met.
+type DeleteBookmarkByUserIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByUserIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByUserIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByUserIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByUserIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByUserIDResponseValidationError) ErrorName() string {
+	return "DeleteBookmarkByUserIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByUserIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByUserIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByUserIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByUserIDResponseValidationError{}
+
+// Validate checks the field values on DeleteBookmarkByArticleIDRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteBookmarkByArticleIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkByArticleIDRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkByArticleIDRequestMultiError, or nil if none found.
+func (m *DeleteBookmarkByArticleIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByArticleIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByArticleIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByArticleIDRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteBookmarkByArticleIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookmarkByArticleIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByArticleIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByArticleIDRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByArticleIDRequestValidationError is the validation error
+// returned by DeleteBookmarkByArticleIDRequest.Validate if the designated
+// constraints aren't met.
+type DeleteBookmarkByArticleIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByArticleIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByArticleIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByArticleIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByArticleIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByArticleIDRequestValidationError) ErrorName() string {
+	return "DeleteBookmarkByArticleIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByArticleIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByArticleIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByArticleIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByArticleIDRequestValidationError{}
+
+// Validate checks the field values on DeleteBookmarkByArticleIDResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteBookmarkByArticleIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkByArticleIDResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkByArticleIDResponseMultiError, or nil if none found.
+func (m *DeleteBookmarkByArticleIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkByArticleIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBookmarkByArticleIDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkByArticleIDResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteBookmarkByArticleIDResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookmarkByArticleIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkByArticleIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkByArticleIDResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkByArticleIDResponseValidationError is the validation error
+// returned by DeleteBookmarkByArticleIDResponse.Validate if the designated
+// constraints aren't met.
+type DeleteBookmarkByArticleIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkByArticleIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkByArticleIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkByArticleIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkByArticleIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkByArticleIDResponseValidationError) ErrorName() string {
+	return "DeleteBookmarkByArticleIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkByArticleIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkByArticleIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkByArticleIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkByArticleIDResponseValidationError{}
+
+// Validate checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetNote()) > 10000 {
+		err := UpdateBookmarkRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 10000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetHighlights()) > 100 {
+		err := UpdateBookmarkRequestValidationError{
+			field:  "Highlights",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 2000 {
+			err := UpdateBookmarkRequestValidationError{
+				field:  fmt.Sprintf("Highlights[%v]", idx),
+				reason: "value length must be between 1 and 2000 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := UpdateBookmarkRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := UpdateBookmarkRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookmarkRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookmarkRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookmarkRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetProgress(); val < 0 || val > 100 {
+		err := UpdateBookmarkRequestValidationError{
+			field:  "Progress",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRemindAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookmarkRequestValidationError{
+					field:  "RemindAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookmarkRequestValidationError{
+					field:  "RemindAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRemindAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookmarkRequestValidationError{
+				field:  "RemindAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkRequestValidationError{}
+
+// Validate checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkResponseValidationError{}
+
+// Validate checks the field values on SearchBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBookmarksRequestMultiError, or nil if none found.
+func (m *SearchBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 200 {
+		err := SearchBookmarksRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTag()) > 50 {
+		err := SearchBookmarksRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := SearchBookmarksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBookmarksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// SearchBookmarksRequestValidationError is the validation error returned by
+// SearchBookmarksRequest.Validate if the designated constraints aren't met.
+type SearchBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBookmarksRequestValidationError) ErrorName() string {
+	return "SearchBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBookmarksRequestValidationError{}
+
+// Validate checks the field values on SearchBookmarkResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBookmarkResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBookmarkResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBookmarkResultMultiError, or nil if none found.
+func (m *SearchBookmarkResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBookmarkResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchBookmarkResultValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchBookmarkResultValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchBookmarkResultValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return SearchBookmarkResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBookmarkResultMultiError is an error wrapping multiple validation
+// errors returned by SearchBookmarkResult.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBookmarkResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBookmarkResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBookmarkResultMultiError) AllErrors() []error { return m }
+
+// SearchBookmarkResultValidationError is the validation error returned by
+// SearchBookmarkResult.Validate if the designated constraints aren't met.
+type SearchBookmarkResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBookmarkResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBookmarkResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBookmarkResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBookmarkResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBookmarkResultValidationError) ErrorName() string {
+	return "SearchBookmarkResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBookmarkResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBookmarkResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBookmarkResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBookmarkResultValidationError{}
+
+// Validate checks the field values on SearchBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBookmarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBookmarksResponseMultiError, or nil if none found.
+func (m *SearchBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBookmarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// SearchBookmarksResponseValidationError is the validation error returned by
+// SearchBookmarksResponse.Validate if the designated constraints aren't met.
+type SearchBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBookmarksResponseValidationError) ErrorName() string {
+	return "SearchBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBookmarksResponseValidationError{}
+
+// Validate checks the field values on MarkBookmarksReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkBookmarksReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkBookmarksReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkBookmarksReadRequestMultiError, or nil if none found.
+func (m *MarkBookmarksReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkBookmarksReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetArticleIds()); l < 1 || l > 100 {
+		err := MarkBookmarksReadRequestValidationError{
+			field:  "ArticleIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MarkBookmarksReadRequest_ArticleIds_Unique := make(map[int32]struct{}, len(m.GetArticleIds()))
+
+	for idx, item := range m.GetArticleIds() {
+		_, _ = idx, item
+
+		if _, exists := _MarkBookmarksReadRequest_ArticleIds_Unique[item]; exists {
+			err := MarkBookmarksReadRequestValidationError{
+				field:  fmt.Sprintf("ArticleIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MarkBookmarksReadRequest_ArticleIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ArticleIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return MarkBookmarksReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkBookmarksReadRequestMultiError is an error wrapping multiple validation
+// errors returned by MarkBookmarksReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MarkBookmarksReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkBookmarksReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkBookmarksReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkBookmarksReadRequestValidationError is the validation error returned by
+// MarkBookmarksReadRequest.Validate if the designated constraints aren't met.
+type MarkBookmarksReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkBookmarksReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkBookmarksReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkBookmarksReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkBookmarksReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkBookmarksReadRequestValidationError) ErrorName() string {
+	return "MarkBookmarksReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkBookmarksReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkBookmarksReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkBookmarksReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkBookmarksReadRequestValidationError{}
+
+// Validate checks the field values on MarkBookmarksReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkBookmarksReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkBookmarksReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkBookmarksReadResponseMultiError, or nil if none found.
+func (m *MarkBookmarksReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkBookmarksReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdatedCount
+
+	if len(errors) > 0 {
+		return MarkBookmarksReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkBookmarksReadResponseMultiError is an error wrapping multiple validation
+// errors returned by MarkBookmarksReadResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MarkBookmarksReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkBookmarksReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkBookmarksReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkBookmarksReadResponseValidationError is the validation error returned by
+// MarkBookmarksReadResponse.Validate if the designated constraints aren't met.
+type MarkBookmarksReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkBookmarksReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkBookmarksReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkBookmarksReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkBookmarksReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkBookmarksReadResponseValidationError) ErrorName() string {
+	return "MarkBookmarksReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkBookmarksReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkBookmarksReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkBookmarksReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkBookmarksReadResponseValidationError{}
+
+// Validate checks the field values on MarkBookmarksUnreadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkBookmarksUnreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkBookmarksUnreadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkBookmarksUnreadRequestMultiError, or nil if none found.
+func (m *MarkBookmarksUnreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkBookmarksUnreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetArticleIds()); l < 1 || l > 100 {
+		err := MarkBookmarksUnreadRequestValidationError{
+			field:  "ArticleIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MarkBookmarksUnreadRequest_ArticleIds_Unique := make(map[int32]struct{}, len(m.GetArticleIds()))
+
+	for idx, item := range m.GetArticleIds() {
+		_, _ = idx, item
+
+		if _, exists := _MarkBookmarksUnreadRequest_ArticleIds_Unique[item]; exists {
+			err := MarkBookmarksUnreadRequestValidationError{
+				field:  fmt.Sprintf("ArticleIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MarkBookmarksUnreadRequest_ArticleIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ArticleIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return MarkBookmarksUnreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkBookmarksUnreadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkBookmarksUnreadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkBookmarksUnreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkBookmarksUnreadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkBookmarksUnreadRequestMultiError) AllErrors() []error { return m }
+
+// MarkBookmarksUnreadRequestValidationError is the validation error returned
+// by MarkBookmarksUnreadRequest.Validate if the designated constraints aren't met.
+type MarkBookmarksUnreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkBookmarksUnreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkBookmarksUnreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkBookmarksUnreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkBookmarksUnreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkBookmarksUnreadRequestValidationError) ErrorName() string {
+	return "MarkBookmarksUnreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkBookmarksUnreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkBookmarksUnreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkBookmarksUnreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkBookmarksUnreadRequestValidationError{}
+
+// Validate checks the field values on MarkBookmarksUnreadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkBookmarksUnreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkBookmarksUnreadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkBookmarksUnreadResponseMultiError, or nil if none found.
+func (m *MarkBookmarksUnreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkBookmarksUnreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpdatedCount
+
+	if len(errors) > 0 {
+		return MarkBookmarksUnreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkBookmarksUnreadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkBookmarksUnreadResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MarkBookmarksUnreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkBookmarksUnreadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkBookmarksUnreadResponseMultiError) AllErrors() []error { return m }
+
+// MarkBookmarksUnreadResponseValidationError is the validation error returned
+// by MarkBookmarksUnreadResponse.Validate if the designated constraints
+// aren't met.
+type MarkBookmarksUnreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkBookmarksUnreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkBookmarksUnreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkBookmarksUnreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkBookmarksUnreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkBookmarksUnreadResponseValidationError) ErrorName() string {
+	return "MarkBookmarksUnreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkBookmarksUnreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkBookmarksUnreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkBookmarksUnreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkBookmarksUnreadResponseValidationError{}
+
+// Validate checks the field values on BookmarkImportError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookmarkImportError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookmarkImportError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookmarkImportErrorMultiError, or nil if none found.
+func (m *BookmarkImportError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookmarkImportError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ItemIndex
+
+	// no validation rules for Url
+
+	// no validation rules for Title
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return BookmarkImportErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookmarkImportErrorMultiError is an error wrapping multiple validation
+// errors returned by BookmarkImportError.ValidateAll() if the designated
+// constraints aren't met.
+type BookmarkImportErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookmarkImportErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookmarkImportErrorMultiError) AllErrors() []error { return m }
+
+// BookmarkImportErrorValidationError is the validation error returned by
+// BookmarkImportError.Validate if the designated constraints aren't met.
+type BookmarkImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookmarkImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookmarkImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookmarkImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookmarkImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookmarkImportErrorValidationError) ErrorName() string {
+	return "BookmarkImportErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookmarkImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookmarkImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookmarkImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookmarkImportErrorValidationError{}
+
+// Validate checks the field values on BookmarkImport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookmarkImport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookmarkImport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookmarkImportMultiError,
+// or nil if none found.
+func (m *BookmarkImport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookmarkImport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	// no validation rules for TotalCount
+
+	// no validation rules for ProcessedCount
+
+	// no validation rules for CreatedCount
+
+	// no validation rules for ExistingCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for LastError
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookmarkImportValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookmarkImportValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookmarkImportValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkImportValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkImportValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkImportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkImportValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkImportValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookmarkImportMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookmarkImportMultiError is an error wrapping multiple validation errors
+// returned by BookmarkImport.ValidateAll() if the designated constraints
+// aren't met.
+type BookmarkImportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookmarkImportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookmarkImportMultiError) AllErrors() []error { return m }
+
+// BookmarkImportValidationError is the validation error returned by
+// BookmarkImport.Validate if the designated constraints aren't met.
+type BookmarkImportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookmarkImportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookmarkImportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookmarkImportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookmarkImportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookmarkImportValidationError) ErrorName() string { return "BookmarkImportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookmarkImportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookmarkImport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookmarkImportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookmarkImportValidationError{}
+
+// Validate checks the field values on ImportBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBookmarksRequestMultiError, or nil if none found.
+func (m *ImportBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportBookmarksRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportBookmarksRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [netscape pocket raindrop]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetData()) < 1 {
+		err := ImportBookmarksRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetData()) > 10485760 {
+		err := ImportBookmarksRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at most 10485760 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportBookmarksRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBookmarkByUserIDAndArticleIDResponseMultiError []error
+type ImportBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByUserIDAndArticleIDResponseMultiError) Error() string {
+func (m ImportBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1292,12 +3902,11 @@ func (m DeleteBookmarkByUserIDAndArticleIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByUserIDAndArticleIDResponseMultiError) AllErrors() []error { return m }
+func (m ImportBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByUserIDAndArticleIDResponseValidationError is the validation
-// error returned by DeleteBookmarkByUserIDAndArticleIDResponse.Validate if
-// the designated constraints aren't met.
-type DeleteBookmarkByUserIDAndArticleIDResponseValidationError struct {
+// ImportBookmarksRequestValidationError is the validation error returned by
+// ImportBookmarksRequest.Validate if the designated constraints aren't met.
+type ImportBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1305,24 +3914,24 @@ type DeleteBookmarkByUserIDAndArticleIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Field() string { return e.field }
+func (e ImportBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Reason() string { return e.reason }
+func (e ImportBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Cause() error { return e.cause }
+func (e ImportBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Key() bool { return e.key }
+func (e ImportBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) ErrorName() string {
-	return "DeleteBookmarkByUserIDAndArticleIDResponseValidationError"
+func (e ImportBookmarksRequestValidationError) ErrorName() string {
+	return "ImportBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Error() string {
+func (e ImportBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1334,14 +3943,14 @@ func (e DeleteBookmarkByUserIDAndArticleIDResponseValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByUserIDAndArticleIDResponse.%s: %s%s",
+		"invalid %sImportBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByUserIDAndArticleIDResponseValidationError{}
+var _ error = ImportBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1349,46 +3958,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByUserIDAndArticleIDResponseValidationError{}
+} = ImportBookmarksRequestValidationError{}
 
-// Validate checks the field values on DeleteBookmarkByUserIDRequest with the
-// rules defined in the proto definition for this message. If any rules are
+var _ImportBookmarksRequest_Format_InLookup = map[string]struct{}{
+	"netscape": {},
+	"pocket":   {},
+	"raindrop": {},
+}
+
+// Validate checks the field values on ImportBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookmarkByUserIDRequest) Validate() error {
+func (m *ImportBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookmarkByUserIDRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteBookmarkByUserIDRequestMultiError, or nil if none found.
-func (m *DeleteBookmarkByUserIDRequest) ValidateAll() error {
+// ValidateAll checks the field values on ImportBookmarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBookmarksResponseMultiError, or nil if none found.
+func (m *ImportBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByUserIDRequest) validate(all bool) error {
+func (m *ImportBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	if all {
+		switch v := interface{}(m.GetImport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportBookmarksResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportBookmarksResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportBookmarksResponseValidationError{
+				field:  "Import",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteBookmarkByUserIDRequestMultiError(errors)
+		return ImportBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookmarkByUserIDRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteBookmarkByUserIDRequest.ValidateAll()
-// if the designated constraints aren't met.
-type DeleteBookmarkByUserIDRequestMultiError []error
+// ImportBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByUserIDRequestMultiError) Error() string {
+func (m ImportBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1397,12 +4039,11 @@ func (m DeleteBookmarkByUserIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByUserIDRequestMultiError) AllErrors() []error { return m }
+func (m ImportBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByUserIDRequestValidationError is the validation error
-// returned by DeleteBookmarkByUserIDRequest.Validate if the designated
-// constraints aren't met.
-type DeleteBookmarkByUserIDRequestValidationError struct {
+// ImportBookmarksResponseValidationError is the validation error returned by
+// ImportBookmarksResponse.Validate if the designated constraints aren't met.
+type ImportBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1410,24 +4051,24 @@ type DeleteBookmarkByUserIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByUserIDRequestValidationError) Field() string { return e.field }
+func (e ImportBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByUserIDRequestValidationError) Reason() string { return e.reason }
+func (e ImportBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByUserIDRequestValidationError) Cause() error { return e.cause }
+func (e ImportBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByUserIDRequestValidationError) Key() bool { return e.key }
+func (e ImportBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByUserIDRequestValidationError) ErrorName() string {
-	return "DeleteBookmarkByUserIDRequestValidationError"
+func (e ImportBookmarksResponseValidationError) ErrorName() string {
+	return "ImportBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByUserIDRequestValidationError) Error() string {
+func (e ImportBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1439,14 +4080,14 @@ func (e DeleteBookmarkByUserIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByUserIDRequest.%s: %s%s",
+		"invalid %sImportBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByUserIDRequestValidationError{}
+var _ error = ImportBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1454,44 +4095,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByUserIDRequestValidationError{}
+} = ImportBookmarksResponseValidationError{}
 
-// Validate checks the field values on DeleteBookmarkByUserIDResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBookmarkImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookmarkByUserIDResponse) Validate() error {
+func (m *GetBookmarkImportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookmarkByUserIDResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteBookmarkByUserIDResponseMultiError, or nil if none found.
-func (m *DeleteBookmarkByUserIDResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetBookmarkImportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkImportRequestMultiError, or nil if none found.
+func (m *GetBookmarkImportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByUserIDResponse) validate(all bool) error {
+func (m *GetBookmarkImportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetId() <= 0 {
+		err := GetBookmarkImportRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteBookmarkByUserIDResponseMultiError(errors)
+		return GetBookmarkImportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookmarkByUserIDResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteBookmarkByUserIDResponse.ValidateAll()
-// if the designated constraints aren't met.
-type DeleteBookmarkByUserIDResponseMultiError []error
+// GetBookmarkImportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkImportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkImportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByUserIDResponseMultiError) Error() string {
+func (m GetBookmarkImportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1500,12 +4152,11 @@ func (m DeleteBookmarkByUserIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByUserIDResponseMultiError) AllErrors() []error { return m }
+func (m GetBookmarkImportRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByUserIDResponseValidationError is the validation error
-// returned by DeleteBookmarkByUserIDResponse.Validate if the designated
-// constraints aren't met.
-type DeleteBookmarkByUserIDResponseValidationError struct {
+// GetBookmarkImportRequestValidationError is the validation error returned by
+// GetBookmarkImportRequest.Validate if the designated constraints aren't met.
+type GetBookmarkImportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1513,24 +4164,24 @@ type DeleteBookmarkByUserIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByUserIDResponseValidationError) Field() string { return e.field }
+func (e GetBookmarkImportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByUserIDResponseValidationError) Reason() string { return e.reason }
+func (e GetBookmarkImportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByUserIDResponseValidationError) Cause() error { return e.cause }
+func (e GetBookmarkImportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByUserIDResponseValidationError) Key() bool { return e.key }
+func (e GetBookmarkImportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByUserIDResponseValidationError) ErrorName() string {
-	return "DeleteBookmarkByUserIDResponseValidationError"
+func (e GetBookmarkImportRequestValidationError) ErrorName() string {
+	return "GetBookmarkImportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByUserIDResponseValidationError) Error() string {
+func (e GetBookmarkImportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1542,14 +4193,14 @@ func (e DeleteBookmarkByUserIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByUserIDResponse.%s: %s%s",
+		"invalid %sGetBookmarkImportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByUserIDResponseValidationError{}
+var _ error = GetBookmarkImportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1557,48 +4208,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByUserIDResponseValidationError{}
+} = GetBookmarkImportRequestValidationError{}
 
-// Validate checks the field values on DeleteBookmarkByArticleIDRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *DeleteBookmarkByArticleIDRequest) Validate() error {
+// Validate checks the field values on GetBookmarkImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkImportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookmarkByArticleIDRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteBookmarkByArticleIDRequestMultiError, or nil if none found.
-func (m *DeleteBookmarkByArticleIDRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetBookmarkImportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkImportResponseMultiError, or nil if none found.
+func (m *GetBookmarkImportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByArticleIDRequest) validate(all bool) error {
+func (m *GetBookmarkImportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ArticleId
+	if all {
+		switch v := interface{}(m.GetImport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookmarkImportResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookmarkImportResponseValidationError{
+					field:  "Import",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookmarkImportResponseValidationError{
+				field:  "Import",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteBookmarkByArticleIDRequestMultiError(errors)
+		return GetBookmarkImportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookmarkByArticleIDRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// DeleteBookmarkByArticleIDRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBookmarkByArticleIDRequestMultiError []error
+// GetBookmarkImportResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkImportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetBookmarkImportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByArticleIDRequestMultiError) Error() string {
+func (m GetBookmarkImportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1607,12 +4283,11 @@ func (m DeleteBookmarkByArticleIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByArticleIDRequestMultiError) AllErrors() []error { return m }
+func (m GetBookmarkImportResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByArticleIDRequestValidationError is the validation error
-// returned by DeleteBookmarkByArticleIDRequest.Validate if the designated
-// constraints aren't met.
-type DeleteBookmarkByArticleIDRequestValidationError struct {
+// GetBookmarkImportResponseValidationError is the validation error returned by
+// GetBookmarkImportResponse.Validate if the designated constraints aren't met.
+type GetBookmarkImportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1620,24 +4295,24 @@ type DeleteBookmarkByArticleIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByArticleIDRequestValidationError) Field() string { return e.field }
+func (e GetBookmarkImportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByArticleIDRequestValidationError) Reason() string { return e.reason }
+func (e GetBookmarkImportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByArticleIDRequestValidationError) Cause() error { return e.cause }
+func (e GetBookmarkImportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByArticleIDRequestValidationError) Key() bool { return e.key }
+func (e GetBookmarkImportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByArticleIDRequestValidationError) ErrorName() string {
-	return "DeleteBookmarkByArticleIDRequestValidationError"
+func (e GetBookmarkImportResponseValidationError) ErrorName() string {
+	return "GetBookmarkImportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByArticleIDRequestValidationError) Error() string {
+func (e GetBookmarkImportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1649,14 +4324,14 @@ func (e DeleteBookmarkByArticleIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByArticleIDRequest.%s: %s%s",
+		"invalid %sGetBookmarkImportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByArticleIDRequestValidationError{}
+var _ error = GetBookmarkImportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1664,46 +4339,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByArticleIDRequestValidationError{}
+} = GetBookmarkImportResponseValidationError{}
 
-// Validate checks the field values on DeleteBookmarkByArticleIDResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *DeleteBookmarkByArticleIDResponse) Validate() error {
+// Validate checks the field values on ExportBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookmarkByArticleIDResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// DeleteBookmarkByArticleIDResponseMultiError, or nil if none found.
-func (m *DeleteBookmarkByArticleIDResponse) ValidateAll() error {
+// ValidateAll checks the field values on ExportBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBookmarksRequestMultiError, or nil if none found.
+func (m *ExportBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookmarkByArticleIDResponse) validate(all bool) error {
+func (m *ExportBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if _, ok := _ExportBookmarksRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportBookmarksRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ netscape csv markdown]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteBookmarkByArticleIDResponseMultiError(errors)
+		return ExportBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookmarkByArticleIDResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// DeleteBookmarkByArticleIDResponse.ValidateAll() if the designated
+// ExportBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportBookmarksRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBookmarkByArticleIDResponseMultiError []error
+type ExportBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookmarkByArticleIDResponseMultiError) Error() string {
+func (m ExportBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1712,12 +4396,11 @@ func (m DeleteBookmarkByArticleIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookmarkByArticleIDResponseMultiError) AllErrors() []error { return m }
+func (m ExportBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookmarkByArticleIDResponseValidationError is the validation error
-// returned by DeleteBookmarkByArticleIDResponse.Validate if the designated
-// constraints aren't met.
-type DeleteBookmarkByArticleIDResponseValidationError struct {
+// ExportBookmarksRequestValidationError is the validation error returned by
+// ExportBookmarksRequest.Validate if the designated constraints aren't met.
+type ExportBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1725,24 +4408,24 @@ type DeleteBookmarkByArticleIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookmarkByArticleIDResponseValidationError) Field() string { return e.field }
+func (e ExportBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookmarkByArticleIDResponseValidationError) Reason() string { return e.reason }
+func (e ExportBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookmarkByArticleIDResponseValidationError) Cause() error { return e.cause }
+func (e ExportBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookmarkByArticleIDResponseValidationError) Key() bool { return e.key }
+func (e ExportBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookmarkByArticleIDResponseValidationError) ErrorName() string {
-	return "DeleteBookmarkByArticleIDResponseValidationError"
+func (e ExportBookmarksRequestValidationError) ErrorName() string {
+	return "ExportBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookmarkByArticleIDResponseValidationError) Error() string {
+func (e ExportBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1754,14 +4437,14 @@ func (e DeleteBookmarkByArticleIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookmarkByArticleIDResponse.%s: %s%s",
+		"invalid %sExportBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookmarkByArticleIDResponseValidationError{}
+var _ error = ExportBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1769,4 +4452,11 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookmarkByArticleIDResponseValidationError{}
+} = ExportBookmarksRequestValidationError{}
+
+var _ExportBookmarksRequest_Format_InLookup = map[string]struct{}{
+	"":         {},
+	"netscape": {},
+	"csv":      {},
+	"markdown": {},
+}
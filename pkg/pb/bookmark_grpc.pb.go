@@ -8,6 +8,7 @@ package pb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -22,10 +23,18 @@ const (
 	BookmarkService_CreateBookmark_FullMethodName                     = "/proto.BookmarkService/CreateBookmark"
 	BookmarkService_GetBookmarkCountByArticleID_FullMethodName        = "/proto.BookmarkService/GetBookmarkCountByArticleID"
 	BookmarkService_ListBookmarksByUserID_FullMethodName              = "/proto.BookmarkService/ListBookmarksByUserID"
+	BookmarkService_ListWorkspaceBookmarks_FullMethodName             = "/proto.BookmarkService/ListWorkspaceBookmarks"
 	BookmarkService_ListBookmarksByArticleID_FullMethodName           = "/proto.BookmarkService/ListBookmarksByArticleID"
 	BookmarkService_DeleteBookmarkByUserIDAndArticleID_FullMethodName = "/proto.BookmarkService/DeleteBookmarkByUserIDAndArticleID"
 	BookmarkService_DeleteBookmarkByUserID_FullMethodName             = "/proto.BookmarkService/DeleteBookmarkByUserID"
 	BookmarkService_DeleteBookmarkByArticleID_FullMethodName          = "/proto.BookmarkService/DeleteBookmarkByArticleID"
+	BookmarkService_UpdateBookmark_FullMethodName                     = "/proto.BookmarkService/UpdateBookmark"
+	BookmarkService_SearchBookmarks_FullMethodName                    = "/proto.BookmarkService/SearchBookmarks"
+	BookmarkService_MarkBookmarksRead_FullMethodName                  = "/proto.BookmarkService/MarkBookmarksRead"
+	BookmarkService_MarkBookmarksUnread_FullMethodName                = "/proto.BookmarkService/MarkBookmarksUnread"
+	BookmarkService_ImportBookmarks_FullMethodName                    = "/proto.BookmarkService/ImportBookmarks"
+	BookmarkService_GetBookmarkImport_FullMethodName                  = "/proto.BookmarkService/GetBookmarkImport"
+	BookmarkService_ExportBookmarks_FullMethodName                    = "/proto.BookmarkService/ExportBookmarks"
 )
 
 // BookmarkServiceClient is the client API for BookmarkService service.
@@ -35,10 +44,18 @@ type BookmarkServiceClient interface {
 	CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*CreateBookmarkResponse, error)
 	GetBookmarkCountByArticleID(ctx context.Context, in *GetBookmarkCountByArticleIDRequest, opts ...grpc.CallOption) (*GetBookmarkCountByArticleIDResponse, error)
 	ListBookmarksByUserID(ctx context.Context, in *ListBookmarksByUserIDRequest, opts ...grpc.CallOption) (*ListBookmarksByUserIDResponse, error)
+	ListWorkspaceBookmarks(ctx context.Context, in *ListWorkspaceBookmarksRequest, opts ...grpc.CallOption) (*ListWorkspaceBookmarksResponse, error)
 	ListBookmarksByArticleID(ctx context.Context, in *ListBookmarksByArticleIDRequest, opts ...grpc.CallOption) (*ListBookmarksByArticleIDResponse, error)
 	DeleteBookmarkByUserIDAndArticleID(ctx context.Context, in *DeleteBookmarkByUserIDAndArticleIDRequest, opts ...grpc.CallOption) (*DeleteBookmarkByUserIDAndArticleIDResponse, error)
 	DeleteBookmarkByUserID(ctx context.Context, in *DeleteBookmarkByUserIDRequest, opts ...grpc.CallOption) (*DeleteBookmarkByUserIDResponse, error)
 	DeleteBookmarkByArticleID(ctx context.Context, in *DeleteBookmarkByArticleIDRequest, opts ...grpc.CallOption) (*DeleteBookmarkByArticleIDResponse, error)
+	UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*UpdateBookmarkResponse, error)
+	SearchBookmarks(ctx context.Context, in *SearchBookmarksRequest, opts ...grpc.CallOption) (*SearchBookmarksResponse, error)
+	MarkBookmarksRead(ctx context.Context, in *MarkBookmarksReadRequest, opts ...grpc.CallOption) (*MarkBookmarksReadResponse, error)
+	MarkBookmarksUnread(ctx context.Context, in *MarkBookmarksUnreadRequest, opts ...grpc.CallOption) (*MarkBookmarksUnreadResponse, error)
+	ImportBookmarks(ctx context.Context, in *ImportBookmarksRequest, opts ...grpc.CallOption) (*ImportBookmarksResponse, error)
+	GetBookmarkImport(ctx context.Context, in *GetBookmarkImportRequest, opts ...grpc.CallOption) (*GetBookmarkImportResponse, error)
+	ExportBookmarks(ctx context.Context, in *ExportBookmarksRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 }
 
 type bookmarkServiceClient struct {
@@ -76,6 +93,15 @@ func (c *bookmarkServiceClient) ListBookmarksByUserID(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *bookmarkServiceClient) ListWorkspaceBookmarks(ctx context.Context, in *ListWorkspaceBookmarksRequest, opts ...grpc.CallOption) (*ListWorkspaceBookmarksResponse, error) {
+	out := new(ListWorkspaceBookmarksResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_ListWorkspaceBookmarks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookmarkServiceClient) ListBookmarksByArticleID(ctx context.Context, in *ListBookmarksByArticleIDRequest, opts ...grpc.CallOption) (*ListBookmarksByArticleIDResponse, error) {
 	out := new(ListBookmarksByArticleIDResponse)
 	err := c.cc.Invoke(ctx, BookmarkService_ListBookmarksByArticleID_FullMethodName, in, out, opts...)
@@ -112,6 +138,69 @@ func (c *bookmarkServiceClient) DeleteBookmarkByArticleID(ctx context.Context, i
 	return out, nil
 }
 
+func (c *bookmarkServiceClient) UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*UpdateBookmarkResponse, error) {
+	out := new(UpdateBookmarkResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_UpdateBookmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) SearchBookmarks(ctx context.Context, in *SearchBookmarksRequest, opts ...grpc.CallOption) (*SearchBookmarksResponse, error) {
+	out := new(SearchBookmarksResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_SearchBookmarks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) MarkBookmarksRead(ctx context.Context, in *MarkBookmarksReadRequest, opts ...grpc.CallOption) (*MarkBookmarksReadResponse, error) {
+	out := new(MarkBookmarksReadResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_MarkBookmarksRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) MarkBookmarksUnread(ctx context.Context, in *MarkBookmarksUnreadRequest, opts ...grpc.CallOption) (*MarkBookmarksUnreadResponse, error) {
+	out := new(MarkBookmarksUnreadResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_MarkBookmarksUnread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) ImportBookmarks(ctx context.Context, in *ImportBookmarksRequest, opts ...grpc.CallOption) (*ImportBookmarksResponse, error) {
+	out := new(ImportBookmarksResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_ImportBookmarks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) GetBookmarkImport(ctx context.Context, in *GetBookmarkImportRequest, opts ...grpc.CallOption) (*GetBookmarkImportResponse, error) {
+	out := new(GetBookmarkImportResponse)
+	err := c.cc.Invoke(ctx, BookmarkService_GetBookmarkImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) ExportBookmarks(ctx context.Context, in *ExportBookmarksRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, BookmarkService_ExportBookmarks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookmarkServiceServer is the server API for BookmarkService service.
 // All implementations must embed UnimplementedBookmarkServiceServer
 // for forward compatibility
@@ -119,10 +208,18 @@ type BookmarkServiceServer interface {
 	CreateBookmark(context.Context, *CreateBookmarkRequest) (*CreateBookmarkResponse, error)
 	GetBookmarkCountByArticleID(context.Context, *GetBookmarkCountByArticleIDRequest) (*GetBookmarkCountByArticleIDResponse, error)
 	ListBookmarksByUserID(context.Context, *ListBookmarksByUserIDRequest) (*ListBookmarksByUserIDResponse, error)
+	ListWorkspaceBookmarks(context.Context, *ListWorkspaceBookmarksRequest) (*ListWorkspaceBookmarksResponse, error)
 	ListBookmarksByArticleID(context.Context, *ListBookmarksByArticleIDRequest) (*ListBookmarksByArticleIDResponse, error)
 	DeleteBookmarkByUserIDAndArticleID(context.Context, *DeleteBookmarkByUserIDAndArticleIDRequest) (*DeleteBookmarkByUserIDAndArticleIDResponse, error)
 	DeleteBookmarkByUserID(context.Context, *DeleteBookmarkByUserIDRequest) (*DeleteBookmarkByUserIDResponse, error)
 	DeleteBookmarkByArticleID(context.Context, *DeleteBookmarkByArticleIDRequest) (*DeleteBookmarkByArticleIDResponse, error)
+	UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*UpdateBookmarkResponse, error)
+	SearchBookmarks(context.Context, *SearchBookmarksRequest) (*SearchBookmarksResponse, error)
+	MarkBookmarksRead(context.Context, *MarkBookmarksReadRequest) (*MarkBookmarksReadResponse, error)
+	MarkBookmarksUnread(context.Context, *MarkBookmarksUnreadRequest) (*MarkBookmarksUnreadResponse, error)
+	ImportBookmarks(context.Context, *ImportBookmarksRequest) (*ImportBookmarksResponse, error)
+	GetBookmarkImport(context.Context, *GetBookmarkImportRequest) (*GetBookmarkImportResponse, error)
+	ExportBookmarks(context.Context, *ExportBookmarksRequest) (*httpbody.HttpBody, error)
 	mustEmbedUnimplementedBookmarkServiceServer()
 }
 
@@ -139,6 +236,9 @@ func (UnimplementedBookmarkServiceServer) GetBookmarkCountByArticleID(context.Co
 func (UnimplementedBookmarkServiceServer) ListBookmarksByUserID(context.Context, *ListBookmarksByUserIDRequest) (*ListBookmarksByUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarksByUserID not implemented")
 }
+func (UnimplementedBookmarkServiceServer) ListWorkspaceBookmarks(context.Context, *ListWorkspaceBookmarksRequest) (*ListWorkspaceBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceBookmarks not implemented")
+}
 func (UnimplementedBookmarkServiceServer) ListBookmarksByArticleID(context.Context, *ListBookmarksByArticleIDRequest) (*ListBookmarksByArticleIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarksByArticleID not implemented")
 }
@@ -151,6 +251,27 @@ func (UnimplementedBookmarkServiceServer) DeleteBookmarkByUserID(context.Context
 func (UnimplementedBookmarkServiceServer) DeleteBookmarkByArticleID(context.Context, *DeleteBookmarkByArticleIDRequest) (*DeleteBookmarkByArticleIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmarkByArticleID not implemented")
 }
+func (UnimplementedBookmarkServiceServer) UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*UpdateBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmark not implemented")
+}
+func (UnimplementedBookmarkServiceServer) SearchBookmarks(context.Context, *SearchBookmarksRequest) (*SearchBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBookmarks not implemented")
+}
+func (UnimplementedBookmarkServiceServer) MarkBookmarksRead(context.Context, *MarkBookmarksReadRequest) (*MarkBookmarksReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkBookmarksRead not implemented")
+}
+func (UnimplementedBookmarkServiceServer) MarkBookmarksUnread(context.Context, *MarkBookmarksUnreadRequest) (*MarkBookmarksUnreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkBookmarksUnread not implemented")
+}
+func (UnimplementedBookmarkServiceServer) ImportBookmarks(context.Context, *ImportBookmarksRequest) (*ImportBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBookmarks not implemented")
+}
+func (UnimplementedBookmarkServiceServer) GetBookmarkImport(context.Context, *GetBookmarkImportRequest) (*GetBookmarkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkImport not implemented")
+}
+func (UnimplementedBookmarkServiceServer) ExportBookmarks(context.Context, *ExportBookmarksRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBookmarks not implemented")
+}
 func (UnimplementedBookmarkServiceServer) mustEmbedUnimplementedBookmarkServiceServer() {}
 
 // UnsafeBookmarkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +339,24 @@ func _BookmarkService_ListBookmarksByUserID_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookmarkService_ListWorkspaceBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).ListWorkspaceBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_ListWorkspaceBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).ListWorkspaceBookmarks(ctx, req.(*ListWorkspaceBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookmarkService_ListBookmarksByArticleID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBookmarksByArticleIDRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +429,132 @@ func _BookmarkService_DeleteBookmarkByArticleID_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookmarkService_UpdateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).UpdateBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_UpdateBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).UpdateBookmark(ctx, req.(*UpdateBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_SearchBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).SearchBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_SearchBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).SearchBookmarks(ctx, req.(*SearchBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_MarkBookmarksRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkBookmarksReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).MarkBookmarksRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_MarkBookmarksRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).MarkBookmarksRead(ctx, req.(*MarkBookmarksReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_MarkBookmarksUnread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkBookmarksUnreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).MarkBookmarksUnread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_MarkBookmarksUnread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).MarkBookmarksUnread(ctx, req.(*MarkBookmarksUnreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_ImportBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).ImportBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_ImportBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).ImportBookmarks(ctx, req.(*ImportBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_GetBookmarkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).GetBookmarkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_GetBookmarkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).GetBookmarkImport(ctx, req.(*GetBookmarkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_ExportBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).ExportBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookmarkService_ExportBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).ExportBookmarks(ctx, req.(*ExportBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookmarkService_ServiceDesc is the grpc.ServiceDesc for BookmarkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,6 +574,10 @@ var BookmarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBookmarksByUserID",
 			Handler:    _BookmarkService_ListBookmarksByUserID_Handler,
 		},
+		{
+			MethodName: "ListWorkspaceBookmarks",
+			Handler:    _BookmarkService_ListWorkspaceBookmarks_Handler,
+		},
 		{
 			MethodName: "ListBookmarksByArticleID",
 			Handler:    _BookmarkService_ListBookmarksByArticleID_Handler,
@@ -325,6 +594,34 @@ var BookmarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBookmarkByArticleID",
 			Handler:    _BookmarkService_DeleteBookmarkByArticleID_Handler,
 		},
+		{
+			MethodName: "UpdateBookmark",
+			Handler:    _BookmarkService_UpdateBookmark_Handler,
+		},
+		{
+			MethodName: "SearchBookmarks",
+			Handler:    _BookmarkService_SearchBookmarks_Handler,
+		},
+		{
+			MethodName: "MarkBookmarksRead",
+			Handler:    _BookmarkService_MarkBookmarksRead_Handler,
+		},
+		{
+			MethodName: "MarkBookmarksUnread",
+			Handler:    _BookmarkService_MarkBookmarksUnread_Handler,
+		},
+		{
+			MethodName: "ImportBookmarks",
+			Handler:    _BookmarkService_ImportBookmarks_Handler,
+		},
+		{
+			MethodName: "GetBookmarkImport",
+			Handler:    _BookmarkService_GetBookmarkImport_Handler,
+		},
+		{
+			MethodName: "ExportBookmarks",
+			Handler:    _BookmarkService_ExportBookmarks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bookmark.proto",
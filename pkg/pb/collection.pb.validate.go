@@ -0,0 +1,2859 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: collection.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Position
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Visibility
+
+	// no validation rules for ShareToken
+
+	// no validation rules for WorkspaceId
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
+
+// Validate checks the field values on CreateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionRequestMultiError, or nil if none found.
+func (m *CreateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateCollectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := CreateCollectionRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWorkspaceId() < 0 {
+		err := CreateCollectionRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CreateCollectionRequestValidationError is the validation error returned by
+// CreateCollectionRequest.Validate if the designated constraints aren't met.
+type CreateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionRequestValidationError) ErrorName() string {
+	return "CreateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionRequestValidationError{}
+
+// Validate checks the field values on CreateCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionResponseMultiError, or nil if none found.
+func (m *CreateCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionResponseMultiError) AllErrors() []error { return m }
+
+// CreateCollectionResponseValidationError is the validation error returned by
+// CreateCollectionResponse.Validate if the designated constraints aren't met.
+type CreateCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionResponseValidationError) ErrorName() string {
+	return "CreateCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionResponseValidationError{}
+
+// Validate checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionRequestMultiError, or nil if none found.
+func (m *GetCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionRequestMultiError) AllErrors() []error { return m }
+
+// GetCollectionRequestValidationError is the validation error returned by
+// GetCollectionRequest.Validate if the designated constraints aren't met.
+type GetCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionRequestValidationError) ErrorName() string {
+	return "GetCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionRequestValidationError{}
+
+// Validate checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionResponseMultiError, or nil if none found.
+func (m *GetCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionResponseMultiError) AllErrors() []error { return m }
+
+// GetCollectionResponseValidationError is the validation error returned by
+// GetCollectionResponse.Validate if the designated constraints aren't met.
+type GetCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionResponseValidationError) ErrorName() string {
+	return "GetCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionResponseValidationError{}
+
+// Validate checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsRequestMultiError, or nil if none found.
+func (m *ListCollectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListCollectionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetWorkspaceId() < 0 {
+		err := ListCollectionsRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsRequestMultiError) AllErrors() []error { return m }
+
+// ListCollectionsRequestValidationError is the validation error returned by
+// ListCollectionsRequest.Validate if the designated constraints aren't met.
+type ListCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsRequestValidationError) ErrorName() string {
+	return "ListCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsRequestValidationError{}
+
+// Validate checks the field values on ListCollectionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsResponseMultiError, or nil if none found.
+func (m *ListCollectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCollectionsResponseValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCollectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsResponseMultiError) AllErrors() []error { return m }
+
+// ListCollectionsResponseValidationError is the validation error returned by
+// ListCollectionsResponse.Validate if the designated constraints aren't met.
+type ListCollectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsResponseValidationError) ErrorName() string {
+	return "ListCollectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsResponseValidationError{}
+
+// Validate checks the field values on UpdateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionRequestMultiError, or nil if none found.
+func (m *UpdateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateCollectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := UpdateCollectionRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionRequestValidationError is the validation error returned by
+// UpdateCollectionRequest.Validate if the designated constraints aren't met.
+type UpdateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionRequestValidationError) ErrorName() string {
+	return "UpdateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionRequestValidationError{}
+
+// Validate checks the field values on UpdateCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionResponseMultiError, or nil if none found.
+func (m *UpdateCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionResponseValidationError is the validation error returned by
+// UpdateCollectionResponse.Validate if the designated constraints aren't met.
+type UpdateCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionResponseValidationError) ErrorName() string {
+	return "UpdateCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionResponseValidationError{}
+
+// Validate checks the field values on DeleteCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionRequestMultiError, or nil if none found.
+func (m *DeleteCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionRequestValidationError is the validation error returned by
+// DeleteCollectionRequest.Validate if the designated constraints aren't met.
+type DeleteCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionRequestValidationError) ErrorName() string {
+	return "DeleteCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionRequestValidationError{}
+
+// Validate checks the field values on DeleteCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionResponseMultiError, or nil if none found.
+func (m *DeleteCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionResponseValidationError is the validation error returned by
+// DeleteCollectionResponse.Validate if the designated constraints aren't met.
+type DeleteCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionResponseValidationError) ErrorName() string {
+	return "DeleteCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionResponseValidationError{}
+
+// Validate checks the field values on ReorderCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCollectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCollectionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderCollectionsRequestMultiError, or nil if none found.
+func (m *ReorderCollectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCollectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetCollectionIds()); l < 1 || l > 1000 {
+		err := ReorderCollectionsRequestValidationError{
+			field:  "CollectionIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ReorderCollectionsRequest_CollectionIds_Unique := make(map[int32]struct{}, len(m.GetCollectionIds()))
+
+	for idx, item := range m.GetCollectionIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReorderCollectionsRequest_CollectionIds_Unique[item]; exists {
+			err := ReorderCollectionsRequestValidationError{
+				field:  fmt.Sprintf("CollectionIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ReorderCollectionsRequest_CollectionIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for CollectionIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return ReorderCollectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderCollectionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReorderCollectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCollectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCollectionsRequestMultiError) AllErrors() []error { return m }
+
+// ReorderCollectionsRequestValidationError is the validation error returned by
+// ReorderCollectionsRequest.Validate if the designated constraints aren't met.
+type ReorderCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCollectionsRequestValidationError) ErrorName() string {
+	return "ReorderCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCollectionsRequestValidationError{}
+
+// Validate checks the field values on ReorderCollectionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCollectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCollectionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderCollectionsResponseMultiError, or nil if none found.
+func (m *ReorderCollectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCollectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReorderCollectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCollectionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ReorderCollectionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReorderCollectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCollectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCollectionsResponseMultiError) AllErrors() []error { return m }
+
+// ReorderCollectionsResponseValidationError is the validation error returned
+// by ReorderCollectionsResponse.Validate if the designated constraints aren't met.
+type ReorderCollectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCollectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCollectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCollectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCollectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCollectionsResponseValidationError) ErrorName() string {
+	return "ReorderCollectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCollectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCollectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCollectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCollectionsResponseValidationError{}
+
+// Validate checks the field values on ReorderCollectionBookmarksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReorderCollectionBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCollectionBookmarksRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReorderCollectionBookmarksRequestMultiError, or nil if none found.
+func (m *ReorderCollectionBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCollectionBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CollectionId
+
+	if l := len(m.GetBookmarkIds()); l < 1 || l > 1000 {
+		err := ReorderCollectionBookmarksRequestValidationError{
+			field:  "BookmarkIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ReorderCollectionBookmarksRequest_BookmarkIds_Unique := make(map[int32]struct{}, len(m.GetBookmarkIds()))
+
+	for idx, item := range m.GetBookmarkIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReorderCollectionBookmarksRequest_BookmarkIds_Unique[item]; exists {
+			err := ReorderCollectionBookmarksRequestValidationError{
+				field:  fmt.Sprintf("BookmarkIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ReorderCollectionBookmarksRequest_BookmarkIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for BookmarkIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return ReorderCollectionBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCollectionBookmarksRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ReorderCollectionBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderCollectionBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCollectionBookmarksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCollectionBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// ReorderCollectionBookmarksRequestValidationError is the validation error
+// returned by ReorderCollectionBookmarksRequest.Validate if the designated
+// constraints aren't met.
+type ReorderCollectionBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCollectionBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCollectionBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCollectionBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCollectionBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCollectionBookmarksRequestValidationError) ErrorName() string {
+	return "ReorderCollectionBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCollectionBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCollectionBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCollectionBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCollectionBookmarksRequestValidationError{}
+
+// Validate checks the field values on ReorderCollectionBookmarksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReorderCollectionBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCollectionBookmarksResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReorderCollectionBookmarksResponseMultiError, or nil if none found.
+func (m *ReorderCollectionBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCollectionBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReorderCollectionBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCollectionBookmarksResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ReorderCollectionBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderCollectionBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCollectionBookmarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCollectionBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// ReorderCollectionBookmarksResponseValidationError is the validation error
+// returned by ReorderCollectionBookmarksResponse.Validate if the designated
+// constraints aren't met.
+type ReorderCollectionBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCollectionBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCollectionBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCollectionBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCollectionBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCollectionBookmarksResponseValidationError) ErrorName() string {
+	return "ReorderCollectionBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCollectionBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCollectionBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCollectionBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCollectionBookmarksResponseValidationError{}
+
+// Validate checks the field values on MoveBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveBookmarksRequestMultiError, or nil if none found.
+func (m *MoveBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetBookmarkIds()); l < 1 || l > 100 {
+		err := MoveBookmarksRequestValidationError{
+			field:  "BookmarkIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MoveBookmarksRequest_BookmarkIds_Unique := make(map[int32]struct{}, len(m.GetBookmarkIds()))
+
+	for idx, item := range m.GetBookmarkIds() {
+		_, _ = idx, item
+
+		if _, exists := _MoveBookmarksRequest_BookmarkIds_Unique[item]; exists {
+			err := MoveBookmarksRequestValidationError{
+				field:  fmt.Sprintf("BookmarkIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MoveBookmarksRequest_BookmarkIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for BookmarkIds[idx]
+	}
+
+	if m.GetCollectionId() < 0 {
+		err := MoveBookmarksRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by MoveBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MoveBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveBookmarksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// MoveBookmarksRequestValidationError is the validation error returned by
+// MoveBookmarksRequest.Validate if the designated constraints aren't met.
+type MoveBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveBookmarksRequestValidationError) ErrorName() string {
+	return "MoveBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveBookmarksRequestValidationError{}
+
+// Validate checks the field values on MoveBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveBookmarksResponseMultiError, or nil if none found.
+func (m *MoveBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MoveBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by MoveBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MoveBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveBookmarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// MoveBookmarksResponseValidationError is the validation error returned by
+// MoveBookmarksResponse.Validate if the designated constraints aren't met.
+type MoveBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveBookmarksResponseValidationError) ErrorName() string {
+	return "MoveBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveBookmarksResponseValidationError{}
+
+// Validate checks the field values on UpdateCollectionVisibilityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateCollectionVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionVisibilityRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateCollectionVisibilityRequestMultiError, or nil if none found.
+func (m *UpdateCollectionVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if _, ok := _UpdateCollectionVisibilityRequest_Visibility_InLookup[m.GetVisibility()]; !ok {
+		err := UpdateCollectionVisibilityRequestValidationError{
+			field:  "Visibility",
+			reason: "value must be in list [private unlisted public]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionVisibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionVisibilityRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateCollectionVisibilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionVisibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionVisibilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionVisibilityRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionVisibilityRequestValidationError is the validation error
+// returned by UpdateCollectionVisibilityRequest.Validate if the designated
+// constraints aren't met.
+type UpdateCollectionVisibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionVisibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionVisibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionVisibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionVisibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionVisibilityRequestValidationError) ErrorName() string {
+	return "UpdateCollectionVisibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionVisibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionVisibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionVisibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionVisibilityRequestValidationError{}
+
+var _UpdateCollectionVisibilityRequest_Visibility_InLookup = map[string]struct{}{
+	"private":  {},
+	"unlisted": {},
+	"public":   {},
+}
+
+// Validate checks the field values on UpdateCollectionVisibilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateCollectionVisibilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionVisibilityResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateCollectionVisibilityResponseMultiError, or nil if none found.
+func (m *UpdateCollectionVisibilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionVisibilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCollectionVisibilityResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCollectionVisibilityResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCollectionVisibilityResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionVisibilityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionVisibilityResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateCollectionVisibilityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionVisibilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionVisibilityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionVisibilityResponseMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionVisibilityResponseValidationError is the validation error
+// returned by UpdateCollectionVisibilityResponse.Validate if the designated
+// constraints aren't met.
+type UpdateCollectionVisibilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionVisibilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionVisibilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionVisibilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionVisibilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionVisibilityResponseValidationError) ErrorName() string {
+	return "UpdateCollectionVisibilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionVisibilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionVisibilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionVisibilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionVisibilityResponseValidationError{}
+
+// Validate checks the field values on SharedCollectionItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SharedCollectionItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharedCollectionItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SharedCollectionItemMultiError, or nil if none found.
+func (m *SharedCollectionItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharedCollectionItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	// no validation rules for Title
+
+	// no validation rules for Url
+
+	// no validation rules for Image
+
+	// no validation rules for Description
+
+	// no validation rules for Site
+
+	if all {
+		switch v := interface{}(m.GetAddedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SharedCollectionItemValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SharedCollectionItemValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SharedCollectionItemValidationError{
+				field:  "AddedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SharedCollectionItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharedCollectionItemMultiError is an error wrapping multiple validation
+// errors returned by SharedCollectionItem.ValidateAll() if the designated
+// constraints aren't met.
+type SharedCollectionItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharedCollectionItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharedCollectionItemMultiError) AllErrors() []error { return m }
+
+// SharedCollectionItemValidationError is the validation error returned by
+// SharedCollectionItem.Validate if the designated constraints aren't met.
+type SharedCollectionItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharedCollectionItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharedCollectionItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharedCollectionItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharedCollectionItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharedCollectionItemValidationError) ErrorName() string {
+	return "SharedCollectionItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SharedCollectionItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharedCollectionItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharedCollectionItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharedCollectionItemValidationError{}
+
+// Validate checks the field values on GetSharedCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedCollectionRequestMultiError, or nil if none found.
+func (m *GetSharedCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetShareToken()); l < 1 || l > 100 {
+		err := GetSharedCollectionRequestValidationError{
+			field:  "ShareToken",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() < 0 {
+		err := GetSharedCollectionRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetSharedCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharedCollectionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSharedCollectionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSharedCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharedCollectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharedCollectionRequestMultiError) AllErrors() []error { return m }
+
+// GetSharedCollectionRequestValidationError is the validation error returned
+// by GetSharedCollectionRequest.Validate if the designated constraints aren't met.
+type GetSharedCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharedCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharedCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharedCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharedCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharedCollectionRequestValidationError) ErrorName() string {
+	return "GetSharedCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharedCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharedCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharedCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharedCollectionRequestValidationError{}
+
+// Validate checks the field values on GetSharedCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedCollectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedCollectionResponseMultiError, or nil if none found.
+func (m *GetSharedCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSharedCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSharedCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSharedCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharedCollectionResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharedCollectionResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharedCollectionResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetSharedCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSharedCollectionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSharedCollectionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSharedCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSharedCollectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSharedCollectionResponseMultiError) AllErrors() []error { return m }
+
+// GetSharedCollectionResponseValidationError is the validation error returned
+// by GetSharedCollectionResponse.Validate if the designated constraints
+// aren't met.
+type GetSharedCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSharedCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSharedCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSharedCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSharedCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSharedCollectionResponseValidationError) ErrorName() string {
+	return "GetSharedCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSharedCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSharedCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSharedCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSharedCollectionResponseValidationError{}
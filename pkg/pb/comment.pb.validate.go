@@ -131,6 +131,16 @@ func (m *Comment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for WorkspaceId
+
+	// no validation rules for ParentCommentId
+
+	// no validation rules for Depth
+
+	// no validation rules for ReplyCount
+
+	// no validation rules for Deleted
+
 	if len(errors) > 0 {
 		return CommentMultiError(errors)
 	}
@@ -230,8 +240,6 @@ func (m *CreateCommentRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for UserId
-
 	// no validation rules for ArticleId
 
 	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 1000 {
@@ -245,6 +253,28 @@ func (m *CreateCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetWorkspaceId() < 0 {
+		err := CreateCommentRequestValidationError{
+			field:  "WorkspaceId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentCommentId() < 0 {
+		err := CreateCommentRequestValidationError{
+			field:  "ParentCommentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateCommentRequestMultiError(errors)
 	}
@@ -480,6 +510,19 @@ func (m *ListCommentsByUserIDRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	if m.GetPageSize() < 0 {
+		err := ListCommentsByUserIDRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
 		return ListCommentsByUserIDRequestMultiError(errors)
 	}
@@ -617,6 +660,8 @@ func (m *ListCommentsByUserIDResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListCommentsByUserIDResponseMultiError(errors)
 	}
@@ -722,6 +767,30 @@ func (m *ListCommentsByArticleIDRequest) validate(all bool) error {
 
 	// no validation rules for ArticleId
 
+	if m.GetPageSize() < 0 {
+		err := ListCommentsByArticleIDRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetParentCommentId() < 0 {
+		err := ListCommentsByArticleIDRequestValidationError{
+			field:  "ParentCommentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListCommentsByArticleIDRequestMultiError(errors)
 	}
@@ -859,6 +928,8 @@ func (m *ListCommentsByArticleIDResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListCommentsByArticleIDResponseMultiError(errors)
 	}
@@ -940,6 +1011,276 @@ var _ interface {
 	ErrorName() string
 } = ListCommentsByArticleIDResponseValidationError{}
 
+// Validate checks the field values on ListWorkspaceCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspaceCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspaceCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkspaceCommentsRequestMultiError, or nil if none found.
+func (m *ListWorkspaceCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspaceCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for ArticleId
+
+	if m.GetPageSize() < 0 {
+		err := ListWorkspaceCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetParentCommentId() < 0 {
+		err := ListWorkspaceCommentsRequestValidationError{
+			field:  "ParentCommentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWorkspaceCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspaceCommentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceCommentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWorkspaceCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspaceCommentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspaceCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListWorkspaceCommentsRequestValidationError is the validation error returned
+// by ListWorkspaceCommentsRequest.Validate if the designated constraints
+// aren't met.
+type ListWorkspaceCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspaceCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspaceCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspaceCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspaceCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspaceCommentsRequestValidationError) ErrorName() string {
+	return "ListWorkspaceCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspaceCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspaceCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspaceCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspaceCommentsRequestValidationError{}
+
+// Validate checks the field values on ListWorkspaceCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspaceCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspaceCommentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWorkspaceCommentsResponseMultiError, or nil if none found.
+func (m *ListWorkspaceCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspaceCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWorkspaceCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWorkspaceCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWorkspaceCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListWorkspaceCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspaceCommentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceCommentsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWorkspaceCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspaceCommentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspaceCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListWorkspaceCommentsResponseValidationError is the validation error
+// returned by ListWorkspaceCommentsResponse.Validate if the designated
+// constraints aren't met.
+type ListWorkspaceCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspaceCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspaceCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspaceCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspaceCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspaceCommentsResponseValidationError) ErrorName() string {
+	return "ListWorkspaceCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspaceCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspaceCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspaceCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspaceCommentsResponseValidationError{}
+
 // Validate checks the field values on DeleteCommentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1779,3 +2120,238 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteCommentByArticleIDResponseValidationError{}
+
+// Validate checks the field values on RestoreCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreCommentRequestMultiError, or nil if none found.
+func (m *RestoreCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreCommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreCommentRequestMultiError) AllErrors() []error { return m }
+
+// RestoreCommentRequestValidationError is the validation error returned by
+// RestoreCommentRequest.Validate if the designated constraints aren't met.
+type RestoreCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreCommentRequestValidationError) ErrorName() string {
+	return "RestoreCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreCommentRequestValidationError{}
+
+// Validate checks the field values on RestoreCommentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreCommentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreCommentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreCommentResponseMultiError, or nil if none found.
+func (m *RestoreCommentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreCommentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreCommentResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreCommentResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreCommentResponseValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreCommentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreCommentResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreCommentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreCommentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreCommentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreCommentResponseMultiError) AllErrors() []error { return m }
+
+// RestoreCommentResponseValidationError is the validation error returned by
+// RestoreCommentResponse.Validate if the designated constraints aren't met.
+type RestoreCommentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreCommentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreCommentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreCommentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreCommentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreCommentResponseValidationError) ErrorName() string {
+	return "RestoreCommentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreCommentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreCommentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreCommentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreCommentResponseValidationError{}
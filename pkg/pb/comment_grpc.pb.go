@@ -22,10 +22,12 @@ const (
 	CommentService_CreateComment_FullMethodName                     = "/proto.CommentService/CreateComment"
 	CommentService_ListCommentsByUserID_FullMethodName              = "/proto.CommentService/ListCommentsByUserID"
 	CommentService_ListCommentsByArticleID_FullMethodName           = "/proto.CommentService/ListCommentsByArticleID"
+	CommentService_ListWorkspaceComments_FullMethodName             = "/proto.CommentService/ListWorkspaceComments"
 	CommentService_DeleteComment_FullMethodName                     = "/proto.CommentService/DeleteComment"
 	CommentService_DeleteCommentByUserIDAndArticleID_FullMethodName = "/proto.CommentService/DeleteCommentByUserIDAndArticleID"
 	CommentService_DeleteCommentByUserID_FullMethodName             = "/proto.CommentService/DeleteCommentByUserID"
 	CommentService_DeleteCommentByArticleID_FullMethodName          = "/proto.CommentService/DeleteCommentByArticleID"
+	CommentService_RestoreComment_FullMethodName                    = "/proto.CommentService/RestoreComment"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -35,10 +37,12 @@ type CommentServiceClient interface {
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	ListCommentsByUserID(ctx context.Context, in *ListCommentsByUserIDRequest, opts ...grpc.CallOption) (*ListCommentsByUserIDResponse, error)
 	ListCommentsByArticleID(ctx context.Context, in *ListCommentsByArticleIDRequest, opts ...grpc.CallOption) (*ListCommentsByArticleIDResponse, error)
+	ListWorkspaceComments(ctx context.Context, in *ListWorkspaceCommentsRequest, opts ...grpc.CallOption) (*ListWorkspaceCommentsResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 	DeleteCommentByUserIDAndArticleID(ctx context.Context, in *DeleteCommentByUserIDAndArticleIDRequest, opts ...grpc.CallOption) (*DeleteCommentByUserIDAndArticleIDResponse, error)
 	DeleteCommentByUserID(ctx context.Context, in *DeleteCommentByUserIDRequest, opts ...grpc.CallOption) (*DeleteCommentByUserIDResponse, error)
 	DeleteCommentByArticleID(ctx context.Context, in *DeleteCommentByArticleIDRequest, opts ...grpc.CallOption) (*DeleteCommentByArticleIDResponse, error)
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error)
 }
 
 type commentServiceClient struct {
@@ -76,6 +80,15 @@ func (c *commentServiceClient) ListCommentsByArticleID(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *commentServiceClient) ListWorkspaceComments(ctx context.Context, in *ListWorkspaceCommentsRequest, opts ...grpc.CallOption) (*ListWorkspaceCommentsResponse, error) {
+	out := new(ListWorkspaceCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListWorkspaceComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
 	out := new(DeleteCommentResponse)
 	err := c.cc.Invoke(ctx, CommentService_DeleteComment_FullMethodName, in, out, opts...)
@@ -112,6 +125,15 @@ func (c *commentServiceClient) DeleteCommentByArticleID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *commentServiceClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error) {
+	out := new(RestoreCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_RestoreComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
@@ -119,10 +141,12 @@ type CommentServiceServer interface {
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	ListCommentsByUserID(context.Context, *ListCommentsByUserIDRequest) (*ListCommentsByUserIDResponse, error)
 	ListCommentsByArticleID(context.Context, *ListCommentsByArticleIDRequest) (*ListCommentsByArticleIDResponse, error)
+	ListWorkspaceComments(context.Context, *ListWorkspaceCommentsRequest) (*ListWorkspaceCommentsResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	DeleteCommentByUserIDAndArticleID(context.Context, *DeleteCommentByUserIDAndArticleIDRequest) (*DeleteCommentByUserIDAndArticleIDResponse, error)
 	DeleteCommentByUserID(context.Context, *DeleteCommentByUserIDRequest) (*DeleteCommentByUserIDResponse, error)
 	DeleteCommentByArticleID(context.Context, *DeleteCommentByArticleIDRequest) (*DeleteCommentByArticleIDResponse, error)
+	RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -139,6 +163,9 @@ func (UnimplementedCommentServiceServer) ListCommentsByUserID(context.Context, *
 func (UnimplementedCommentServiceServer) ListCommentsByArticleID(context.Context, *ListCommentsByArticleIDRequest) (*ListCommentsByArticleIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommentsByArticleID not implemented")
 }
+func (UnimplementedCommentServiceServer) ListWorkspaceComments(context.Context, *ListWorkspaceCommentsRequest) (*ListWorkspaceCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceComments not implemented")
+}
 func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
@@ -151,6 +178,9 @@ func (UnimplementedCommentServiceServer) DeleteCommentByUserID(context.Context,
 func (UnimplementedCommentServiceServer) DeleteCommentByArticleID(context.Context, *DeleteCommentByArticleIDRequest) (*DeleteCommentByArticleIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentByArticleID not implemented")
 }
+func (UnimplementedCommentServiceServer) RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
 // UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +248,24 @@ func _CommentService_ListCommentsByArticleID_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_ListWorkspaceComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListWorkspaceComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListWorkspaceComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListWorkspaceComments(ctx, req.(*ListWorkspaceCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +338,24 @@ func _CommentService_DeleteCommentByArticleID_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,6 +375,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCommentsByArticleID",
 			Handler:    _CommentService_ListCommentsByArticleID_Handler,
 		},
+		{
+			MethodName: "ListWorkspaceComments",
+			Handler:    _CommentService_ListWorkspaceComments_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _CommentService_DeleteComment_Handler,
@@ -325,6 +395,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCommentByArticleID",
 			Handler:    _CommentService_DeleteCommentByArticleID_Handler,
 		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _CommentService_RestoreComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment.proto",
@@ -0,0 +1,1724 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: feed_source.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FeedSource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeedSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeedSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeedSourceMultiError, or
+// nil if none found.
+func (m *FeedSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeedSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for Title
+
+	if all {
+		switch v := interface{}(m.GetLastFetchedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "LastFetchedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "LastFetchedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastFetchedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FeedSourceValidationError{
+				field:  "LastFetchedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextFetchAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "NextFetchAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "NextFetchAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextFetchAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FeedSourceValidationError{
+				field:  "NextFetchAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorCount
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FeedSourceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FeedSourceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FeedSourceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FeedSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeedSourceMultiError is an error wrapping multiple validation errors
+// returned by FeedSource.ValidateAll() if the designated constraints aren't met.
+type FeedSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeedSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeedSourceMultiError) AllErrors() []error { return m }
+
+// FeedSourceValidationError is the validation error returned by
+// FeedSource.Validate if the designated constraints aren't met.
+type FeedSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeedSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeedSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeedSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeedSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeedSourceValidationError) ErrorName() string { return "FeedSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeedSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeedSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeedSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeedSourceValidationError{}
+
+// Validate checks the field values on CreateFeedSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFeedSourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFeedSourceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFeedSourceRequestMultiError, or nil if none found.
+func (m *CreateFeedSourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFeedSourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateFeedSourceRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateFeedSourceRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateFeedSourceRequest_Url_Pattern.MatchString(m.GetUrl()) {
+		err := CreateFeedSourceRequestValidationError{
+			field:  "Url",
+			reason: "value does not match regex pattern \"^https?://\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateFeedSourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFeedSourceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFeedSourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFeedSourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFeedSourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFeedSourceRequestMultiError) AllErrors() []error { return m }
+
+// CreateFeedSourceRequestValidationError is the validation error returned by
+// CreateFeedSourceRequest.Validate if the designated constraints aren't met.
+type CreateFeedSourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFeedSourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFeedSourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFeedSourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFeedSourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFeedSourceRequestValidationError) ErrorName() string {
+	return "CreateFeedSourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFeedSourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFeedSourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFeedSourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFeedSourceRequestValidationError{}
+
+var _CreateFeedSourceRequest_Url_Pattern = regexp.MustCompile("^https?://")
+
+// Validate checks the field values on CreateFeedSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFeedSourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFeedSourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFeedSourceResponseMultiError, or nil if none found.
+func (m *CreateFeedSourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFeedSourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFeedSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFeedSourceResponseValidationError{
+					field:  "FeedSource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFeedSourceResponseValidationError{
+					field:  "FeedSource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeedSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFeedSourceResponseValidationError{
+				field:  "FeedSource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFeedSourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFeedSourceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFeedSourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFeedSourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFeedSourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFeedSourceResponseMultiError) AllErrors() []error { return m }
+
+// CreateFeedSourceResponseValidationError is the validation error returned by
+// CreateFeedSourceResponse.Validate if the designated constraints aren't met.
+type CreateFeedSourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFeedSourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFeedSourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFeedSourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFeedSourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFeedSourceResponseValidationError) ErrorName() string {
+	return "CreateFeedSourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFeedSourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFeedSourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFeedSourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFeedSourceResponseValidationError{}
+
+// Validate checks the field values on GetFeedSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeedSourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeedSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeedSourceRequestMultiError, or nil if none found.
+func (m *GetFeedSourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeedSourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetFeedSourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeedSourceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFeedSourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeedSourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeedSourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeedSourceRequestMultiError) AllErrors() []error { return m }
+
+// GetFeedSourceRequestValidationError is the validation error returned by
+// GetFeedSourceRequest.Validate if the designated constraints aren't met.
+type GetFeedSourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeedSourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeedSourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeedSourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeedSourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeedSourceRequestValidationError) ErrorName() string {
+	return "GetFeedSourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeedSourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeedSourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeedSourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeedSourceRequestValidationError{}
+
+// Validate checks the field values on GetFeedSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeedSourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeedSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeedSourceResponseMultiError, or nil if none found.
+func (m *GetFeedSourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeedSourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFeedSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFeedSourceResponseValidationError{
+					field:  "FeedSource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFeedSourceResponseValidationError{
+					field:  "FeedSource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeedSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFeedSourceResponseValidationError{
+				field:  "FeedSource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFeedSourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeedSourceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFeedSourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeedSourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeedSourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeedSourceResponseMultiError) AllErrors() []error { return m }
+
+// GetFeedSourceResponseValidationError is the validation error returned by
+// GetFeedSourceResponse.Validate if the designated constraints aren't met.
+type GetFeedSourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeedSourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeedSourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeedSourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeedSourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeedSourceResponseValidationError) ErrorName() string {
+	return "GetFeedSourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeedSourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeedSourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeedSourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeedSourceResponseValidationError{}
+
+// Validate checks the field values on ListFeedSourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeedSourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeedSourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeedSourcesRequestMultiError, or nil if none found.
+func (m *ListFeedSourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeedSourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListFeedSourcesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFeedSourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeedSourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFeedSourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeedSourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeedSourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeedSourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListFeedSourcesRequestValidationError is the validation error returned by
+// ListFeedSourcesRequest.Validate if the designated constraints aren't met.
+type ListFeedSourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeedSourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeedSourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeedSourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeedSourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeedSourcesRequestValidationError) ErrorName() string {
+	return "ListFeedSourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeedSourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeedSourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeedSourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeedSourcesRequestValidationError{}
+
+// Validate checks the field values on ListFeedSourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeedSourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeedSourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeedSourcesResponseMultiError, or nil if none found.
+func (m *ListFeedSourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeedSourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFeedSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFeedSourcesResponseValidationError{
+						field:  fmt.Sprintf("FeedSources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFeedSourcesResponseValidationError{
+						field:  fmt.Sprintf("FeedSources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFeedSourcesResponseValidationError{
+					field:  fmt.Sprintf("FeedSources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFeedSourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeedSourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFeedSourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeedSourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeedSourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeedSourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListFeedSourcesResponseValidationError is the validation error returned by
+// ListFeedSourcesResponse.Validate if the designated constraints aren't met.
+type ListFeedSourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeedSourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeedSourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeedSourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeedSourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeedSourcesResponseValidationError) ErrorName() string {
+	return "ListFeedSourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeedSourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeedSourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeedSourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeedSourcesResponseValidationError{}
+
+// Validate checks the field values on DeleteFeedSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFeedSourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFeedSourceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFeedSourceRequestMultiError, or nil if none found.
+func (m *DeleteFeedSourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFeedSourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteFeedSourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFeedSourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFeedSourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFeedSourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFeedSourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFeedSourceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFeedSourceRequestValidationError is the validation error returned by
+// DeleteFeedSourceRequest.Validate if the designated constraints aren't met.
+type DeleteFeedSourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFeedSourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFeedSourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFeedSourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFeedSourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFeedSourceRequestValidationError) ErrorName() string {
+	return "DeleteFeedSourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFeedSourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFeedSourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFeedSourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFeedSourceRequestValidationError{}
+
+// Validate checks the field values on DeleteFeedSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFeedSourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFeedSourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFeedSourceResponseMultiError, or nil if none found.
+func (m *DeleteFeedSourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFeedSourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteFeedSourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFeedSourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteFeedSourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFeedSourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFeedSourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFeedSourceResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFeedSourceResponseValidationError is the validation error returned by
+// DeleteFeedSourceResponse.Validate if the designated constraints aren't met.
+type DeleteFeedSourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFeedSourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFeedSourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFeedSourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFeedSourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFeedSourceResponseValidationError) ErrorName() string {
+	return "DeleteFeedSourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFeedSourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFeedSourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFeedSourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFeedSourceResponseValidationError{}
+
+// Validate checks the field values on ImportOPMLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportOPMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOPMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOPMLRequestMultiError, or nil if none found.
+func (m *ImportOPMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOPMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOpml()) < 1 {
+		err := ImportOPMLRequestValidationError{
+			field:  "Opml",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOpml()) > 5242880 {
+		err := ImportOPMLRequestValidationError{
+			field:  "Opml",
+			reason: "value length must be at most 5242880 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportOPMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOPMLRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportOPMLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOPMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOPMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOPMLRequestMultiError) AllErrors() []error { return m }
+
+// ImportOPMLRequestValidationError is the validation error returned by
+// ImportOPMLRequest.Validate if the designated constraints aren't met.
+type ImportOPMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOPMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOPMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOPMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOPMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOPMLRequestValidationError) ErrorName() string {
+	return "ImportOPMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOPMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOPMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOPMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOPMLRequestValidationError{}
+
+// Validate checks the field values on SkippedOutline with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkippedOutline) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkippedOutline with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SkippedOutlineMultiError,
+// or nil if none found.
+func (m *SkippedOutline) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkippedOutline) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Url
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SkippedOutlineMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkippedOutlineMultiError is an error wrapping multiple validation errors
+// returned by SkippedOutline.ValidateAll() if the designated constraints
+// aren't met.
+type SkippedOutlineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkippedOutlineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkippedOutlineMultiError) AllErrors() []error { return m }
+
+// SkippedOutlineValidationError is the validation error returned by
+// SkippedOutline.Validate if the designated constraints aren't met.
+type SkippedOutlineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkippedOutlineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkippedOutlineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkippedOutlineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkippedOutlineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkippedOutlineValidationError) ErrorName() string { return "SkippedOutlineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkippedOutlineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkippedOutline.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkippedOutlineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkippedOutlineValidationError{}
+
+// Validate checks the field values on ImportOPMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportOPMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOPMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportOPMLResponseMultiError, or nil if none found.
+func (m *ImportOPMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOPMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOPMLResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOPMLResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOPMLResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSkipped() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOPMLResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOPMLResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOPMLResponseValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportOPMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOPMLResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportOPMLResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOPMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOPMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOPMLResponseMultiError) AllErrors() []error { return m }
+
+// ImportOPMLResponseValidationError is the validation error returned by
+// ImportOPMLResponse.Validate if the designated constraints aren't met.
+type ImportOPMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOPMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOPMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOPMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOPMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOPMLResponseValidationError) ErrorName() string {
+	return "ImportOPMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOPMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOPMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOPMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOPMLResponseValidationError{}
+
+// Validate checks the field values on ExportOPMLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportOPMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportOPMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportOPMLRequestMultiError, or nil if none found.
+func (m *ExportOPMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportOPMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportOPMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportOPMLRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportOPMLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportOPMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportOPMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportOPMLRequestMultiError) AllErrors() []error { return m }
+
+// ExportOPMLRequestValidationError is the validation error returned by
+// ExportOPMLRequest.Validate if the designated constraints aren't met.
+type ExportOPMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOPMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOPMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOPMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOPMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOPMLRequestValidationError) ErrorName() string {
+	return "ExportOPMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOPMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOPMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOPMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOPMLRequestValidationError{}
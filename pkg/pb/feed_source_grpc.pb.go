@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: feed_source.proto
+
+package pb
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FeedSourceService_CreateFeedSource_FullMethodName = "/proto.FeedSourceService/CreateFeedSource"
+	FeedSourceService_GetFeedSource_FullMethodName    = "/proto.FeedSourceService/GetFeedSource"
+	FeedSourceService_ListFeedSources_FullMethodName  = "/proto.FeedSourceService/ListFeedSources"
+	FeedSourceService_DeleteFeedSource_FullMethodName = "/proto.FeedSourceService/DeleteFeedSource"
+	FeedSourceService_ImportOPML_FullMethodName       = "/proto.FeedSourceService/ImportOPML"
+	FeedSourceService_ExportOPML_FullMethodName       = "/proto.FeedSourceService/ExportOPML"
+)
+
+// FeedSourceServiceClient is the client API for FeedSourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeedSourceServiceClient interface {
+	CreateFeedSource(ctx context.Context, in *CreateFeedSourceRequest, opts ...grpc.CallOption) (*CreateFeedSourceResponse, error)
+	GetFeedSource(ctx context.Context, in *GetFeedSourceRequest, opts ...grpc.CallOption) (*GetFeedSourceResponse, error)
+	ListFeedSources(ctx context.Context, in *ListFeedSourcesRequest, opts ...grpc.CallOption) (*ListFeedSourcesResponse, error)
+	DeleteFeedSource(ctx context.Context, in *DeleteFeedSourceRequest, opts ...grpc.CallOption) (*DeleteFeedSourceResponse, error)
+	ImportOPML(ctx context.Context, in *ImportOPMLRequest, opts ...grpc.CallOption) (*ImportOPMLResponse, error)
+	ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type feedSourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeedSourceServiceClient(cc grpc.ClientConnInterface) FeedSourceServiceClient {
+	return &feedSourceServiceClient{cc}
+}
+
+func (c *feedSourceServiceClient) CreateFeedSource(ctx context.Context, in *CreateFeedSourceRequest, opts ...grpc.CallOption) (*CreateFeedSourceResponse, error) {
+	out := new(CreateFeedSourceResponse)
+	err := c.cc.Invoke(ctx, FeedSourceService_CreateFeedSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSourceServiceClient) GetFeedSource(ctx context.Context, in *GetFeedSourceRequest, opts ...grpc.CallOption) (*GetFeedSourceResponse, error) {
+	out := new(GetFeedSourceResponse)
+	err := c.cc.Invoke(ctx, FeedSourceService_GetFeedSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSourceServiceClient) ListFeedSources(ctx context.Context, in *ListFeedSourcesRequest, opts ...grpc.CallOption) (*ListFeedSourcesResponse, error) {
+	out := new(ListFeedSourcesResponse)
+	err := c.cc.Invoke(ctx, FeedSourceService_ListFeedSources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSourceServiceClient) DeleteFeedSource(ctx context.Context, in *DeleteFeedSourceRequest, opts ...grpc.CallOption) (*DeleteFeedSourceResponse, error) {
+	out := new(DeleteFeedSourceResponse)
+	err := c.cc.Invoke(ctx, FeedSourceService_DeleteFeedSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSourceServiceClient) ImportOPML(ctx context.Context, in *ImportOPMLRequest, opts ...grpc.CallOption) (*ImportOPMLResponse, error) {
+	out := new(ImportOPMLResponse)
+	err := c.cc.Invoke(ctx, FeedSourceService_ImportOPML_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSourceServiceClient) ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, FeedSourceService_ExportOPML_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeedSourceServiceServer is the server API for FeedSourceService service.
+// All implementations must embed UnimplementedFeedSourceServiceServer
+// for forward compatibility
+type FeedSourceServiceServer interface {
+	CreateFeedSource(context.Context, *CreateFeedSourceRequest) (*CreateFeedSourceResponse, error)
+	GetFeedSource(context.Context, *GetFeedSourceRequest) (*GetFeedSourceResponse, error)
+	ListFeedSources(context.Context, *ListFeedSourcesRequest) (*ListFeedSourcesResponse, error)
+	DeleteFeedSource(context.Context, *DeleteFeedSourceRequest) (*DeleteFeedSourceResponse, error)
+	ImportOPML(context.Context, *ImportOPMLRequest) (*ImportOPMLResponse, error)
+	ExportOPML(context.Context, *ExportOPMLRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedFeedSourceServiceServer()
+}
+
+// UnimplementedFeedSourceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFeedSourceServiceServer struct {
+}
+
+func (UnimplementedFeedSourceServiceServer) CreateFeedSource(context.Context, *CreateFeedSourceRequest) (*CreateFeedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedSource not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) GetFeedSource(context.Context, *GetFeedSourceRequest) (*GetFeedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedSource not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) ListFeedSources(context.Context, *ListFeedSourcesRequest) (*ListFeedSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeedSources not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) DeleteFeedSource(context.Context, *DeleteFeedSourceRequest) (*DeleteFeedSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedSource not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) ImportOPML(context.Context, *ImportOPMLRequest) (*ImportOPMLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOPML not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) ExportOPML(context.Context, *ExportOPMLRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOPML not implemented")
+}
+func (UnimplementedFeedSourceServiceServer) mustEmbedUnimplementedFeedSourceServiceServer() {}
+
+// UnsafeFeedSourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeedSourceServiceServer will
+// result in compilation errors.
+type UnsafeFeedSourceServiceServer interface {
+	mustEmbedUnimplementedFeedSourceServiceServer()
+}
+
+func RegisterFeedSourceServiceServer(s grpc.ServiceRegistrar, srv FeedSourceServiceServer) {
+	s.RegisterService(&FeedSourceService_ServiceDesc, srv)
+}
+
+func _FeedSourceService_CreateFeedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).CreateFeedSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_CreateFeedSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).CreateFeedSource(ctx, req.(*CreateFeedSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSourceService_GetFeedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).GetFeedSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_GetFeedSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).GetFeedSource(ctx, req.(*GetFeedSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSourceService_ListFeedSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).ListFeedSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_ListFeedSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).ListFeedSources(ctx, req.(*ListFeedSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSourceService_DeleteFeedSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeedSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).DeleteFeedSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_DeleteFeedSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).DeleteFeedSource(ctx, req.(*DeleteFeedSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSourceService_ImportOPML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOPMLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).ImportOPML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_ImportOPML_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).ImportOPML(ctx, req.(*ImportOPMLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSourceService_ExportOPML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOPMLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSourceServiceServer).ExportOPML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSourceService_ExportOPML_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSourceServiceServer).ExportOPML(ctx, req.(*ExportOPMLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeedSourceService_ServiceDesc is the grpc.ServiceDesc for FeedSourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeedSourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.FeedSourceService",
+	HandlerType: (*FeedSourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFeedSource",
+			Handler:    _FeedSourceService_CreateFeedSource_Handler,
+		},
+		{
+			MethodName: "GetFeedSource",
+			Handler:    _FeedSourceService_GetFeedSource_Handler,
+		},
+		{
+			MethodName: "ListFeedSources",
+			Handler:    _FeedSourceService_ListFeedSources_Handler,
+		},
+		{
+			MethodName: "DeleteFeedSource",
+			Handler:    _FeedSourceService_DeleteFeedSource_Handler,
+		},
+		{
+			MethodName: "ImportOPML",
+			Handler:    _FeedSourceService_ImportOPML_Handler,
+		},
+		{
+			MethodName: "ExportOPML",
+			Handler:    _FeedSourceService_ExportOPML_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feed_source.proto",
+}
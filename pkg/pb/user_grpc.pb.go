@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_CreateUser_FullMethodName = "/proto.UserService/CreateUser"
-	UserService_GetUser_FullMethodName    = "/proto.UserService/GetUser"
-	UserService_ListUsers_FullMethodName  = "/proto.UserService/ListUsers"
-	UserService_UpdateUser_FullMethodName = "/proto.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName = "/proto.UserService/DeleteUser"
+	UserService_CreateUser_FullMethodName               = "/proto.UserService/CreateUser"
+	UserService_GetUser_FullMethodName                  = "/proto.UserService/GetUser"
+	UserService_ListUsers_FullMethodName                = "/proto.UserService/ListUsers"
+	UserService_UpdateUser_FullMethodName               = "/proto.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName               = "/proto.UserService/DeleteUser"
+	UserService_UpdateBookmarkVisibility_FullMethodName = "/proto.UserService/UpdateBookmarkVisibility"
+	UserService_UpdateDigestSubscription_FullMethodName = "/proto.UserService/UpdateDigestSubscription"
+	UserService_RestoreUser_FullMethodName              = "/proto.UserService/RestoreUser"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -35,6 +38,9 @@ type UserServiceClient interface {
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	UpdateBookmarkVisibility(ctx context.Context, in *UpdateBookmarkVisibilityRequest, opts ...grpc.CallOption) (*UpdateBookmarkVisibilityResponse, error)
+	UpdateDigestSubscription(ctx context.Context, in *UpdateDigestSubscriptionRequest, opts ...grpc.CallOption) (*UpdateDigestSubscriptionResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 }
 
 type userServiceClient struct {
@@ -90,6 +96,33 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateBookmarkVisibility(ctx context.Context, in *UpdateBookmarkVisibilityRequest, opts ...grpc.CallOption) (*UpdateBookmarkVisibilityResponse, error) {
+	out := new(UpdateBookmarkVisibilityResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateBookmarkVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateDigestSubscription(ctx context.Context, in *UpdateDigestSubscriptionRequest, opts ...grpc.CallOption) (*UpdateDigestSubscriptionResponse, error) {
+	out := new(UpdateDigestSubscriptionResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateDigestSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -99,6 +132,9 @@ type UserServiceServer interface {
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	UpdateBookmarkVisibility(context.Context, *UpdateBookmarkVisibilityRequest) (*UpdateBookmarkVisibilityResponse, error)
+	UpdateDigestSubscription(context.Context, *UpdateDigestSubscriptionRequest) (*UpdateDigestSubscriptionResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -121,6 +157,15 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateBookmarkVisibility(context.Context, *UpdateBookmarkVisibilityRequest) (*UpdateBookmarkVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmarkVisibility not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateDigestSubscription(context.Context, *UpdateDigestSubscriptionRequest) (*UpdateDigestSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDigestSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +269,60 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateBookmarkVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookmarkVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateBookmarkVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateBookmarkVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateBookmarkVisibility(ctx, req.(*UpdateBookmarkVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateDigestSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDigestSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateDigestSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateDigestSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateDigestSubscription(ctx, req.(*UpdateDigestSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +350,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "UpdateBookmarkVisibility",
+			Handler:    _UserService_UpdateBookmarkVisibility_Handler,
+		},
+		{
+			MethodName: "UpdateDigestSubscription",
+			Handler:    _UserService_UpdateDigestSubscription_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserService_RestoreUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -0,0 +1,2729 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: workspace.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Workspace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Workspace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Workspace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkspaceMultiError, or nil
+// if none found.
+func (m *Workspace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Workspace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkspaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkspaceMultiError is an error wrapping multiple validation errors returned
+// by Workspace.ValidateAll() if the designated constraints aren't met.
+type WorkspaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkspaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkspaceMultiError) AllErrors() []error { return m }
+
+// WorkspaceValidationError is the validation error returned by
+// Workspace.Validate if the designated constraints aren't met.
+type WorkspaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkspaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkspaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkspaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkspaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkspaceValidationError) ErrorName() string { return "WorkspaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkspaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkspace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkspaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkspaceValidationError{}
+
+// Validate checks the field values on WorkspaceMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WorkspaceMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkspaceMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkspaceMemberMultiError, or nil if none found.
+func (m *WorkspaceMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkspaceMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceMemberValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkspaceMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkspaceMemberMultiError is an error wrapping multiple validation errors
+// returned by WorkspaceMember.ValidateAll() if the designated constraints
+// aren't met.
+type WorkspaceMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkspaceMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkspaceMemberMultiError) AllErrors() []error { return m }
+
+// WorkspaceMemberValidationError is the validation error returned by
+// WorkspaceMember.Validate if the designated constraints aren't met.
+type WorkspaceMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkspaceMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkspaceMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkspaceMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkspaceMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkspaceMemberValidationError) ErrorName() string { return "WorkspaceMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkspaceMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkspaceMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkspaceMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkspaceMemberValidationError{}
+
+// Validate checks the field values on WorkspaceInvitation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkspaceInvitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkspaceInvitation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkspaceInvitationMultiError, or nil if none found.
+func (m *WorkspaceInvitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkspaceInvitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceInvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceInvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceInvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceInvitationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkspaceInvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkspaceInvitationMultiError is an error wrapping multiple validation
+// errors returned by WorkspaceInvitation.ValidateAll() if the designated
+// constraints aren't met.
+type WorkspaceInvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkspaceInvitationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkspaceInvitationMultiError) AllErrors() []error { return m }
+
+// WorkspaceInvitationValidationError is the validation error returned by
+// WorkspaceInvitation.Validate if the designated constraints aren't met.
+type WorkspaceInvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkspaceInvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkspaceInvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkspaceInvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkspaceInvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkspaceInvitationValidationError) ErrorName() string {
+	return "WorkspaceInvitationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkspaceInvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkspaceInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkspaceInvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkspaceInvitationValidationError{}
+
+// Validate checks the field values on CreateWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkspaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkspaceRequestMultiError, or nil if none found.
+func (m *CreateWorkspaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkspaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateWorkspaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWorkspaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkspaceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkspaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkspaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkspaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkspaceRequestMultiError) AllErrors() []error { return m }
+
+// CreateWorkspaceRequestValidationError is the validation error returned by
+// CreateWorkspaceRequest.Validate if the designated constraints aren't met.
+type CreateWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkspaceRequestValidationError) ErrorName() string {
+	return "CreateWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkspaceRequestValidationError{}
+
+// Validate checks the field values on CreateWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkspaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkspaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkspaceResponseMultiError, or nil if none found.
+func (m *CreateWorkspaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkspaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWorkspaceResponseValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWorkspaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkspaceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkspaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkspaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkspaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkspaceResponseMultiError) AllErrors() []error { return m }
+
+// CreateWorkspaceResponseValidationError is the validation error returned by
+// CreateWorkspaceResponse.Validate if the designated constraints aren't met.
+type CreateWorkspaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkspaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkspaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkspaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkspaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkspaceResponseValidationError) ErrorName() string {
+	return "CreateWorkspaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkspaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkspaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkspaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkspaceResponseValidationError{}
+
+// Validate checks the field values on GetWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkspaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkspaceRequestMultiError, or nil if none found.
+func (m *GetWorkspaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkspaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetWorkspaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkspaceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkspaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkspaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkspaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkspaceRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkspaceRequestValidationError is the validation error returned by
+// GetWorkspaceRequest.Validate if the designated constraints aren't met.
+type GetWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkspaceRequestValidationError) ErrorName() string {
+	return "GetWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkspaceRequestValidationError{}
+
+// Validate checks the field values on GetWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkspaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkspaceResponseMultiError, or nil if none found.
+func (m *GetWorkspaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkspaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWorkspaceResponseValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWorkspaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkspaceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkspaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkspaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkspaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkspaceResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkspaceResponseValidationError is the validation error returned by
+// GetWorkspaceResponse.Validate if the designated constraints aren't met.
+type GetWorkspaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkspaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkspaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkspaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkspaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkspaceResponseValidationError) ErrorName() string {
+	return "GetWorkspaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkspaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkspaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkspaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkspaceResponseValidationError{}
+
+// Validate checks the field values on ListWorkspacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkspacesRequestMultiError, or nil if none found.
+func (m *ListWorkspacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() < 0 {
+		err := ListWorkspacesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListWorkspacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspacesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWorkspacesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWorkspacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspacesRequestMultiError) AllErrors() []error { return m }
+
+// ListWorkspacesRequestValidationError is the validation error returned by
+// ListWorkspacesRequest.Validate if the designated constraints aren't met.
+type ListWorkspacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspacesRequestValidationError) ErrorName() string {
+	return "ListWorkspacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspacesRequestValidationError{}
+
+// Validate checks the field values on ListWorkspacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkspacesResponseMultiError, or nil if none found.
+func (m *ListWorkspacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWorkspaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWorkspacesResponseValidationError{
+						field:  fmt.Sprintf("Workspaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWorkspacesResponseValidationError{
+						field:  fmt.Sprintf("Workspaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWorkspacesResponseValidationError{
+					field:  fmt.Sprintf("Workspaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListWorkspacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspacesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWorkspacesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWorkspacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspacesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspacesResponseMultiError) AllErrors() []error { return m }
+
+// ListWorkspacesResponseValidationError is the validation error returned by
+// ListWorkspacesResponse.Validate if the designated constraints aren't met.
+type ListWorkspacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspacesResponseValidationError) ErrorName() string {
+	return "ListWorkspacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspacesResponseValidationError{}
+
+// Validate checks the field values on DeleteWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWorkspaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWorkspaceRequestMultiError, or nil if none found.
+func (m *DeleteWorkspaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWorkspaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWorkspaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWorkspaceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWorkspaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWorkspaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWorkspaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWorkspaceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWorkspaceRequestValidationError is the validation error returned by
+// DeleteWorkspaceRequest.Validate if the designated constraints aren't met.
+type DeleteWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWorkspaceRequestValidationError) ErrorName() string {
+	return "DeleteWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWorkspaceRequestValidationError{}
+
+// Validate checks the field values on DeleteWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWorkspaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWorkspaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWorkspaceResponseMultiError, or nil if none found.
+func (m *DeleteWorkspaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWorkspaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWorkspaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWorkspaceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWorkspaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWorkspaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWorkspaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWorkspaceResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWorkspaceResponseValidationError is the validation error returned by
+// DeleteWorkspaceResponse.Validate if the designated constraints aren't met.
+type DeleteWorkspaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWorkspaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWorkspaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWorkspaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWorkspaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWorkspaceResponseValidationError) ErrorName() string {
+	return "DeleteWorkspaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWorkspaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWorkspaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWorkspaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWorkspaceResponseValidationError{}
+
+// Validate checks the field values on ListWorkspaceMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspaceMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspaceMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkspaceMembersRequestMultiError, or nil if none found.
+func (m *ListWorkspaceMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspaceMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	if m.GetPageSize() < 0 {
+		err := ListWorkspaceMembersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListWorkspaceMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspaceMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWorkspaceMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspaceMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspaceMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListWorkspaceMembersRequestValidationError is the validation error returned
+// by ListWorkspaceMembersRequest.Validate if the designated constraints
+// aren't met.
+type ListWorkspaceMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspaceMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspaceMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspaceMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspaceMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspaceMembersRequestValidationError) ErrorName() string {
+	return "ListWorkspaceMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspaceMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspaceMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspaceMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspaceMembersRequestValidationError{}
+
+// Validate checks the field values on ListWorkspaceMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkspaceMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkspaceMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkspaceMembersResponseMultiError, or nil if none found.
+func (m *ListWorkspaceMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkspaceMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWorkspaceMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWorkspaceMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWorkspaceMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListWorkspaceMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkspaceMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWorkspaceMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListWorkspaceMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkspaceMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkspaceMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListWorkspaceMembersResponseValidationError is the validation error returned
+// by ListWorkspaceMembersResponse.Validate if the designated constraints
+// aren't met.
+type ListWorkspaceMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkspaceMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkspaceMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkspaceMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkspaceMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkspaceMembersResponseValidationError) ErrorName() string {
+	return "ListWorkspaceMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkspaceMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkspaceMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkspaceMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkspaceMembersResponseValidationError{}
+
+// Validate checks the field values on UpdateWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWorkspaceMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *UpdateWorkspaceMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWorkspaceMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for UserId
+
+	if _, ok := _UpdateWorkspaceMemberRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := UpdateWorkspaceMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [owner editor viewer]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateWorkspaceMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateWorkspaceMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWorkspaceMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
+
+// UpdateWorkspaceMemberRequestValidationError is the validation error returned
+// by UpdateWorkspaceMemberRequest.Validate if the designated constraints
+// aren't met.
+type UpdateWorkspaceMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWorkspaceMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWorkspaceMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "UpdateWorkspaceMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWorkspaceMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWorkspaceMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWorkspaceMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWorkspaceMemberRequestValidationError{}
+
+var _UpdateWorkspaceMemberRequest_Role_InLookup = map[string]struct{}{
+	"owner":  {},
+	"editor": {},
+	"viewer": {},
+}
+
+// Validate checks the field values on UpdateWorkspaceMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWorkspaceMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWorkspaceMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *UpdateWorkspaceMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWorkspaceMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWorkspaceMemberResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWorkspaceMemberResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWorkspaceMemberResponseValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateWorkspaceMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateWorkspaceMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWorkspaceMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
+
+// UpdateWorkspaceMemberResponseValidationError is the validation error
+// returned by UpdateWorkspaceMemberResponse.Validate if the designated
+// constraints aren't met.
+type UpdateWorkspaceMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWorkspaceMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWorkspaceMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "UpdateWorkspaceMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWorkspaceMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWorkspaceMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWorkspaceMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWorkspaceMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWorkspaceMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *RemoveWorkspaceMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWorkspaceMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return RemoveWorkspaceMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWorkspaceMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWorkspaceMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveWorkspaceMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWorkspaceMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveWorkspaceMemberRequestValidationError is the validation error returned
+// by RemoveWorkspaceMemberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveWorkspaceMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWorkspaceMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWorkspaceMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "RemoveWorkspaceMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWorkspaceMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWorkspaceMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWorkspaceMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWorkspaceMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveWorkspaceMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWorkspaceMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWorkspaceMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *RemoveWorkspaceMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWorkspaceMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveWorkspaceMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWorkspaceMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWorkspaceMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWorkspaceMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveWorkspaceMemberResponseValidationError is the validation error
+// returned by RemoveWorkspaceMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWorkspaceMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWorkspaceMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWorkspaceMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "RemoveWorkspaceMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWorkspaceMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWorkspaceMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWorkspaceMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWorkspaceMemberResponseValidationError{}
+
+// Validate checks the field values on InviteWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteWorkspaceMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *InviteWorkspaceMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteWorkspaceMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = InviteWorkspaceMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _InviteWorkspaceMemberRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := InviteWorkspaceMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [owner editor viewer]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteWorkspaceMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *InviteWorkspaceMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *InviteWorkspaceMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// InviteWorkspaceMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by InviteWorkspaceMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InviteWorkspaceMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteWorkspaceMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
+
+// InviteWorkspaceMemberRequestValidationError is the validation error returned
+// by InviteWorkspaceMemberRequest.Validate if the designated constraints
+// aren't met.
+type InviteWorkspaceMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteWorkspaceMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteWorkspaceMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "InviteWorkspaceMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteWorkspaceMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteWorkspaceMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteWorkspaceMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteWorkspaceMemberRequestValidationError{}
+
+var _InviteWorkspaceMemberRequest_Role_InLookup = map[string]struct{}{
+	"owner":  {},
+	"editor": {},
+	"viewer": {},
+}
+
+// Validate checks the field values on InviteWorkspaceMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteWorkspaceMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteWorkspaceMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InviteWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *InviteWorkspaceMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteWorkspaceMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteWorkspaceMemberResponseValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteWorkspaceMemberResponseValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteWorkspaceMemberResponseValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InviteWorkspaceMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by InviteWorkspaceMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InviteWorkspaceMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteWorkspaceMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
+
+// InviteWorkspaceMemberResponseValidationError is the validation error
+// returned by InviteWorkspaceMemberResponse.Validate if the designated
+// constraints aren't met.
+type InviteWorkspaceMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteWorkspaceMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteWorkspaceMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "InviteWorkspaceMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteWorkspaceMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteWorkspaceMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteWorkspaceMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteWorkspaceMemberResponseValidationError{}
+
+// Validate checks the field values on AcceptWorkspaceInvitationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AcceptWorkspaceInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptWorkspaceInvitationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AcceptWorkspaceInvitationRequestMultiError, or nil if none found.
+func (m *AcceptWorkspaceInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptWorkspaceInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 100 {
+		err := AcceptWorkspaceInvitationRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcceptWorkspaceInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptWorkspaceInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AcceptWorkspaceInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptWorkspaceInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptWorkspaceInvitationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptWorkspaceInvitationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptWorkspaceInvitationRequestValidationError is the validation error
+// returned by AcceptWorkspaceInvitationRequest.Validate if the designated
+// constraints aren't met.
+type AcceptWorkspaceInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptWorkspaceInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptWorkspaceInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptWorkspaceInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptWorkspaceInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptWorkspaceInvitationRequestValidationError) ErrorName() string {
+	return "AcceptWorkspaceInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptWorkspaceInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptWorkspaceInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptWorkspaceInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptWorkspaceInvitationRequestValidationError{}
+
+// Validate checks the field values on AcceptWorkspaceInvitationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AcceptWorkspaceInvitationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptWorkspaceInvitationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AcceptWorkspaceInvitationResponseMultiError, or nil if none found.
+func (m *AcceptWorkspaceInvitationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptWorkspaceInvitationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptWorkspaceInvitationResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptWorkspaceInvitationResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptWorkspaceInvitationResponseValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptWorkspaceInvitationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptWorkspaceInvitationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AcceptWorkspaceInvitationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptWorkspaceInvitationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptWorkspaceInvitationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptWorkspaceInvitationResponseMultiError) AllErrors() []error { return m }
+
+// AcceptWorkspaceInvitationResponseValidationError is the validation error
+// returned by AcceptWorkspaceInvitationResponse.Validate if the designated
+// constraints aren't met.
+type AcceptWorkspaceInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptWorkspaceInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptWorkspaceInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptWorkspaceInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptWorkspaceInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptWorkspaceInvitationResponseValidationError) ErrorName() string {
+	return "AcceptWorkspaceInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptWorkspaceInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptWorkspaceInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptWorkspaceInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptWorkspaceInvitationResponseValidationError{}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loak155/techbranch-backend/pkg/urlsign"
+)
+
+const metadataDir = ".meta"
+
+type LocalStore struct {
+	root    string
+	baseURL string
+	signer  *urlsign.Signer
+}
+
+type localMetadata struct {
+	ContentType string `json:"content_type"`
+}
+
+func NewLocalStore(root, baseURL, signingSecret string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{root: root, baseURL: strings.TrimSuffix(baseURL, "/"), signer: urlsign.NewSigner(signingSecret)}, nil
+}
+
+func (s *LocalStore) Put(key string, body io.Reader, contentType string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(name, body); err != nil {
+		return err
+	}
+	metadata, err := json.Marshal(localMetadata{ContentType: contentType})
+	if err != nil {
+		return err
+	}
+	return writeFile(s.metadataPath(key), strings.NewReader(string(metadata)))
+}
+
+func (s *LocalStore) Get(key string) (*Object, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &Object{
+		Body:        file,
+		ContentType: s.contentType(key),
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
+func (s *LocalStore) Delete(key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Remove(s.metadataPath(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) SignedURL(key string, expires time.Duration) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expiresAt)
+	query.Set("signature", s.signer.Sign(key+":"+expiresAt))
+	return fmt.Sprintf("%s/%s?%s", s.baseURL, key, query.Encode()), nil
+}
+
+func (s *LocalStore) VerifySignedURL(key, expiresAt, signature string) error {
+	expires, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || time.Now().Unix() > expires || !s.signer.Verify(key+":"+expiresAt, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean != "/"+key || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStore) metadataPath(key string) string {
+	return filepath.Join(s.root, metadataDir, filepath.FromSlash(key)+".json")
+}
+
+func (s *LocalStore) contentType(key string) string {
+	data, err := os.ReadFile(s.metadataPath(key))
+	if err == nil {
+		metadata := localMetadata{}
+		if json.Unmarshal(data, &metadata) == nil && metadata.ContentType != "" {
+			return metadata.ContentType
+		}
+	}
+	return mime.TypeByExtension(path.Ext(key))
+}
+
+func writeFile(name string, body io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalStore(t *testing.T) *LocalStore {
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080/files/", "secret")
+	require.NoError(t, err)
+	return store
+}
+
+func TestLocalStorePutGet(t *testing.T) {
+	store := newTestLocalStore(t)
+
+	require.NoError(t, store.Put("images/1/avatar", strings.NewReader("image"), "image/png"))
+
+	object, err := store.Get("images/1/avatar")
+	require.NoError(t, err)
+	defer object.Body.Close()
+	body, err := io.ReadAll(object.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "image", string(body))
+	assert.Equal(t, "image/png", object.ContentType)
+	assert.Equal(t, int64(5), object.Size)
+
+	require.NoError(t, store.Delete("images/1/avatar"))
+	_, err = store.Get("images/1/avatar")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocalStorePutStream(t *testing.T) {
+	store := newTestLocalStore(t)
+	data := bytes.Repeat([]byte("x"), s3PartSize+1)
+
+	reader, writer := io.Pipe()
+	go func() {
+		for i := 0; i < len(data); i += 1 << 20 {
+			writer.Write(data[i:min(i+1<<20, len(data))])
+		}
+		writer.Close()
+	}()
+	require.NoError(t, store.Put("exports/1.json", reader, ""))
+
+	object, err := store.Get("exports/1.json")
+	require.NoError(t, err)
+	defer object.Body.Close()
+	assert.Equal(t, int64(len(data)), object.Size)
+	assert.Equal(t, "application/json", object.ContentType)
+}
+
+func TestLocalStoreInvalidKey(t *testing.T) {
+	store := newTestLocalStore(t)
+
+	for _, key := range []string{"", "../secret", "a/../b", ".meta/a.json", "a\\b"} {
+		err := store.Put(key, strings.NewReader("data"), "text/plain")
+		assert.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
+
+func TestLocalStoreSignedURL(t *testing.T) {
+	store := newTestLocalStore(t)
+
+	signedURL, err := store.SignedURL("images/1/avatar", time.Minute)
+	require.NoError(t, err)
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/files/images/1/avatar", u.Path)
+	query := u.Query()
+	assert.NoError(t, store.VerifySignedURL("images/1/avatar", query.Get("expires"), query.Get("signature")))
+	assert.ErrorIs(t, store.VerifySignedURL("images/2/avatar", query.Get("expires"), query.Get("signature")), ErrInvalidSignature)
+
+	signedURL, err = store.SignedURL("images/1/avatar", -time.Minute)
+	require.NoError(t, err)
+	u, err = url.Parse(signedURL)
+	require.NoError(t, err)
+	query = u.Query()
+	assert.ErrorIs(t, store.VerifySignedURL("images/1/avatar", query.Get("expires"), query.Get("signature")), ErrInvalidSignature)
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Runs against the minio service in docker-compose.yml, e.g.
+// STORAGE_TEST_S3_ENDPOINT=localhost:9000 go test ./pkg/storage
+func newTestS3Store(t *testing.T) *S3Store {
+	endpoint := os.Getenv("STORAGE_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("STORAGE_TEST_S3_ENDPOINT is not set")
+	}
+	store, err := NewS3Store(endpoint, "us-east-1", "techbranch-test", getenv("STORAGE_TEST_S3_ACCESS_KEY_ID", "minio"), getenv("STORAGE_TEST_S3_SECRET_ACCESS_KEY", "minio-secret"), false)
+	require.NoError(t, err)
+	return store
+}
+
+func getenv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestS3StorePutGet(t *testing.T) {
+	store := newTestS3Store(t)
+
+	require.NoError(t, store.Put("images/1/avatar", strings.NewReader("image"), "image/png"))
+	defer store.Delete("images/1/avatar")
+
+	object, err := store.Get("images/1/avatar")
+	require.NoError(t, err)
+	defer object.Body.Close()
+	body, err := io.ReadAll(object.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "image", string(body))
+	assert.Equal(t, "image/png", object.ContentType)
+	assert.Equal(t, int64(5), object.Size)
+
+	require.NoError(t, store.Delete("images/1/avatar"))
+	_, err = store.Get("images/1/avatar")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestS3StorePutStream(t *testing.T) {
+	store := newTestS3Store(t)
+	data := bytes.Repeat([]byte("x"), s3PartSize+1)
+
+	reader, writer := io.Pipe()
+	go func() {
+		for i := 0; i < len(data); i += 1 << 20 {
+			writer.Write(data[i:min(i+1<<20, len(data))])
+		}
+		writer.Close()
+	}()
+	require.NoError(t, store.Put("exports/1.json", reader, "application/json"))
+	defer store.Delete("exports/1.json")
+
+	object, err := store.Get("exports/1.json")
+	require.NoError(t, err)
+	defer object.Body.Close()
+	assert.Equal(t, int64(len(data)), object.Size)
+	assert.Equal(t, "application/json", object.ContentType)
+}
+
+func TestS3StoreSignedURL(t *testing.T) {
+	store := newTestS3Store(t)
+
+	require.NoError(t, store.Put("images/1/avatar", strings.NewReader("image"), "image/png"))
+	defer store.Delete("images/1/avatar")
+
+	signedURL, err := store.SignedURL("images/1/avatar", time.Minute)
+	require.NoError(t, err)
+	res, err := http.Get(signedURL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "image", string(body))
+
+	signedURL, err = store.SignedURL("images/1/avatar", time.Second)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Second)
+	res, err = http.Get(signedURL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var (
+	ErrNotFound         = errors.New("object not found")
+	ErrInvalidKey       = errors.New("invalid key")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+type Store interface {
+	Put(key string, body io.Reader, contentType string) error
+	Get(key string) (*Object, error)
+	Delete(key string) error
+	SignedURL(key string, expires time.Duration) (string, error)
+}
+
+type Object struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+	ModTime     time.Time
+}
+
+type Config struct {
+	Driver            string
+	LocalDir          string
+	LocalBaseURL      string
+	SigningSecret     string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UseSSL          bool
+}
+
+func NewStore(conf Config) (Store, error) {
+	switch conf.Driver {
+	case DriverLocal, "":
+		return NewLocalStore(conf.LocalDir, conf.LocalBaseURL, conf.SigningSecret)
+	case DriverS3:
+		return NewS3Store(conf.S3Endpoint, conf.S3Region, conf.S3Bucket, conf.S3AccessKeyID, conf.S3SecretAccessKey, conf.S3UseSSL)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %q", conf.Driver)
+	}
+}